@@ -0,0 +1,102 @@
+package cmds
+
+import (
+	"context"
+
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/ProtoconNet/mitum-nft/nft/collection"
+	"github.com/pkg/errors"
+
+	"github.com/ProtoconNet/mitum-currency/v2/cmds"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+)
+
+type AcceptOfferCommand struct {
+	baseCommand
+	cmds.OperationFlags
+	Sender   cmds.AddressFlag    `arg:"" name:"sender" help:"sender address" required:"true"`
+	Offerer  cmds.AddressFlag    `arg:"" name:"offerer" help:"offerer address" required:"true"`
+	NFT      NFTIDFlag           `arg:"" name:"nft" help:"target nft of offer; \"<collection>,<idx>\""`
+	Currency cmds.CurrencyIDFlag `arg:"" name:"currency" help:"currency id" required:"true"`
+	sender   base.Address
+	offerer  base.Address
+	nft      nft.NFTID
+}
+
+func NewAcceptOfferCommand() AcceptOfferCommand {
+	cmd := NewbaseCommand()
+	return AcceptOfferCommand{baseCommand: *cmd}
+}
+
+func (cmd *AcceptOfferCommand) Run(pctx context.Context) error { // nolint:dupl
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	encs = cmd.encs
+	enc = cmd.enc
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *AcceptOfferCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	if a, err := cmd.Sender.Encode(enc); err != nil {
+		return errors.Wrapf(err, "invalid sender format, %q", cmd.Sender)
+	} else {
+		cmd.sender = a
+	}
+
+	if a, err := cmd.Offerer.Encode(enc); err != nil {
+		return errors.Wrapf(err, "invalid offerer format, %q", cmd.Offerer)
+	} else {
+		cmd.offerer = a
+	}
+
+	n := nft.NewNFTID(cmd.NFT.collection, cmd.NFT.idx)
+	if err := n.IsValid(nil); err != nil {
+		return err
+	}
+	cmd.nft = n
+
+	return nil
+
+}
+
+func (cmd *AcceptOfferCommand) createOperation() (base.Operation, error) {
+	e := util.StringErrorFunc("failed to create accept-offer operation")
+
+	item := collection.NewAcceptOfferItem(cmd.offerer, cmd.nft, cmd.Currency.CID)
+
+	fact := collection.NewAcceptOfferFact(
+		[]byte(cmd.Token),
+		cmd.sender,
+		[]collection.AcceptOfferItem{item},
+	)
+
+	op, err := collection.NewAcceptOffer(fact)
+	if err != nil {
+		return nil, e(err, "")
+	}
+	err = op.HashSign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, e(err, "")
+	}
+
+	return op, nil
+}
@@ -0,0 +1,102 @@
+package cmds
+
+import (
+	"context"
+
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/ProtoconNet/mitum-nft/nft/collection"
+	"github.com/pkg/errors"
+
+	"github.com/ProtoconNet/mitum-currency/v2/cmds"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+)
+
+type AttachChildCommand struct {
+	baseCommand
+	cmds.OperationFlags
+	Sender   cmds.AddressFlag    `arg:"" name:"sender" help:"sender address" required:"true"`
+	Parent   NFTIDFlag           `arg:"" name:"parent" help:"parent nft; \"<collection>,<idx>\""`
+	Child    NFTIDFlag           `arg:"" name:"child" help:"child nft; \"<collection>,<idx>\""`
+	Currency cmds.CurrencyIDFlag `arg:"" name:"currency" help:"currency id" required:"true"`
+	sender   base.Address
+	parent   nft.NFTID
+	child    nft.NFTID
+}
+
+func NewAttachChildCommand() AttachChildCommand {
+	cmd := NewbaseCommand()
+	return AttachChildCommand{baseCommand: *cmd}
+}
+
+func (cmd *AttachChildCommand) Run(pctx context.Context) error { // nolint:dupl
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	encs = cmd.encs
+	enc = cmd.enc
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *AttachChildCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	if a, err := cmd.Sender.Encode(enc); err != nil {
+		return errors.Wrapf(err, "invalid sender format, %q", cmd.Sender)
+	} else {
+		cmd.sender = a
+	}
+
+	parent := nft.NewNFTID(cmd.Parent.collection, cmd.Parent.idx)
+	if err := parent.IsValid(nil); err != nil {
+		return err
+	}
+	cmd.parent = parent
+
+	child := nft.NewNFTID(cmd.Child.collection, cmd.Child.idx)
+	if err := child.IsValid(nil); err != nil {
+		return err
+	}
+	cmd.child = child
+
+	return nil
+
+}
+
+func (cmd *AttachChildCommand) createOperation() (base.Operation, error) {
+	e := util.StringErrorFunc("failed to create attach-child operation")
+
+	item := collection.NewAttachChildItem(cmd.parent, cmd.child, cmd.Currency.CID)
+
+	fact := collection.NewAttachChildFact(
+		[]byte(cmd.Token),
+		cmd.sender,
+		[]collection.AttachChildItem{item},
+	)
+
+	op, err := collection.NewAttachChild(fact)
+	if err != nil {
+		return nil, e(err, "")
+	}
+	err = op.HashSign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, e(err, "")
+	}
+
+	return op, nil
+}
@@ -0,0 +1,89 @@
+package cmds
+
+import (
+	"context"
+
+	extensioncurrency "github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+	nftcollection "github.com/ProtoconNet/mitum-nft/nft/collection"
+
+	"github.com/pkg/errors"
+
+	"github.com/ProtoconNet/mitum-currency/v2/cmds"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+)
+
+type CollectionActiveUpdaterCommand struct {
+	baseCommand
+	cmds.OperationFlags
+	Sender     cmds.AddressFlag    `arg:"" name:"sender" help:"contract account owner address" required:"true"`
+	Collection string              `arg:"" name:"collection" help:"collection symbol" required:"true"`
+	Active     bool                `arg:"" name:"active" help:"true to activate, false to deactivate" required:"true"`
+	Currency   cmds.CurrencyIDFlag `arg:"" name:"currency" help:"currency id" required:"true"`
+	sender     base.Address
+	collection extensioncurrency.ContractID
+}
+
+func NewCollectionActiveUpdaterCommand() CollectionActiveUpdaterCommand {
+	cmd := NewbaseCommand()
+	return CollectionActiveUpdaterCommand{baseCommand: *cmd}
+}
+
+func (cmd *CollectionActiveUpdaterCommand) Run(pctx context.Context) error {
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	encs = cmd.encs
+	enc = cmd.enc
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *CollectionActiveUpdaterCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	if a, err := cmd.Sender.Encode(enc); err != nil {
+		return errors.Wrapf(err, "invalid sender format, %q", cmd.Sender)
+	} else {
+		cmd.sender = a
+	}
+
+	collection := extensioncurrency.ContractID(cmd.Collection)
+	if err := collection.IsValid(nil); err != nil {
+		return err
+	}
+	cmd.collection = collection
+
+	return nil
+}
+
+func (cmd *CollectionActiveUpdaterCommand) createOperation() (base.Operation, error) {
+	e := util.StringErrorFunc("failed to create collection-active-updater operation")
+
+	fact := nftcollection.NewCollectionActiveUpdaterFact([]byte(cmd.Token), cmd.sender, cmd.collection, cmd.Active, cmd.Currency.CID)
+
+	op, err := nftcollection.NewCollectionActiveUpdater(fact)
+	if err != nil {
+		return nil, e(err, "")
+	}
+	err = op.HashSign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, e(err, "")
+	}
+
+	return op, nil
+}
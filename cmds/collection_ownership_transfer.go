@@ -0,0 +1,104 @@
+package cmds
+
+import (
+	"context"
+
+	extensioncurrency "github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+	nftcollection "github.com/ProtoconNet/mitum-nft/nft/collection"
+
+	"github.com/pkg/errors"
+
+	"github.com/ProtoconNet/mitum-currency/v2/cmds"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+)
+
+type CollectionOwnershipTransferCommand struct {
+	baseCommand
+	cmds.OperationFlags
+	Sender     cmds.AddressFlag    `arg:"" name:"sender" help:"sender address" required:"true"`
+	Collection string              `arg:"" name:"collection" help:"collection symbol" required:"true"`
+	Parent     cmds.AddressFlag    `arg:"" name:"parent" help:"new parent contract account" required:"true"`
+	Creator    cmds.AddressFlag    `arg:"" name:"creator" help:"new creator address" required:"true"`
+	Currency   cmds.CurrencyIDFlag `arg:"" name:"currency" help:"currency id" required:"true"`
+	sender     base.Address
+	collection extensioncurrency.ContractID
+	parent     base.Address
+	creator    base.Address
+}
+
+func NewCollectionOwnershipTransferCommand() CollectionOwnershipTransferCommand {
+	cmd := NewbaseCommand()
+	return CollectionOwnershipTransferCommand{baseCommand: *cmd}
+}
+
+func (cmd *CollectionOwnershipTransferCommand) Run(pctx context.Context) error {
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	encs = cmd.encs
+	enc = cmd.enc
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *CollectionOwnershipTransferCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	if a, err := cmd.Sender.Encode(enc); err != nil {
+		return errors.Wrapf(err, "invalid sender format, %q", cmd.Sender)
+	} else {
+		cmd.sender = a
+	}
+
+	if a, err := cmd.Parent.Encode(enc); err != nil {
+		return errors.Wrapf(err, "invalid parent format, %q", cmd.Parent)
+	} else {
+		cmd.parent = a
+	}
+
+	if a, err := cmd.Creator.Encode(enc); err != nil {
+		return errors.Wrapf(err, "invalid creator format, %q", cmd.Creator)
+	} else {
+		cmd.creator = a
+	}
+
+	collection := extensioncurrency.ContractID(cmd.Collection)
+	if err := collection.IsValid(nil); err != nil {
+		return err
+	}
+	cmd.collection = collection
+
+	return nil
+}
+
+func (cmd *CollectionOwnershipTransferCommand) createOperation() (base.Operation, error) {
+	e := util.StringErrorFunc("failed to create collection-ownership-transfer operation")
+
+	fact := nftcollection.NewCollectionOwnershipTransferFact([]byte(cmd.Token), cmd.sender, cmd.collection, cmd.parent, cmd.creator, cmd.Currency.CID)
+
+	op, err := nftcollection.NewCollectionOwnershipTransfer(fact)
+	if err != nil {
+		return nil, e(err, "")
+	}
+	err = op.HashSign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, e(err, "")
+	}
+
+	return op, nil
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/ProtoconNet/mitum-currency/v2/cmds"
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
 	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
 )
@@ -17,15 +18,21 @@ import (
 type CollectionPolicyUpdaterCommand struct {
 	baseCommand
 	cmds.OperationFlags
-	Sender     cmds.AddressFlag    `arg:"" name:"sender" help:"sender address" required:"true"`
-	Collection string              `arg:"" name:"collection" help:"collection symbol" required:"true"`
-	Name       string              `arg:"" name:"name" help:"collection name" required:"true"`
-	Royalty    uint                `arg:"" name:"royalty" help:"royalty parameter; 0 <= royalty param < 100" required:"true"`
-	Currency   cmds.CurrencyIDFlag `arg:"" name:"currency" help:"currency id" required:"true"`
-	URI        string              `name:"uri" help:"collection uri" optional:""`
-	White      cmds.AddressFlag    `name:"white" help:"whitelisted address" optional:""`
-	sender     base.Address
-	policy     nftcollection.CollectionPolicy
+	Sender       cmds.AddressFlag        `arg:"" name:"sender" help:"sender address" required:"true"`
+	Collection   string                  `arg:"" name:"collection" help:"collection symbol" required:"true"`
+	Name         string                  `arg:"" name:"name" help:"collection name" required:"true"`
+	Royalty      uint                    `arg:"" name:"royalty" help:"royalty parameter; 0 <= royalty param < 100" required:"true"`
+	Currency     cmds.CurrencyIDFlag     `arg:"" name:"currency" help:"currency id" required:"true"`
+	URI          string                  `name:"uri" help:"collection uri" optional:""`
+	MaxSupply    uint64                  `name:"max-supply" help:"max supply of collection; 0 means no limit" optional:""`
+	PublicMint   bool                    `name:"public-mint" help:"allow anyone to mint by paying mint price" optional:""`
+	MintPrice    cmds.CurrencyAmountFlag `name:"mint-price" help:"mint price for public mint (ex: \"<currency>,<amount>\")" optional:""`
+	MintStart    uint64                  `name:"mint-start-height" help:"first block height to allow mint; 0 means no limit" optional:""`
+	MintEnd      uint64                  `name:"mint-end-height" help:"last block height to allow mint; 0 means no limit" optional:""`
+	Soulbound    bool                    `name:"soulbound" help:"collection nfts are non-transferable; must match registered policy" optional:""`
+	RequireSigns bool                    `name:"require-signatures" help:"nfts are not transferable until all creators and copyrighters sign" optional:""`
+	sender       base.Address
+	policy       nftcollection.CollectionPolicy
 }
 
 func NewCollectionPolicyUpdaterCommand() CollectionPolicyUpdaterCommand {
@@ -66,15 +73,6 @@ func (cmd *CollectionPolicyUpdaterCommand) parseFlags() error {
 		cmd.sender = a
 	}
 
-	var white base.Address = nil
-	if cmd.White.String() != "" {
-		if a, err := cmd.White.Encode(enc); err != nil {
-			return errors.Wrapf(err, "invalid white format, %q", cmd.White)
-		} else {
-			white = a
-		}
-	}
-
 	collection := extensioncurrency.ContractID(cmd.Collection)
 	if err := collection.IsValid(nil); err != nil {
 		return err
@@ -95,12 +93,17 @@ func (cmd *CollectionPolicyUpdaterCommand) parseFlags() error {
 		return err
 	}
 
-	whites := []base.Address{}
-	if white != nil {
-		whites = append(whites, white)
+	mintPrice := currency.ZeroBig
+	var mintCurrency currency.CurrencyID
+	if cmd.PublicMint {
+		if cmd.MintPrice.Big.Int == nil {
+			return errors.Errorf("mint price required for public mint")
+		}
+		mintPrice = cmd.MintPrice.Big
+		mintCurrency = cmd.MintPrice.CID
 	}
 
-	policy := nftcollection.NewCollectionPolicy(name, royalty, uri, whites)
+	policy := nftcollection.NewCollectionPolicy(name, royalty, uri, cmd.MaxSupply, cmd.PublicMint, mintPrice, mintCurrency, base.Height(cmd.MintStart), base.Height(cmd.MintEnd), !cmd.Soulbound, cmd.RequireSigns)
 	if err := policy.IsValid(nil); err != nil {
 		return err
 	}
@@ -10,6 +10,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/ProtoconNet/mitum-currency/v2/cmds"
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
 	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
 )
@@ -17,17 +18,25 @@ import (
 type CollectionRegisterCommand struct {
 	baseCommand
 	cmds.OperationFlags
-	Sender     cmds.AddressFlag    `arg:"" name:"sender" help:"sender address" required:"true"`
-	Target     cmds.AddressFlag    `arg:"" name:"target" help:"target account to register policy" required:"true"`
-	Collection string              `arg:"" name:"collection" help:"collection symbol" required:"true"`
-	Name       string              `arg:"" name:"name" help:"collection name" required:"true"`
-	Royalty    uint                `arg:"" name:"royalty" help:"royalty parameter; 0 <= royalty param < 100" required:"true"`
-	Currency   cmds.CurrencyIDFlag `arg:"" name:"currency" help:"currency id" required:"true"`
-	URI        string              `name:"uri" help:"collection uri" optional:""`
-	White      cmds.AddressFlag    `name:"white" help:"whitelisted address" optional:""`
-	sender     base.Address
-	target     base.Address
-	form       nftcollection.CollectionRegisterForm
+	Sender       cmds.AddressFlag        `arg:"" name:"sender" help:"sender address" required:"true"`
+	Target       cmds.AddressFlag        `arg:"" name:"target" help:"target account to register policy" required:"true"`
+	Collection   string                  `arg:"" name:"collection" help:"collection symbol" required:"true"`
+	Name         string                  `arg:"" name:"name" help:"collection name" required:"true"`
+	Royalty      uint                    `arg:"" name:"royalty" help:"royalty parameter; 0 <= royalty param < 100" required:"true"`
+	Currency     cmds.CurrencyIDFlag     `arg:"" name:"currency" help:"currency id" required:"true"`
+	URI          string                  `name:"uri" help:"collection uri" optional:""`
+	White        cmds.AddressFlag        `name:"white" help:"whitelisted address" optional:""`
+	WhiteQuota   uint64                  `name:"white-quota" help:"mint quota of whitelisted address; 0 means no limit" optional:""`
+	MaxSupply    uint64                  `name:"max-supply" help:"max supply of collection; 0 means no limit" optional:""`
+	PublicMint   bool                    `name:"public-mint" help:"allow anyone to mint by paying mint price" optional:""`
+	MintPrice    cmds.CurrencyAmountFlag `name:"mint-price" help:"mint price for public mint (ex: \"<currency>,<amount>\")" optional:""`
+	MintStart    uint64                  `name:"mint-start-height" help:"first block height to allow mint; 0 means no limit" optional:""`
+	MintEnd      uint64                  `name:"mint-end-height" help:"last block height to allow mint; 0 means no limit" optional:""`
+	Soulbound    bool                    `name:"soulbound" help:"make collection nfts non-transferable" optional:""`
+	RequireSigns bool                    `name:"require-signatures" help:"nfts are not transferable until all creators and copyrighters sign" optional:""`
+	sender       base.Address
+	target       base.Address
+	form         nftcollection.CollectionRegisterForm
 }
 
 func NewCollectionRegisterCommand() CollectionRegisterCommand {
@@ -103,12 +112,22 @@ func (cmd *CollectionRegisterCommand) parseFlags() error {
 		return err
 	}
 
-	whites := []base.Address{}
+	whites := []nftcollection.White{}
 	if white != nil {
-		whites = append(whites, white)
+		whites = append(whites, nftcollection.NewWhite(white, cmd.WhiteQuota))
 	}
 
-	form := nftcollection.NewCollectionRegisterForm(cmd.target, collection, name, royalty, uri, whites)
+	mintPrice := currency.ZeroBig
+	var mintCurrency currency.CurrencyID
+	if cmd.PublicMint {
+		if cmd.MintPrice.Big.Int == nil {
+			return errors.Errorf("mint price required for public mint")
+		}
+		mintPrice = cmd.MintPrice.Big
+		mintCurrency = cmd.MintPrice.CID
+	}
+
+	form := nftcollection.NewCollectionRegisterForm(cmd.target, collection, name, royalty, uri, whites, cmd.MaxSupply, cmd.PublicMint, mintPrice, mintCurrency, base.Height(cmd.MintStart), base.Height(cmd.MintEnd), !cmd.Soulbound, cmd.RequireSigns)
 	if err := form.IsValid(nil); err != nil {
 		return err
 	}
@@ -0,0 +1,62 @@
+package cmds
+
+import (
+	"context"
+
+	extensioncurrency "github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+	"github.com/ProtoconNet/mitum-currency/v2/cmds"
+	"github.com/ProtoconNet/mitum-nft/nft/collection"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/pkg/errors"
+)
+
+type CollectionsCommand struct {
+	baseCommand
+	QueryFlags
+	Contract cmds.AddressFlag `arg:"" name:"contract-account" help:"contract account of collections" required:"true"`
+}
+
+type collections struct {
+	Contract    base.Address                   `json:"contract_account"`
+	Collections []extensioncurrency.ContractID `json:"collections"`
+}
+
+func NewCollectionsCommand() CollectionsCommand {
+	cmd := NewbaseCommand()
+	return CollectionsCommand{baseCommand: *cmd}
+}
+
+func (cmd *CollectionsCommand) Run(pctx context.Context) error {
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	encs = cmd.encs
+	enc = cmd.enc
+
+	contract, err := cmd.Contract.Encode(enc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid contract account format; %q", cmd.Contract)
+	}
+
+	qc, err := cmd.client(cmd.encs, cmd.enc)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		_ = qc.close()
+	}()
+
+	cs, err := collection.CollectionsOf(contract, qc.getState)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get collections, %q", contract)
+	}
+
+	PrettyPrint(cmd.Out, collections{
+		Contract:    contract,
+		Collections: cs,
+	})
+
+	return nil
+}
@@ -0,0 +1,94 @@
+package cmds
+
+import (
+	"context"
+
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/ProtoconNet/mitum-nft/nft/collection"
+	"github.com/pkg/errors"
+
+	"github.com/ProtoconNet/mitum-currency/v2/cmds"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+)
+
+type EditionBurnCommand struct {
+	baseCommand
+	cmds.OperationFlags
+	Sender   cmds.AddressFlag    `arg:"" name:"sender" help:"sender address" required:"true"`
+	NFT      NFTIDFlag           `arg:"" name:"nft" help:"target edition; \"<symbol>,<idx>\""`
+	Amount   uint64              `arg:"" name:"amount" help:"amount of edition" required:"true"`
+	Currency cmds.CurrencyIDFlag `arg:"" name:"currency" help:"currency id" required:"true"`
+	sender   base.Address
+	nft      nft.NFTID
+}
+
+func NewEditionBurnCommand() EditionBurnCommand {
+	cmd := NewbaseCommand()
+	return EditionBurnCommand{baseCommand: *cmd}
+}
+
+func (cmd *EditionBurnCommand) Run(pctx context.Context) error { // nolint:dupl
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	encs = cmd.encs
+	enc = cmd.enc
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *EditionBurnCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	if a, err := cmd.Sender.Encode(enc); err != nil {
+		return errors.Wrapf(err, "invalid sender format, %q", cmd.Sender.String())
+	} else {
+		cmd.sender = a
+	}
+
+	n := nft.NewNFTID(cmd.NFT.collection, cmd.NFT.idx)
+	if err := n.IsValid(nil); err != nil {
+		return err
+	}
+	cmd.nft = n
+
+	return nil
+
+}
+
+func (cmd *EditionBurnCommand) createOperation() (base.Operation, error) {
+	e := util.StringErrorFunc("failed to create edition-burn operation")
+
+	item := collection.NewEditionBurnItem(cmd.nft, cmd.Amount, cmd.Currency.CID)
+	fact := collection.NewEditionBurnFact(
+		[]byte(cmd.Token),
+		cmd.sender,
+		[]collection.EditionBurnItem{item},
+	)
+
+	op, err := collection.NewEditionBurn(fact)
+	if err != nil {
+		return nil, e(err, "")
+	}
+	err = op.HashSign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, e(err, "")
+	}
+
+	return op, nil
+}
@@ -0,0 +1,104 @@
+package cmds
+
+import (
+	"context"
+
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/ProtoconNet/mitum-nft/nft/collection"
+	"github.com/pkg/errors"
+
+	"github.com/ProtoconNet/mitum-currency/v2/cmds"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+)
+
+type FractionalizeCommand struct {
+	baseCommand
+	cmds.OperationFlags
+	Sender   cmds.AddressFlag        `arg:"" name:"sender" help:"sender address" required:"true"`
+	NFT      NFTIDFlag               `arg:"" name:"nft" help:"target nft to fractionalize; \"<collection>,<idx>\""`
+	Vault    cmds.AddressFlag        `arg:"" name:"vault" help:"contract account to lock nft" required:"true"`
+	Shares   cmds.CurrencyAmountFlag `arg:"" name:"shares" help:"registered share currency and its full supply (ex: \"<currency>,<amount>\")" required:"true"`
+	Currency cmds.CurrencyIDFlag     `arg:"" name:"currency" help:"currency id" required:"true"`
+	sender   base.Address
+	vault    base.Address
+	nft      nft.NFTID
+}
+
+func NewFractionalizeCommand() FractionalizeCommand {
+	cmd := NewbaseCommand()
+	return FractionalizeCommand{baseCommand: *cmd}
+}
+
+func (cmd *FractionalizeCommand) Run(pctx context.Context) error { // nolint:dupl
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	encs = cmd.encs
+	enc = cmd.enc
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *FractionalizeCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	if a, err := cmd.Sender.Encode(enc); err != nil {
+		return errors.Wrapf(err, "invalid sender format, %q", cmd.Sender)
+	} else {
+		cmd.sender = a
+	}
+
+	if a, err := cmd.Vault.Encode(enc); err != nil {
+		return errors.Wrapf(err, "invalid vault format, %q", cmd.Vault)
+	} else {
+		cmd.vault = a
+	}
+
+	n := nft.NewNFTID(cmd.NFT.collection, cmd.NFT.idx)
+	if err := n.IsValid(nil); err != nil {
+		return err
+	}
+	cmd.nft = n
+
+	return nil
+}
+
+func (cmd *FractionalizeCommand) createOperation() (base.Operation, error) {
+	e := util.StringErrorFunc("failed to create fractionalize operation")
+
+	fact := collection.NewFractionalizeFact(
+		[]byte(cmd.Token),
+		cmd.sender,
+		cmd.nft,
+		cmd.vault,
+		cmd.Shares.CID,
+		cmd.Shares.Big,
+		cmd.Currency.CID,
+	)
+
+	op, err := collection.NewFractionalize(fact)
+	if err != nil {
+		return nil, e(err, "")
+	}
+	err = op.HashSign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, e(err, "")
+	}
+
+	return op, nil
+}
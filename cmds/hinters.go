@@ -63,6 +63,7 @@ var hinters = []encoder.DecodeDetail{
 	{Hint: nft.SignersHint, Instance: nft.Signers{}},
 	{Hint: nft.NFTIDHint, Instance: nft.NFTID{}},
 	{Hint: nft.NFTHint, Instance: nft.NFT{}},
+	{Hint: nft.LegacyNFTHint, Instance: nft.NFT{}},
 	{Hint: nft.DesignHint, Instance: nft.Design{}},
 	{Hint: collection.CollectionLastNFTIndexStateValueHint, Instance: collection.CollectionLastNFTIndexStateValue{}},
 	{Hint: collection.NFTStateValueHint, Instance: collection.NFTStateValue{}},
@@ -70,7 +71,10 @@ var hinters = []encoder.DecodeDetail{
 	{Hint: collection.NFTBoxHint, Instance: collection.NFTBox{}},
 	{Hint: collection.AgentBoxStateValueHint, Instance: collection.AgentBoxStateValue{}},
 	{Hint: collection.AgentBoxHint, Instance: collection.AgentBox{}},
+	{Hint: collection.CollectionBoxStateValueHint, Instance: collection.CollectionBoxStateValue{}},
+	{Hint: collection.CollectionBoxHint, Instance: collection.CollectionBox{}},
 	{Hint: collection.CollectionPolicyHint, Instance: collection.CollectionPolicy{}},
+	{Hint: collection.LegacyCollectionPolicyHint, Instance: collection.CollectionPolicy{}},
 	{Hint: collection.CollectionDesignHint, Instance: collection.CollectionDesign{}},
 	{Hint: collection.CollectionDesignStateValueHint, Instance: collection.CollectionDesignStateValue{}},
 	{Hint: collection.CollectionRegisterFormHint, Instance: collection.CollectionRegisterForm{}},
@@ -87,6 +91,80 @@ var hinters = []encoder.DecodeDetail{
 	{Hint: collection.ApproveHint, Instance: collection.Approve{}},
 	{Hint: collection.NFTSignItemHint, Instance: collection.NFTSignItem{}},
 	{Hint: collection.NFTSignHint, Instance: collection.NFTSign{}},
+	{Hint: collection.BurnItemHint, Instance: collection.BurnItem{}},
+	{Hint: collection.BurnHint, Instance: collection.Burn{}},
+	{Hint: collection.NFTSaleItemHint, Instance: collection.NFTSaleItem{}},
+	{Hint: collection.NFTSaleHint, Instance: collection.NFTSale{}},
+	{Hint: collection.ListingHint, Instance: collection.Listing{}},
+	{Hint: collection.ListingStateValueHint, Instance: collection.ListingStateValue{}},
+	{Hint: collection.ListItemHint, Instance: collection.ListItem{}},
+	{Hint: collection.ListHint, Instance: collection.List{}},
+	{Hint: collection.DelistItemHint, Instance: collection.DelistItem{}},
+	{Hint: collection.DelistHint, Instance: collection.Delist{}},
+	{Hint: collection.BuyItemHint, Instance: collection.BuyItem{}},
+	{Hint: collection.BuyHint, Instance: collection.Buy{}},
+	{Hint: collection.AuctionHint, Instance: collection.Auction{}},
+	{Hint: collection.AuctionStateValueHint, Instance: collection.AuctionStateValue{}},
+	{Hint: collection.AuctionCreateItemHint, Instance: collection.AuctionCreateItem{}},
+	{Hint: collection.AuctionCreateHint, Instance: collection.AuctionCreate{}},
+	{Hint: collection.BidItemHint, Instance: collection.BidItem{}},
+	{Hint: collection.BidHint, Instance: collection.Bid{}},
+	{Hint: collection.AuctionSettleItemHint, Instance: collection.AuctionSettleItem{}},
+	{Hint: collection.AuctionSettleHint, Instance: collection.AuctionSettle{}},
+	{Hint: collection.OfferHint, Instance: collection.Offer{}},
+	{Hint: collection.OfferStateValueHint, Instance: collection.OfferStateValue{}},
+	{Hint: collection.MakeOfferItemHint, Instance: collection.MakeOfferItem{}},
+	{Hint: collection.MakeOfferHint, Instance: collection.MakeOffer{}},
+	{Hint: collection.CancelOfferItemHint, Instance: collection.CancelOfferItem{}},
+	{Hint: collection.CancelOfferHint, Instance: collection.CancelOffer{}},
+	{Hint: collection.AcceptOfferItemHint, Instance: collection.AcceptOfferItem{}},
+	{Hint: collection.AcceptOfferHint, Instance: collection.AcceptOffer{}},
+	{Hint: collection.CollectionStatusHint, Instance: collection.CollectionStatus{}},
+	{Hint: collection.CollectionStatusStateValueHint, Instance: collection.CollectionStatusStateValue{}},
+	{Hint: collection.CollectionActiveUpdaterHint, Instance: collection.CollectionActiveUpdater{}},
+	{Hint: collection.CollectionOwnershipTransferHint, Instance: collection.CollectionOwnershipTransfer{}},
+	{Hint: collection.WhiteHint, Instance: collection.White{}},
+	{Hint: collection.WhiteStateValueHint, Instance: collection.WhiteStateValue{}},
+	{Hint: collection.MintCountStateValueHint, Instance: collection.MintCountStateValue{}},
+	{Hint: collection.FreezeStateValueHint, Instance: collection.FreezeStateValue{}},
+	{Hint: collection.FreezeItemHint, Instance: collection.FreezeItem{}},
+	{Hint: collection.FreezeHint, Instance: collection.Freeze{}},
+	{Hint: collection.UnfreezeItemHint, Instance: collection.UnfreezeItem{}},
+	{Hint: collection.UnfreezeHint, Instance: collection.Unfreeze{}},
+	{Hint: collection.EditionHint, Instance: collection.Edition{}},
+	{Hint: collection.EditionStateValueHint, Instance: collection.EditionStateValue{}},
+	{Hint: collection.EditionBalanceStateValueHint, Instance: collection.EditionBalanceStateValue{}},
+	{Hint: collection.EditionMintItemHint, Instance: collection.EditionMintItem{}},
+	{Hint: collection.EditionMintHint, Instance: collection.EditionMint{}},
+	{Hint: collection.EditionTransferItemHint, Instance: collection.EditionTransferItem{}},
+	{Hint: collection.EditionTransferHint, Instance: collection.EditionTransfer{}},
+	{Hint: collection.EditionBurnItemHint, Instance: collection.EditionBurnItem{}},
+	{Hint: collection.EditionBurnHint, Instance: collection.EditionBurn{}},
+	{Hint: collection.FractionHint, Instance: collection.Fraction{}},
+	{Hint: collection.FractionStateValueHint, Instance: collection.FractionStateValue{}},
+	{Hint: collection.FractionalizeHint, Instance: collection.Fractionalize{}},
+	{Hint: collection.RedeemHint, Instance: collection.Redeem{}},
+	{Hint: collection.SetUserItemHint, Instance: collection.SetUserItem{}},
+	{Hint: collection.SetUserHint, Instance: collection.SetUser{}},
+	{Hint: collection.NFTLinkHint, Instance: collection.NFTLink{}},
+	{Hint: collection.NFTParentStateValueHint, Instance: collection.NFTParentStateValue{}},
+	{Hint: collection.NFTChildrenStateValueHint, Instance: collection.NFTChildrenStateValue{}},
+	{Hint: collection.AttachChildItemHint, Instance: collection.AttachChildItem{}},
+	{Hint: collection.AttachChildHint, Instance: collection.AttachChild{}},
+	{Hint: collection.DetachChildItemHint, Instance: collection.DetachChildItem{}},
+	{Hint: collection.DetachChildHint, Instance: collection.DetachChild{}},
+	{Hint: collection.SwapSideHint, Instance: collection.SwapSide{}},
+	{Hint: collection.SwapHint, Instance: collection.Swap{}},
+	{Hint: collection.VoucherHint, Instance: collection.Voucher{}},
+	{Hint: collection.UsedVoucherStateValueHint, Instance: collection.UsedVoucherStateValue{}},
+	{Hint: collection.RedeemVoucherHint, Instance: collection.RedeemVoucher{}},
+	{Hint: collection.SignRevocationHint, Instance: collection.SignRevocation{}},
+	{Hint: collection.SignRevocationStateValueHint, Instance: collection.SignRevocationStateValue{}},
+	{Hint: collection.NFTUnsignItemHint, Instance: collection.NFTUnsignItem{}},
+	{Hint: collection.NFTUnsignHint, Instance: collection.NFTUnsign{}},
+	{Hint: collection.NFTSignersUpdaterHint, Instance: collection.NFTSignersUpdater{}},
+	{Hint: collection.AddWhitesHint, Instance: collection.AddWhites{}},
+	{Hint: collection.RemoveWhitesHint, Instance: collection.RemoveWhites{}},
 }
 
 var supportedProposalOperationFactHinters = []encoder.DecodeDetail{
@@ -111,6 +189,35 @@ var supportedProposalOperationFactHinters = []encoder.DecodeDetail{
 	{Hint: collection.DelegateFactHint, Instance: collection.DelegateFact{}},
 	{Hint: collection.ApproveFactHint, Instance: collection.ApproveFact{}},
 	{Hint: collection.NFTSignFactHint, Instance: collection.NFTSignFact{}},
+	{Hint: collection.BurnFactHint, Instance: collection.BurnFact{}},
+	{Hint: collection.NFTSaleFactHint, Instance: collection.NFTSaleFact{}},
+	{Hint: collection.ListFactHint, Instance: collection.ListFact{}},
+	{Hint: collection.DelistFactHint, Instance: collection.DelistFact{}},
+	{Hint: collection.BuyFactHint, Instance: collection.BuyFact{}},
+	{Hint: collection.AuctionCreateFactHint, Instance: collection.AuctionCreateFact{}},
+	{Hint: collection.BidFactHint, Instance: collection.BidFact{}},
+	{Hint: collection.AuctionSettleFactHint, Instance: collection.AuctionSettleFact{}},
+	{Hint: collection.MakeOfferFactHint, Instance: collection.MakeOfferFact{}},
+	{Hint: collection.CancelOfferFactHint, Instance: collection.CancelOfferFact{}},
+	{Hint: collection.AcceptOfferFactHint, Instance: collection.AcceptOfferFact{}},
+	{Hint: collection.CollectionActiveUpdaterFactHint, Instance: collection.CollectionActiveUpdaterFact{}},
+	{Hint: collection.CollectionOwnershipTransferFactHint, Instance: collection.CollectionOwnershipTransferFact{}},
+	{Hint: collection.FreezeFactHint, Instance: collection.FreezeFact{}},
+	{Hint: collection.UnfreezeFactHint, Instance: collection.UnfreezeFact{}},
+	{Hint: collection.EditionMintFactHint, Instance: collection.EditionMintFact{}},
+	{Hint: collection.EditionTransferFactHint, Instance: collection.EditionTransferFact{}},
+	{Hint: collection.EditionBurnFactHint, Instance: collection.EditionBurnFact{}},
+	{Hint: collection.FractionalizeFactHint, Instance: collection.FractionalizeFact{}},
+	{Hint: collection.RedeemFactHint, Instance: collection.RedeemFact{}},
+	{Hint: collection.SetUserFactHint, Instance: collection.SetUserFact{}},
+	{Hint: collection.AttachChildFactHint, Instance: collection.AttachChildFact{}},
+	{Hint: collection.DetachChildFactHint, Instance: collection.DetachChildFact{}},
+	{Hint: collection.SwapFactHint, Instance: collection.SwapFact{}},
+	{Hint: collection.RedeemVoucherFactHint, Instance: collection.RedeemVoucherFact{}},
+	{Hint: collection.NFTUnsignFactHint, Instance: collection.NFTUnsignFact{}},
+	{Hint: collection.NFTSignersUpdaterFactHint, Instance: collection.NFTSignersUpdaterFact{}},
+	{Hint: collection.AddWhitesFactHint, Instance: collection.AddWhitesFact{}},
+	{Hint: collection.RemoveWhitesFactHint, Instance: collection.RemoveWhitesFact{}},
 }
 
 func init() {
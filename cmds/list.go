@@ -0,0 +1,102 @@
+package cmds
+
+import (
+	"context"
+
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/ProtoconNet/mitum-nft/nft/collection"
+	"github.com/pkg/errors"
+
+	"github.com/ProtoconNet/mitum-currency/v2/cmds"
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+)
+
+type ListCommand struct {
+	baseCommand
+	cmds.OperationFlags
+	Sender   cmds.AddressFlag        `arg:"" name:"sender" help:"seller address" required:"true"`
+	NFT      NFTIDFlag               `arg:"" name:"nft" help:"target nft; \"<symbol>,<idx>\""`
+	Price    cmds.CurrencyAmountFlag `arg:"" name:"price" help:"listing price (ex: \"<currency>,<amount>\")" required:"true"`
+	Currency cmds.CurrencyIDFlag     `arg:"" name:"currency" help:"currency id" required:"true"`
+	sender   base.Address
+	nft      nft.NFTID
+	price    currency.Amount
+}
+
+func NewListCommand() ListCommand {
+	cmd := NewbaseCommand()
+	return ListCommand{baseCommand: *cmd}
+}
+
+func (cmd *ListCommand) Run(pctx context.Context) error { // nolint:dupl
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	encs = cmd.encs
+	enc = cmd.enc
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *ListCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	if a, err := cmd.Sender.Encode(enc); err != nil {
+		return errors.Wrapf(err, "invalid sender format, %q", cmd.Sender)
+	} else {
+		cmd.sender = a
+	}
+
+	n := nft.NewNFTID(cmd.NFT.collection, cmd.NFT.idx)
+	if err := n.IsValid(nil); err != nil {
+		return err
+	}
+	cmd.nft = n
+
+	price := currency.NewAmount(cmd.Price.Big, cmd.Price.CID)
+	if err := price.IsValid(nil); err != nil {
+		return err
+	}
+	cmd.price = price
+
+	return nil
+}
+
+func (cmd *ListCommand) createOperation() (base.Operation, error) {
+	e := util.StringErrorFunc("failed to create list operation")
+
+	item := collection.NewListItem(cmd.nft, cmd.price, cmd.Currency.CID)
+
+	fact := collection.NewListFact(
+		[]byte(cmd.Token),
+		cmd.sender,
+		[]collection.ListItem{item},
+	)
+
+	op, err := collection.NewList(fact)
+	if err != nil {
+		return nil, e(err, "")
+	}
+	err = op.HashSign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, e(err, "")
+	}
+
+	return op, nil
+}
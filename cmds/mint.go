@@ -26,6 +26,8 @@ type MintCommand struct {
 	Copyrighter      SignerFlag          `name:"copyrighter" help:"nft contents copyrighter \"<address>,<share>\"" optional:""`
 	CreatorTotal     uint                `name:"creator-total" help:"creators total share" optional:""`
 	CopyrighterTotal uint                `name:"copyrighter-total" help:"copyrighters total share" optional:""`
+	Royalty          uint                `name:"royalty" help:"royalty percent of this nft, overriding the collection royalty" optional:""`
+	RoyaltyReceiver  cmds.AddressFlag    `name:"royalty-receiver" help:"receiver of the nft royalty" optional:""`
 	sender           base.Address
 	form             collection.MintForm
 }
@@ -119,7 +121,16 @@ func (cmd *MintCommand) parseFlags() error {
 		return err
 	}
 
-	form := collection.NewMintForm(hash, uri, creators, copyrighters)
+	var receiver base.Address
+	if cmd.RoyaltyReceiver.String() != "" {
+		a, err := cmd.RoyaltyReceiver.Encode(enc)
+		if err != nil {
+			return errors.Wrapf(err, "invalid royalty receiver format, %q", cmd.RoyaltyReceiver)
+		}
+		receiver = a
+	}
+
+	form := collection.NewMintForm(hash, uri, creators, copyrighters, nft.PaymentParameter(cmd.Royalty), receiver)
 	if err := form.IsValid(nil); err != nil {
 		return err
 	}
@@ -0,0 +1,76 @@
+package cmds
+
+import (
+	"context"
+
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/ProtoconNet/mitum-nft/nft/collection"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/pkg/errors"
+)
+
+type NFTInfoCommand struct {
+	baseCommand
+	QueryFlags
+	NFT NFTIDFlag `arg:"" name:"nft" help:"target nft; \"<symbol>,<idx>\"" required:"true"`
+}
+
+type nftInfo struct {
+	NFT         nft.NFT      `json:"nft"`
+	Height      base.Height  `json:"height"`
+	CurrentUser base.Address `json:"current_user"`
+}
+
+func NewNFTInfoCommand() NFTInfoCommand {
+	cmd := NewbaseCommand()
+	return NFTInfoCommand{baseCommand: *cmd}
+}
+
+func (cmd *NFTInfoCommand) Run(pctx context.Context) error {
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	encs = cmd.encs
+	enc = cmd.enc
+
+	n := nft.NewNFTID(cmd.NFT.collection, cmd.NFT.idx)
+	if err := n.IsValid(nil); err != nil {
+		return err
+	}
+
+	qc, err := cmd.client(cmd.encs, cmd.enc)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		_ = qc.close()
+	}()
+
+	st, found, err := qc.getState(collection.StateKeyNFT(n))
+	switch {
+	case err != nil:
+		return errors.Wrapf(err, "failed to get nft, %q", n)
+	case !found:
+		return errors.Errorf("nft not found, %q", n)
+	}
+
+	nv, err := collection.StateNFTValue(st)
+	if err != nil {
+		return errors.Wrapf(err, "nft value not found, %q", n)
+	}
+
+	height, err := qc.lastHeight()
+	if err != nil {
+		return errors.Wrap(err, "failed to get last height")
+	}
+
+	PrettyPrint(cmd.Out, nftInfo{
+		NFT:         nv,
+		Height:      height,
+		CurrentUser: nv.CurrentUser(height),
+	})
+
+	return nil
+}
@@ -0,0 +1,130 @@
+package cmds
+
+import (
+	"context"
+
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/ProtoconNet/mitum-nft/nft/collection"
+	"github.com/pkg/errors"
+
+	"github.com/ProtoconNet/mitum-currency/v2/cmds"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+)
+
+type NFTSignersUpdaterCommand struct {
+	baseCommand
+	cmds.OperationFlags
+	Sender        cmds.AddressFlag    `arg:"" name:"sender" help:"sender address" required:"true"`
+	NFT           NFTIDFlag           `arg:"" name:"nft" help:"target nft; \"<collection>,<idx>\""`
+	Currency      cmds.CurrencyIDFlag `arg:"" name:"currency" help:"currency id" required:"true"`
+	Qualification string              `name:"qualification" help:"target qualification; creator | copyrighter" optional:""`
+	Signer        SignerFlag          `name:"signer" help:"new creator or copyrighter \"<address>,<share>\"" optional:""`
+	SignerTotal   uint                `name:"signer-total" help:"signers total share" optional:""`
+	sender        base.Address
+	nft           nft.NFTID
+	qualification collection.Qualification
+	signers       nft.Signers
+}
+
+func NewNFTSignersUpdaterCommand() NFTSignersUpdaterCommand {
+	cmd := NewbaseCommand()
+	return NFTSignersUpdaterCommand{baseCommand: *cmd}
+}
+
+func (cmd *NFTSignersUpdaterCommand) Run(pctx context.Context) error { // nolint:dupl
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	encs = cmd.encs
+	enc = cmd.enc
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *NFTSignersUpdaterCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	if a, err := cmd.Sender.Encode(enc); err != nil {
+		return errors.Wrapf(err, "invalid sender format, %q", cmd.Sender)
+	} else {
+		cmd.sender = a
+	}
+
+	n := nft.NewNFTID(cmd.NFT.collection, cmd.NFT.idx)
+	if err := n.IsValid(nil); err != nil {
+		return err
+	}
+	cmd.nft = n
+
+	if cmd.Qualification == "" {
+		cmd.qualification = collection.CreatorQualification
+	} else {
+		q := collection.Qualification(cmd.Qualification)
+		if err := q.IsValid(nil); err != nil {
+			return err
+		}
+		cmd.qualification = q
+	}
+
+	var sgns []nft.Signer
+	if len(cmd.Signer.address) > 0 {
+		a, err := cmd.Signer.Encode(enc)
+		if err != nil {
+			return errors.Wrapf(err, "invalid signer format, %q", cmd.Signer)
+		}
+
+		signer := nft.NewSigner(a, cmd.Signer.share, false)
+		if err = signer.IsValid(nil); err != nil {
+			return err
+		}
+
+		sgns = append(sgns, signer)
+	}
+
+	signers := nft.NewSigners(cmd.SignerTotal, sgns)
+	if err := signers.IsValid(nil); err != nil {
+		return err
+	}
+	cmd.signers = signers
+
+	return nil
+}
+
+func (cmd *NFTSignersUpdaterCommand) createOperation() (base.Operation, error) {
+	e := util.StringErrorFunc("failed to create nft-signers-updater operation")
+
+	fact := collection.NewNFTSignersUpdaterFact(
+		[]byte(cmd.Token),
+		cmd.sender,
+		cmd.nft,
+		cmd.qualification,
+		cmd.signers,
+		cmd.Currency.CID,
+	)
+
+	op, err := collection.NewNFTSignersUpdater(fact)
+	if err != nil {
+		return nil, e(err, "")
+	}
+	err = op.HashSign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, e(err, "")
+	}
+
+	return op, nil
+}
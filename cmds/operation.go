@@ -5,45 +5,103 @@ import (
 )
 
 type OperationCommand struct {
-	CreateAccount           cmds.CreateAccountCommand         `cmd:"" name:"create-account" help:"create new account"`
-	KeyUpdater              cmds.KeyUpdaterCommand            `cmd:"" name:"key-updater" help:"update account keys"`
-	Transfer                cmds.TransferCommand              `cmd:"" name:"transfer" help:"transfer amounts to receiver"`
-	CreateContractAccount   cmds.CreateContractAccountCommand `cmd:"" name:"create-contract-account" help:"create new contract account"`
-	Withdraw                cmds.WithdrawCommand              `cmd:"" name:"withdraw" help:"withdraw amounts from target contract account"`
-	CurrencyRegister        cmds.CurrencyRegisterCommand      `cmd:"" name:"currency-register" help:"register new currency"`
-	CurrencyPolicyUpdater   cmds.CurrencyPolicyUpdaterCommand `cmd:"" name:"currency-policy-updater" help:"update currency policy"`
-	SuffrageInflation       cmds.SuffrageInflationCommand     `cmd:"" name:"suffrage-inflation" help:"suffrage inflation operation"`
-	CollectionRegister      CollectionRegisterCommand         `cmd:"" name:"collection-register" help:"register new collection design"`
-	CollectionPolicyUpdater CollectionPolicyUpdaterCommand    `cmd:"" name:"collection-policy-updater" help:"update collection design"`
-	Mint                    MintCommand                       `cmd:"" name:"mint" help:"mint new nft to collection"`
-	NFTTransfer             NFTTransferCommand                `cmd:"" name:"nft-transfer" help:"transfer nfts to receiver"`
-	Delegate                DelegateCommand                   `cmd:"" name:"delegate" help:"delegate agent or cancel agent delegation"`
-	Approve                 ApproveCommand                    `cmd:"" name:"approve" help:"approve account for nft"`
-	NFTSign                 NFTSignCommand                    `cmd:"" name:"nft-sign" help:"sign nft as creator | copyrighter"`
-	SuffrageCandidate       cmds.SuffrageCandidateCommand     `cmd:"" name:"suffrage-candidate" help:"suffrage candidate operation"`
-	SuffrageJoin            cmds.SuffrageJoinCommand          `cmd:"" name:"suffrage-join" help:"suffrage join operation"`
-	SuffrageDisjoin         cmds.SuffrageDisjoinCommand       `cmd:"" name:"suffrage-disjoin" help:"suffrage disjoin operation"` // revive:disable-line:line-length-limit
+	CreateAccount               cmds.CreateAccountCommand          `cmd:"" name:"create-account" help:"create new account"`
+	KeyUpdater                  cmds.KeyUpdaterCommand             `cmd:"" name:"key-updater" help:"update account keys"`
+	Transfer                    cmds.TransferCommand               `cmd:"" name:"transfer" help:"transfer amounts to receiver"`
+	CreateContractAccount       cmds.CreateContractAccountCommand  `cmd:"" name:"create-contract-account" help:"create new contract account"`
+	Withdraw                    cmds.WithdrawCommand               `cmd:"" name:"withdraw" help:"withdraw amounts from target contract account"`
+	CurrencyRegister            cmds.CurrencyRegisterCommand       `cmd:"" name:"currency-register" help:"register new currency"`
+	CurrencyPolicyUpdater       cmds.CurrencyPolicyUpdaterCommand  `cmd:"" name:"currency-policy-updater" help:"update currency policy"`
+	SuffrageInflation           cmds.SuffrageInflationCommand      `cmd:"" name:"suffrage-inflation" help:"suffrage inflation operation"`
+	CollectionRegister          CollectionRegisterCommand          `cmd:"" name:"collection-register" help:"register new collection design"`
+	CollectionPolicyUpdater     CollectionPolicyUpdaterCommand     `cmd:"" name:"collection-policy-updater" help:"update collection design"`
+	Mint                        MintCommand                        `cmd:"" name:"mint" help:"mint new nft to collection"`
+	NFTTransfer                 NFTTransferCommand                 `cmd:"" name:"nft-transfer" help:"transfer nfts to receiver"`
+	Delegate                    DelegateCommand                    `cmd:"" name:"delegate" help:"delegate agent or cancel agent delegation"`
+	Approve                     ApproveCommand                     `cmd:"" name:"approve" help:"approve account for nft"`
+	NFTSign                     NFTSignCommand                     `cmd:"" name:"nft-sign" help:"sign nft as creator | copyrighter"`
+	Burn                        BurnCommand                        `cmd:"" name:"burn" help:"burn nfts"`
+	NFTSale                     NFTSaleCommand                     `cmd:"" name:"nft-sale" help:"sell nfts to buyer for price"`
+	List                        ListCommand                        `cmd:"" name:"list" help:"list nft for sale at fixed price"`
+	Delist                      DelistCommand                      `cmd:"" name:"delist" help:"cancel nft listing"`
+	Buy                         BuyCommand                         `cmd:"" name:"buy" help:"buy listed nft"`
+	AuctionCreate               AuctionCreateCommand               `cmd:"" name:"auction-create" help:"start english auction for nft"`
+	Bid                         BidCommand                         `cmd:"" name:"bid" help:"bid on nft auction"`
+	AuctionSettle               AuctionSettleCommand               `cmd:"" name:"auction-settle" help:"settle ended nft auction"`
+	MakeOffer                   MakeOfferCommand                   `cmd:"" name:"make-offer" help:"make offer on nft"`
+	CancelOffer                 CancelOfferCommand                 `cmd:"" name:"cancel-offer" help:"cancel offer and unlock amount"`
+	AcceptOffer                 AcceptOfferCommand                 `cmd:"" name:"accept-offer" help:"accept offer on owned nft"`
+	CollectionActiveUpdater     CollectionActiveUpdaterCommand     `cmd:"" name:"collection-active-updater" help:"activate or deactivate collection"`
+	CollectionOwnershipTransfer CollectionOwnershipTransferCommand `cmd:"" name:"collection-ownership-transfer" help:"move collection to new parent or creator"`
+	Freeze                      FreezeCommand                      `cmd:"" name:"freeze" help:"freeze nft"`
+	Unfreeze                    UnfreezeCommand                    `cmd:"" name:"unfreeze" help:"unfreeze nft"`
+	EditionMint                 EditionMintCommand                 `cmd:"" name:"edition-mint" help:"mint semi-fungible edition"`
+	EditionTransfer             EditionTransferCommand             `cmd:"" name:"edition-transfer" help:"transfer semi-fungible edition"`
+	EditionBurn                 EditionBurnCommand                 `cmd:"" name:"edition-burn" help:"burn semi-fungible edition"`
+	Fractionalize               FractionalizeCommand               `cmd:"" name:"fractionalize" help:"lock nft into vault and issue shares"`
+	Redeem                      RedeemCommand                      `cmd:"" name:"redeem" help:"burn full share supply to release nft"`
+	SetUser                     SetUserCommand                     `cmd:"" name:"set-user" help:"set user of nft until expiry height"`
+	AttachChild                 AttachChildCommand                 `cmd:"" name:"attach-child" help:"attach child nft to parent nft"`
+	DetachChild                 DetachChildCommand                 `cmd:"" name:"detach-child" help:"detach child nft from parent nft"`
+	Swap                        SwapCommand                        `cmd:"" name:"swap" help:"swap nfts between two accounts"`
+	RedeemVoucher               RedeemVoucherCommand               `cmd:"" name:"redeem-voucher" help:"redeem voucher to mint nft"`
+	NFTUnsign                   NFTUnsignCommand                   `cmd:"" name:"nft-unsign" help:"revoke nft sign as creator | copyrighter"`
+	NFTSignersUpdater           NFTSignersUpdaterCommand           `cmd:"" name:"nft-signers-updater" help:"replace nft creators or copyrighters"`
+	AddWhites                   AddWhitesCommand                   `cmd:"" name:"add-whites" help:"add whitelisted addresses to collection policy"`
+	RemoveWhites                RemoveWhitesCommand                `cmd:"" name:"remove-whites" help:"remove whitelisted addresses from collection policy"`
+	SuffrageCandidate           cmds.SuffrageCandidateCommand      `cmd:"" name:"suffrage-candidate" help:"suffrage candidate operation"`
+	SuffrageJoin                cmds.SuffrageJoinCommand           `cmd:"" name:"suffrage-join" help:"suffrage join operation"`
+	SuffrageDisjoin             cmds.SuffrageDisjoinCommand        `cmd:"" name:"suffrage-disjoin" help:"suffrage disjoin operation"` // revive:disable-line:line-length-limit
 }
 
 func NewOperationCommand() OperationCommand {
 	return OperationCommand{
-		CreateAccount:           cmds.NewCreateAccountCommand(),
-		KeyUpdater:              cmds.NewKeyUpdaterCommand(),
-		Transfer:                cmds.NewTransferCommand(),
-		CreateContractAccount:   cmds.NewCreateContractAccountCommand(),
-		Withdraw:                cmds.NewWithdrawCommand(),
-		CurrencyRegister:        cmds.NewCurrencyRegisterCommand(),
-		CurrencyPolicyUpdater:   cmds.NewCurrencyPolicyUpdaterCommand(),
-		SuffrageInflation:       cmds.NewSuffrageInflationCommand(),
-		CollectionRegister:      NewCollectionRegisterCommand(),
-		CollectionPolicyUpdater: NewCollectionPolicyUpdaterCommand(),
-		Mint:                    NewMintCommand(),
-		NFTTransfer:             NewNFTTranfserCommand(),
-		Delegate:                NewDelegateCommand(),
-		Approve:                 NewApproveCommand(),
-		NFTSign:                 NewNFTSignCommand(),
-		SuffrageCandidate:       cmds.NewSuffrageCandidateCommand(),
-		SuffrageJoin:            cmds.NewSuffrageJoinCommand(),
-		SuffrageDisjoin:         cmds.NewSuffrageDisjoinCommand(),
+		CreateAccount:               cmds.NewCreateAccountCommand(),
+		KeyUpdater:                  cmds.NewKeyUpdaterCommand(),
+		Transfer:                    cmds.NewTransferCommand(),
+		CreateContractAccount:       cmds.NewCreateContractAccountCommand(),
+		Withdraw:                    cmds.NewWithdrawCommand(),
+		CurrencyRegister:            cmds.NewCurrencyRegisterCommand(),
+		CurrencyPolicyUpdater:       cmds.NewCurrencyPolicyUpdaterCommand(),
+		SuffrageInflation:           cmds.NewSuffrageInflationCommand(),
+		CollectionRegister:          NewCollectionRegisterCommand(),
+		CollectionPolicyUpdater:     NewCollectionPolicyUpdaterCommand(),
+		Mint:                        NewMintCommand(),
+		NFTTransfer:                 NewNFTTranfserCommand(),
+		Delegate:                    NewDelegateCommand(),
+		Approve:                     NewApproveCommand(),
+		NFTSign:                     NewNFTSignCommand(),
+		Burn:                        NewBurnCommand(),
+		NFTSale:                     NewNFTSaleCommand(),
+		List:                        NewListCommand(),
+		Delist:                      NewDelistCommand(),
+		Buy:                         NewBuyCommand(),
+		AuctionCreate:               NewAuctionCreateCommand(),
+		Bid:                         NewBidCommand(),
+		AuctionSettle:               NewAuctionSettleCommand(),
+		MakeOffer:                   NewMakeOfferCommand(),
+		CancelOffer:                 NewCancelOfferCommand(),
+		AcceptOffer:                 NewAcceptOfferCommand(),
+		CollectionActiveUpdater:     NewCollectionActiveUpdaterCommand(),
+		CollectionOwnershipTransfer: NewCollectionOwnershipTransferCommand(),
+		Freeze:                      NewFreezeCommand(),
+		Unfreeze:                    NewUnfreezeCommand(),
+		EditionMint:                 NewEditionMintCommand(),
+		EditionTransfer:             NewEditionTransferCommand(),
+		EditionBurn:                 NewEditionBurnCommand(),
+		Fractionalize:               NewFractionalizeCommand(),
+		Redeem:                      NewRedeemCommand(),
+		SetUser:                     NewSetUserCommand(),
+		AttachChild:                 NewAttachChildCommand(),
+		DetachChild:                 NewDetachChildCommand(),
+		Swap:                        NewSwapCommand(),
+		RedeemVoucher:               NewRedeemVoucherCommand(),
+		NFTUnsign:                   NewNFTUnsignCommand(),
+		NFTSignersUpdater:           NewNFTSignersUpdaterCommand(),
+		AddWhites:                   NewAddWhitesCommand(),
+		RemoveWhites:                NewRemoveWhitesCommand(),
+		SuffrageCandidate:           cmds.NewSuffrageCandidateCommand(),
+		SuffrageJoin:                cmds.NewSuffrageJoinCommand(),
+		SuffrageDisjoin:             cmds.NewSuffrageDisjoinCommand(),
 	}
 }
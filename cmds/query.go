@@ -0,0 +1,110 @@
+package cmds
+
+import (
+	"context"
+	"time"
+
+	"github.com/ProtoconNet/mitum2/base"
+	isaacnetwork "github.com/ProtoconNet/mitum2/isaac/network"
+	"github.com/ProtoconNet/mitum2/launch"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+	"github.com/pkg/errors"
+)
+
+type QueryCommand struct {
+	RoyaltyInfo RoyaltyInfoCommand `cmd:"" name:"royalty-info" help:"royalty receivers and amounts of nft for sale price"`
+	NFT         NFTInfoCommand     `cmd:"" name:"nft" help:"nft with its current user"`
+	Collections CollectionsCommand `cmd:"" name:"collections" help:"collections of contract account"`
+}
+
+func NewQueryCommand() QueryCommand {
+	return QueryCommand{
+		RoyaltyInfo: NewRoyaltyInfoCommand(),
+		NFT:         NewNFTInfoCommand(),
+		Collections: NewCollectionsCommand(),
+	}
+}
+
+type QueryFlags struct {
+	NetworkID string              `arg:"" name:"network-id" help:"network-id" required:"true"`
+	Remote    launch.ConnInfoFlag `name:"remote" help:"remote node conn info" placeholder:"ConnInfo" default:"localhost:4321"`
+	Timeout   time.Duration       `name:"timeout" help:"timeout" placeholder:"duration" default:"10s"`
+}
+
+type queryClient struct {
+	getState   base.GetStateFunc
+	lastHeight func() (base.Height, error)
+	close      func() error
+}
+
+// client connects to the remote node; close it after use.
+func (flags *QueryFlags) client(encs *encoder.Encoders, enc *jsonenc.Encoder) (queryClient, error) {
+	remote, err := flags.Remote.ConnInfo()
+	if err != nil {
+		return queryClient{}, err
+	}
+
+	timeout := flags.Timeout
+	if timeout < 1 {
+		timeout = time.Second * 5 //nolint:gomnd //...
+	}
+
+	client := launch.NewNetworkClient(encs, enc, timeout, base.NetworkID([]byte(flags.NetworkID)))
+
+	getState := func(key string) (base.State, bool, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+
+		response, v, cancelrequest, err := client.Request(ctx, remote, isaacnetwork.NewStateRequestHeader(key, nil), nil)
+
+		switch {
+		case err != nil:
+			return nil, false, err
+		case response.Err() != nil:
+			return nil, false, response.Err()
+		}
+
+		defer func() {
+			_ = cancelrequest()
+		}()
+
+		if v == nil {
+			return nil, false, nil
+		}
+
+		st, ok := v.(base.State)
+		if !ok {
+			return nil, false, errors.Errorf("expected base.State, not %T", v)
+		}
+
+		return st, true, nil
+	}
+
+	lastHeight := func() (base.Height, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+
+		response, v, cancelrequest, err := client.Request(ctx, remote, isaacnetwork.NewLastBlockMapRequestHeader(nil), nil)
+
+		switch {
+		case err != nil:
+			return base.NilHeight, err
+		case response.Err() != nil:
+			return base.NilHeight, response.Err()
+		}
+
+		defer func() {
+			_ = cancelrequest()
+		}()
+
+		m, ok := v.(base.BlockMap)
+		if !ok {
+			return base.NilHeight, errors.Errorf("expected base.BlockMap, not %T", v)
+		}
+
+		return m.Manifest().Height(), nil
+	}
+
+	return queryClient{getState: getState, lastHeight: lastHeight, close: client.Close}, nil
+}
@@ -0,0 +1,142 @@
+package cmds
+
+import (
+	"context"
+
+	extensioncurrency "github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/ProtoconNet/mitum-nft/nft/collection"
+
+	"github.com/pkg/errors"
+
+	"github.com/ProtoconNet/mitum-currency/v2/cmds"
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+)
+
+type RedeemVoucherCommand struct {
+	baseCommand
+	cmds.OperationFlags
+	Sender       cmds.AddressFlag        `arg:"" name:"sender" help:"sender address" required:"true"`
+	Collection   string                  `arg:"" name:"collection" help:"collection symbol" required:"true"`
+	Hash         string                  `arg:"" name:"hash" help:"nft hash" required:"true"`
+	Uri          string                  `arg:"" name:"uri" help:"nft uri" required:"true"`
+	Price        cmds.CurrencyAmountFlag `arg:"" name:"price" help:"price (ex: \"<currency>,<amount>\")" required:"true"`
+	Nonce        uint64                  `arg:"" name:"nonce" help:"voucher nonce" required:"true"`
+	Signer       cmds.AddressFlag        `arg:"" name:"signer" help:"voucher signer address" required:"true"`
+	Currency     cmds.CurrencyIDFlag     `arg:"" name:"currency" help:"currency id" required:"true"`
+	SignerKey    cmds.PrivatekeyFlag     `name:"signer-key" help:"privatekey to sign voucher" required:"true"`
+	Creator      SignerFlag              `name:"creator" help:"nft contents creator \"<address>,<share>\"" optional:""`
+	CreatorTotal uint                    `name:"creator-total" help:"creators total share" optional:""`
+	sender       base.Address
+	voucher      collection.Voucher
+}
+
+func NewRedeemVoucherCommand() RedeemVoucherCommand {
+	cmd := NewbaseCommand()
+	return RedeemVoucherCommand{baseCommand: *cmd}
+}
+
+func (cmd *RedeemVoucherCommand) Run(pctx context.Context) error { // nolint:dupl
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	encs = cmd.encs
+	enc = cmd.enc
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *RedeemVoucherCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	a, err := cmd.Sender.Encode(enc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid sender format, %q", cmd.Sender)
+	}
+	cmd.sender = a
+
+	signer, err := cmd.Signer.Encode(enc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid signer format, %q", cmd.Signer)
+	}
+
+	hash := nft.NFTHash(cmd.Hash)
+	if err := hash.IsValid(nil); err != nil {
+		return err
+	}
+
+	uri := nft.URI(cmd.Uri)
+	if err := uri.IsValid(nil); err != nil {
+		return err
+	}
+
+	var crts = []nft.Signer{}
+	if len(cmd.Creator.address) > 0 {
+		a, err := cmd.Creator.Encode(enc)
+		if err != nil {
+			return errors.Wrapf(err, "invalid creator format, %q", cmd.Creator)
+		}
+
+		signer := nft.NewSigner(a, cmd.Creator.share, false)
+		if err = signer.IsValid(nil); err != nil {
+			return err
+		}
+
+		crts = append(crts, signer)
+	}
+
+	creators := nft.NewSigners(cmd.CreatorTotal, crts)
+	if err := creators.IsValid(nil); err != nil {
+		return err
+	}
+
+	price := currency.NewAmount(cmd.Price.Big, cmd.Price.CID)
+	if err := price.IsValid(nil); err != nil {
+		return err
+	}
+
+	voucher := collection.NewVoucher(extensioncurrency.ContractID(cmd.Collection), hash, uri, creators, price, cmd.Nonce, signer)
+	if err := voucher.Sign(cmd.SignerKey, cmd.NetworkID.NetworkID()); err != nil {
+		return errors.Wrap(err, "failed to sign voucher")
+	}
+
+	if err := voucher.IsValid(cmd.NetworkID.NetworkID()); err != nil {
+		return err
+	}
+	cmd.voucher = voucher
+
+	return nil
+}
+
+func (cmd *RedeemVoucherCommand) createOperation() (base.Operation, error) { // nolint:dupl
+	e := util.StringErrorFunc("failed to create redeem-voucher operation")
+
+	fact := collection.NewRedeemVoucherFact([]byte(cmd.Token), cmd.sender, cmd.voucher, cmd.Currency.CID)
+
+	op, err := collection.NewRedeemVoucher(fact)
+	if err != nil {
+		return nil, e(err, "")
+	}
+	err = op.HashSign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, e(err, "")
+	}
+
+	return op, nil
+}
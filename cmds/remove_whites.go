@@ -0,0 +1,101 @@
+package cmds
+
+import (
+	"context"
+
+	extensioncurrency "github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+	"github.com/ProtoconNet/mitum-nft/nft/collection"
+	"github.com/pkg/errors"
+
+	"github.com/ProtoconNet/mitum-currency/v2/cmds"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+)
+
+type RemoveWhitesCommand struct {
+	baseCommand
+	cmds.OperationFlags
+	Sender     cmds.AddressFlag    `arg:"" name:"sender" help:"sender address" required:"true"`
+	Collection string              `arg:"" name:"collection" help:"collection symbol" required:"true"`
+	White      cmds.AddressFlag    `arg:"" name:"white" help:"address to remove from whitelist" required:"true"`
+	Currency   cmds.CurrencyIDFlag `arg:"" name:"currency" help:"currency id" required:"true"`
+	sender     base.Address
+	collection extensioncurrency.ContractID
+	white      base.Address
+}
+
+func NewRemoveWhitesCommand() RemoveWhitesCommand {
+	cmd := NewbaseCommand()
+	return RemoveWhitesCommand{baseCommand: *cmd}
+}
+
+func (cmd *RemoveWhitesCommand) Run(pctx context.Context) error { // nolint:dupl
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	encs = cmd.encs
+	enc = cmd.enc
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *RemoveWhitesCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	if a, err := cmd.Sender.Encode(enc); err != nil {
+		return errors.Wrapf(err, "invalid sender format, %q", cmd.Sender)
+	} else {
+		cmd.sender = a
+	}
+
+	col := extensioncurrency.ContractID(cmd.Collection)
+	if err := col.IsValid(nil); err != nil {
+		return err
+	}
+	cmd.collection = col
+
+	if a, err := cmd.White.Encode(enc); err != nil {
+		return errors.Wrapf(err, "invalid white format, %q", cmd.White)
+	} else {
+		cmd.white = a
+	}
+
+	return nil
+}
+
+func (cmd *RemoveWhitesCommand) createOperation() (base.Operation, error) {
+	e := util.StringErrorFunc("failed to create remove-whites operation")
+
+	fact := collection.NewRemoveWhitesFact(
+		[]byte(cmd.Token),
+		cmd.sender,
+		cmd.collection,
+		[]base.Address{cmd.white},
+		cmd.Currency.CID,
+	)
+
+	op, err := collection.NewRemoveWhites(fact)
+	if err != nil {
+		return nil, e(err, "")
+	}
+	err = op.HashSign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, e(err, "")
+	}
+
+	return op, nil
+}
@@ -0,0 +1,72 @@
+package cmds
+
+import (
+	"context"
+
+	"github.com/ProtoconNet/mitum-currency/v2/cmds"
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/ProtoconNet/mitum-nft/nft/collection"
+	"github.com/pkg/errors"
+)
+
+type RoyaltyInfoCommand struct {
+	baseCommand
+	QueryFlags
+	NFT   NFTIDFlag               `arg:"" name:"nft" help:"target nft; \"<symbol>,<idx>\"" required:"true"`
+	Price cmds.CurrencyAmountFlag `arg:"" name:"price" help:"sale price (ex: \"<currency>,<amount>\")" required:"true"`
+}
+
+type royaltyInfo struct {
+	NFT       nft.NFTID                 `json:"nft"`
+	Price     currency.Amount           `json:"price"`
+	Royalties []collection.RoyaltyShare `json:"royalties"`
+	Rest      currency.Amount           `json:"rest"`
+}
+
+func NewRoyaltyInfoCommand() RoyaltyInfoCommand {
+	cmd := NewbaseCommand()
+	return RoyaltyInfoCommand{baseCommand: *cmd}
+}
+
+func (cmd *RoyaltyInfoCommand) Run(pctx context.Context) error {
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	encs = cmd.encs
+	enc = cmd.enc
+
+	n := nft.NewNFTID(cmd.NFT.collection, cmd.NFT.idx)
+	if err := n.IsValid(nil); err != nil {
+		return err
+	}
+
+	price := currency.NewAmount(cmd.Price.Big, cmd.Price.CID)
+	if err := price.IsValid(nil); err != nil {
+		return err
+	}
+
+	qc, err := cmd.client(cmd.encs, cmd.enc)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		_ = qc.close()
+	}()
+
+	shares, rest, err := collection.RoyaltyInfo(n, price, qc.getState)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get royalty info, %q", n)
+	}
+
+	PrettyPrint(cmd.Out, royaltyInfo{
+		NFT:       n,
+		Price:     price,
+		Royalties: shares,
+		Rest:      rest,
+	})
+
+	return nil
+}
@@ -0,0 +1,103 @@
+package cmds
+
+import (
+	"context"
+
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/ProtoconNet/mitum-nft/nft/collection"
+	"github.com/pkg/errors"
+
+	"github.com/ProtoconNet/mitum-currency/v2/cmds"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+)
+
+type SetUserCommand struct {
+	baseCommand
+	cmds.OperationFlags
+	Sender   cmds.AddressFlag    `arg:"" name:"sender" help:"sender address" required:"true"`
+	User     cmds.AddressFlag    `arg:"" name:"user" help:"user account address" required:"true"`
+	Expiry   uint64              `arg:"" name:"expiry" help:"last height the user role is valid" required:"true"`
+	NFT      NFTIDFlag           `arg:"" name:"nft" help:"target nft to lend; \"<collection>,<idx>\""`
+	Currency cmds.CurrencyIDFlag `arg:"" name:"currency" help:"currency id" required:"true"`
+	sender   base.Address
+	user     base.Address
+	nft      nft.NFTID
+}
+
+func NewSetUserCommand() SetUserCommand {
+	cmd := NewbaseCommand()
+	return SetUserCommand{baseCommand: *cmd}
+}
+
+func (cmd *SetUserCommand) Run(pctx context.Context) error { // nolint:dupl
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	encs = cmd.encs
+	enc = cmd.enc
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *SetUserCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	if a, err := cmd.Sender.Encode(enc); err != nil {
+		return errors.Wrapf(err, "invalid sender format, %q", cmd.Sender)
+	} else {
+		cmd.sender = a
+	}
+
+	if a, err := cmd.User.Encode(enc); err != nil {
+		return errors.Wrapf(err, "invalid user format, %q", cmd.User)
+	} else {
+		cmd.user = a
+	}
+
+	n := nft.NewNFTID(cmd.NFT.collection, cmd.NFT.idx)
+	if err := n.IsValid(nil); err != nil {
+		return err
+	}
+	cmd.nft = n
+
+	return nil
+
+}
+
+func (cmd *SetUserCommand) createOperation() (base.Operation, error) {
+	e := util.StringErrorFunc("failed to create set-user operation")
+
+	item := collection.NewSetUserItem(cmd.user, base.Height(cmd.Expiry), cmd.nft, cmd.Currency.CID)
+
+	fact := collection.NewSetUserFact(
+		[]byte(cmd.Token),
+		cmd.sender,
+		[]collection.SetUserItem{item},
+	)
+
+	op, err := collection.NewSetUser(fact)
+	if err != nil {
+		return nil, e(err, "")
+	}
+	err = op.HashSign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, e(err, "")
+	}
+
+	return op, nil
+}
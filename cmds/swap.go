@@ -0,0 +1,122 @@
+package cmds
+
+import (
+	"context"
+
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/ProtoconNet/mitum-nft/nft/collection"
+	"github.com/pkg/errors"
+
+	"github.com/ProtoconNet/mitum-currency/v2/cmds"
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+)
+
+type SwapCommand struct {
+	baseCommand
+	cmds.OperationFlags
+	Sender        cmds.AddressFlag        `arg:"" name:"sender" help:"sender address" required:"true"`
+	NFT           NFTIDFlag               `arg:"" name:"nft" help:"nft given by sender; \"<symbol>,<idx>\""`
+	Counterparty  cmds.AddressFlag        `arg:"" name:"counterparty" help:"counterparty address" required:"true"`
+	CounterNFT    NFTIDFlag               `arg:"" name:"counter-nft" help:"nft given by counterparty; \"<symbol>,<idx>\""`
+	Currency      cmds.CurrencyIDFlag     `arg:"" name:"currency" help:"currency id" required:"true"`
+	Amount        cmds.CurrencyAmountFlag `name:"amount" help:"amount added by sender (ex: \"<currency>,<amount>\")"`
+	CounterAmount cmds.CurrencyAmountFlag `name:"counter-amount" help:"amount added by counterparty (ex: \"<currency>,<amount>\")"`
+	left          collection.SwapSide
+	right         collection.SwapSide
+}
+
+func NewSwapCommand() SwapCommand {
+	cmd := NewbaseCommand()
+	return SwapCommand{baseCommand: *cmd}
+}
+
+func (cmd *SwapCommand) Run(pctx context.Context) error { // nolint:dupl
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	encs = cmd.encs
+	enc = cmd.enc
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *SwapCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	left, err := cmd.parseSide(cmd.Sender, cmd.NFT, cmd.Amount)
+	if err != nil {
+		return err
+	}
+	cmd.left = left
+
+	right, err := cmd.parseSide(cmd.Counterparty, cmd.CounterNFT, cmd.CounterAmount)
+	if err != nil {
+		return err
+	}
+	cmd.right = right
+
+	return nil
+}
+
+func (cmd *SwapCommand) parseSide(
+	sender cmds.AddressFlag, nid NFTIDFlag, amount cmds.CurrencyAmountFlag,
+) (collection.SwapSide, error) {
+	a, err := sender.Encode(enc)
+	if err != nil {
+		return collection.SwapSide{}, errors.Wrapf(err, "invalid sender format, %q", sender)
+	}
+
+	n := nft.NewNFTID(nid.collection, nid.idx)
+	if err := n.IsValid(nil); err != nil {
+		return collection.SwapSide{}, err
+	}
+
+	var amounts []currency.Amount
+	if len(amount.CID) > 0 {
+		am := currency.NewAmount(amount.Big, amount.CID)
+		if err := am.IsValid(nil); err != nil {
+			return collection.SwapSide{}, err
+		}
+		amounts = append(amounts, am)
+	}
+
+	return collection.NewSwapSide(a, []nft.NFTID{n}, amounts), nil
+}
+
+func (cmd *SwapCommand) createOperation() (base.Operation, error) {
+	e := util.StringErrorFunc("failed to create swap operation")
+
+	fact := collection.NewSwapFact(
+		[]byte(cmd.Token),
+		cmd.left,
+		cmd.right,
+		cmd.Currency.CID,
+	)
+
+	op, err := collection.NewSwap(fact)
+	if err != nil {
+		return nil, e(err, "")
+	}
+	err = op.HashSign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, e(err, "")
+	}
+
+	return op, nil
+}
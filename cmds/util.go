@@ -177,6 +177,35 @@ func POperationProcessorsMap(ctx context.Context) (context.Context, error) {
 	opr.SetProcessor(collection.DelegateHint, collection.NewDelegateProcessor())
 	opr.SetProcessor(collection.ApproveHint, collection.NewApproveProcessor())
 	opr.SetProcessor(collection.NFTSignHint, collection.NewNFTSignProcessor())
+	opr.SetProcessor(collection.BurnHint, collection.NewBurnProcessor())
+	opr.SetProcessor(collection.NFTSaleHint, collection.NewNFTSaleProcessor())
+	opr.SetProcessor(collection.ListHint, collection.NewListProcessor())
+	opr.SetProcessor(collection.DelistHint, collection.NewDelistProcessor())
+	opr.SetProcessor(collection.BuyHint, collection.NewBuyProcessor())
+	opr.SetProcessor(collection.AuctionCreateHint, collection.NewAuctionCreateProcessor())
+	opr.SetProcessor(collection.BidHint, collection.NewBidProcessor())
+	opr.SetProcessor(collection.AuctionSettleHint, collection.NewAuctionSettleProcessor())
+	opr.SetProcessor(collection.MakeOfferHint, collection.NewMakeOfferProcessor())
+	opr.SetProcessor(collection.CancelOfferHint, collection.NewCancelOfferProcessor())
+	opr.SetProcessor(collection.AcceptOfferHint, collection.NewAcceptOfferProcessor())
+	opr.SetProcessor(collection.CollectionActiveUpdaterHint, collection.NewCollectionActiveUpdaterProcessor())
+	opr.SetProcessor(collection.CollectionOwnershipTransferHint, collection.NewCollectionOwnershipTransferProcessor())
+	opr.SetProcessor(collection.FreezeHint, collection.NewFreezeProcessor())
+	opr.SetProcessor(collection.UnfreezeHint, collection.NewUnfreezeProcessor())
+	opr.SetProcessor(collection.EditionMintHint, collection.NewEditionMintProcessor())
+	opr.SetProcessor(collection.EditionTransferHint, collection.NewEditionTransferProcessor())
+	opr.SetProcessor(collection.EditionBurnHint, collection.NewEditionBurnProcessor())
+	opr.SetProcessor(collection.FractionalizeHint, collection.NewFractionalizeProcessor())
+	opr.SetProcessor(collection.RedeemHint, collection.NewRedeemProcessor())
+	opr.SetProcessor(collection.SetUserHint, collection.NewSetUserProcessor())
+	opr.SetProcessor(collection.AttachChildHint, collection.NewAttachChildProcessor())
+	opr.SetProcessor(collection.DetachChildHint, collection.NewDetachChildProcessor())
+	opr.SetProcessor(collection.SwapHint, collection.NewSwapProcessor())
+	opr.SetProcessor(collection.RedeemVoucherHint, collection.NewRedeemVoucherProcessor())
+	opr.SetProcessor(collection.NFTUnsignHint, collection.NewNFTUnsignProcessor())
+	opr.SetProcessor(collection.NFTSignersUpdaterHint, collection.NewNFTSignersUpdaterProcessor())
+	opr.SetProcessor(collection.AddWhitesHint, collection.NewAddWhitesProcessor())
+	opr.SetProcessor(collection.RemoveWhitesHint, collection.NewRemoveWhitesProcessor())
 
 	_ = set.Add(currency.CreateAccountsHint, func(height base.Height) (base.OperationProcessor, error) {
 		return opr.New(
@@ -313,6 +342,267 @@ func POperationProcessorsMap(ctx context.Context) (context.Context, error) {
 		)
 	})
 
+	_ = set.Add(collection.BurnHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
+	_ = set.Add(collection.NFTSaleHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
+	_ = set.Add(collection.ListHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
+	_ = set.Add(collection.DelistHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
+	_ = set.Add(collection.BuyHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
+	_ = set.Add(collection.AuctionCreateHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
+	_ = set.Add(collection.BidHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
+	_ = set.Add(collection.AuctionSettleHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
+	_ = set.Add(collection.MakeOfferHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
+	_ = set.Add(collection.CancelOfferHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
+	_ = set.Add(collection.AcceptOfferHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
+	_ = set.Add(collection.CollectionActiveUpdaterHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
+	_ = set.Add(collection.CollectionOwnershipTransferHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
+	_ = set.Add(collection.FreezeHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
+	_ = set.Add(collection.UnfreezeHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
+	_ = set.Add(collection.EditionMintHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
+	_ = set.Add(collection.EditionTransferHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
+	_ = set.Add(collection.EditionBurnHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
+	_ = set.Add(collection.FractionalizeHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
+	_ = set.Add(collection.RedeemHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
+	_ = set.Add(collection.SetUserHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
+	_ = set.Add(collection.AttachChildHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
+	_ = set.Add(collection.DetachChildHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
+	_ = set.Add(collection.SwapHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
+	_ = set.Add(collection.RedeemVoucherHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
+	_ = set.Add(collection.NFTUnsignHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
+	_ = set.Add(collection.NFTSignersUpdaterHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
+	_ = set.Add(collection.AddWhitesHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
+	_ = set.Add(collection.RemoveWhitesHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
 	_ = set.Add(isaacoperation.SuffrageCandidateHint, func(height base.Height) (base.OperationProcessor, error) {
 		policy := db.LastNetworkPolicy()
 		if policy == nil { // NOTE Usually it means empty block data
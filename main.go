@@ -30,6 +30,7 @@ type CLI struct { //nolint:govet //...
 	Init      cmds.INITCommand             `cmd:"" help:"init node"`
 	Run       cmds.RunCommand              `cmd:"" help:"run node"`
 	Operation cmds.OperationCommand        `cmd:"" help:"create operation"`
+	Query     cmds.QueryCommand            `cmd:"" help:"query states"`
 	Network   extensioncmds.NetworkCommand `cmd:"" help:"network"`
 	Key       extensioncmds.KeyCommand     `cmd:"" help:"key"`
 	Version   struct{}                     `cmd:"" help:"version"`
@@ -52,6 +53,7 @@ func main() {
 		Init:      cmds.NewINITCommand(),
 		Run:       cmds.NewRunCommand(),
 		Operation: cmds.NewOperationCommand(),
+		Query:     cmds.NewQueryCommand(),
 		Network:   extensioncmds.NewNetworkCommand(),
 		Key:       extensioncmds.NewKeyCommand(),
 	}
@@ -0,0 +1,132 @@
+package collection
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var MaxAcceptOfferItems = 10
+
+var (
+	AcceptOfferFactHint = hint.MustNewHint("mitum-nft-accept-offer-operation-fact-v0.0.1")
+	AcceptOfferHint     = hint.MustNewHint("mitum-nft-accept-offer-operation-v0.0.1")
+)
+
+type AcceptOfferFact struct {
+	base.BaseFact
+	sender base.Address
+	items  []AcceptOfferItem
+}
+
+func NewAcceptOfferFact(token []byte, sender base.Address, items []AcceptOfferItem) AcceptOfferFact {
+	bf := base.NewBaseFact(AcceptOfferFactHint, token)
+	fact := AcceptOfferFact{
+		BaseFact: bf,
+		sender:   sender,
+		items:    items,
+	}
+
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact AcceptOfferFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := currency.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if l := len(fact.items); l < 1 {
+		return util.ErrInvalid.Errorf("empty items for AcceptOfferFact")
+	} else if l > int(MaxAcceptOfferItems) {
+		return util.ErrInvalid.Errorf("items over allowed, %d > %d", l, MaxAcceptOfferItems)
+	}
+
+	if err := fact.sender.IsValid(nil); err != nil {
+		return err
+	}
+
+	founds := map[string]struct{}{}
+	for _, item := range fact.items {
+		if err := item.IsValid(nil); err != nil {
+			return err
+		}
+
+		n := item.NFT()
+		if err := n.IsValid(nil); err != nil {
+			return err
+		}
+
+		if _, found := founds[n.String()]; found {
+			return util.ErrInvalid.Errorf("duplicate nft found, %q", n)
+		}
+
+		founds[n.String()] = struct{}{}
+	}
+
+	return nil
+}
+
+func (fact AcceptOfferFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact AcceptOfferFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact AcceptOfferFact) Bytes() []byte {
+	is := make([][]byte, len(fact.items))
+
+	for i, item := range fact.items {
+		is[i] = item.Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		util.ConcatBytesSlice(is...),
+	)
+}
+
+func (fact AcceptOfferFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact AcceptOfferFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact AcceptOfferFact) Items() []AcceptOfferItem {
+	return fact.items
+}
+
+func (fact AcceptOfferFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 1)
+	as[0] = fact.sender
+
+	return as, nil
+}
+
+type AcceptOffer struct {
+	currency.BaseOperation
+}
+
+func NewAcceptOffer(fact AcceptOfferFact) (AcceptOffer, error) {
+	return AcceptOffer{BaseOperation: currency.NewBaseOperation(AcceptOfferHint, fact)}, nil
+}
+
+func (op *AcceptOffer) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
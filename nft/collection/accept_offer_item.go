@@ -0,0 +1,63 @@
+package collection
+
+import (
+	"github.com/ProtoconNet/mitum-nft/nft"
+
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+var AcceptOfferItemHint = hint.MustNewHint("mitum-nft-accept-offer-item-v0.0.1")
+
+type AcceptOfferItem struct {
+	hint.BaseHinter
+	offerer  base.Address
+	nft      nft.NFTID
+	currency currency.CurrencyID
+}
+
+func NewAcceptOfferItem(offerer base.Address, n nft.NFTID, currency currency.CurrencyID) AcceptOfferItem {
+	return AcceptOfferItem{
+		BaseHinter: hint.NewBaseHinter(AcceptOfferItemHint),
+		offerer:    offerer,
+		nft:        n,
+		currency:   currency,
+	}
+}
+
+func (it AcceptOfferItem) IsValid([]byte) error {
+	return util.CheckIsValiders(nil, false,
+		it.BaseHinter,
+		it.offerer,
+		it.nft,
+		it.currency,
+	)
+}
+
+func (it AcceptOfferItem) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		it.offerer.Bytes(),
+		it.nft.Bytes(),
+		it.currency.Bytes(),
+	)
+}
+
+func (it AcceptOfferItem) Offerer() base.Address {
+	return it.offerer
+}
+
+func (it AcceptOfferItem) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 1)
+	as[0] = it.offerer
+	return as, nil
+}
+
+func (it AcceptOfferItem) NFT() nft.NFTID {
+	return it.nft
+}
+
+func (it AcceptOfferItem) Currency() currency.CurrencyID {
+	return it.currency
+}
@@ -0,0 +1,47 @@
+package collection
+
+import (
+	"encoding/json"
+
+	"github.com/ProtoconNet/mitum-nft/nft"
+
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+type AcceptOfferItemJSONMarshaler struct {
+	hint.BaseHinter
+	Offerer  base.Address        `json:"offerer"`
+	NFT      nft.NFTID           `json:"nft"`
+	Currency currency.CurrencyID `json:"currency"`
+}
+
+func (it AcceptOfferItem) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(AcceptOfferItemJSONMarshaler{
+		BaseHinter: it.BaseHinter,
+		Offerer:    it.offerer,
+		NFT:        it.nft,
+		Currency:   it.currency,
+	})
+}
+
+type AcceptOfferItemJSONUnmarshaler struct {
+	Hint     hint.Hint       `json:"_hint"`
+	Offerer  string          `json:"offerer"`
+	NFT      json.RawMessage `json:"nft"`
+	Currency string          `json:"currency"`
+}
+
+func (it *AcceptOfferItem) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed decode json of AcceptOfferItem")
+
+	var u AcceptOfferItemJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	return it.unmarshal(enc, u.Hint, u.Offerer, u.NFT, u.Currency)
+}
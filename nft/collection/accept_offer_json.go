@@ -0,0 +1,66 @@
+package collection
+
+import (
+	"encoding/json"
+
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+)
+
+type AcceptOfferFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender base.Address      `json:"sender"`
+	Items  []AcceptOfferItem `json:"items"`
+}
+
+func (fact AcceptOfferFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(AcceptOfferFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Items:                 fact.items,
+	})
+}
+
+type AcceptOfferFactJSONUnmarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender string          `json:"sender"`
+	Items  json.RawMessage `json:"items"`
+}
+
+func (fact *AcceptOfferFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of AcceptOfferFact")
+
+	var uf AcceptOfferFactJSONUnmarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e(err, "")
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	return fact.unmarshal(enc, uf.Sender, uf.Items)
+}
+
+type acceptOfferMarshaler struct {
+	currency.BaseOperationJSONMarshaler
+}
+
+func (op AcceptOffer) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(acceptOfferMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *AcceptOffer) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of AcceptOffer")
+
+	var ubo currency.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e(err, "")
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,343 @@
+package collection
+
+import (
+	"context"
+	"sync"
+
+	extensioncurrency "github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var acceptOfferItemProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(AcceptOfferItemProcessor)
+	},
+}
+
+var acceptOfferProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(AcceptOfferProcessor)
+	},
+}
+
+func (AcceptOffer) Process(
+	ctx context.Context, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type AcceptOfferItemProcessor struct {
+	h        util.Hash
+	sender   base.Address
+	item     AcceptOfferItem
+	balances *balanceChanges
+}
+
+func (ipp *AcceptOfferItemProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) error {
+	nid := ipp.item.NFT()
+
+	st, err := existsState(StateKeyCollectionDesign(nid.Collection()), "key of design", getStateFunc)
+	if err != nil {
+		return errors.Errorf("collection design not found, %q: %w", nid.Collection(), err)
+	}
+
+	design, err := StateCollectionDesignValue(st)
+	if err != nil {
+		return errors.Errorf("collection design value not found, %q: %w", nid.Collection(), err)
+	}
+
+	if !design.Active() {
+		return errors.Errorf("deactivated collection, %q", nid.Collection())
+	}
+
+	st, err = existsState(extensioncurrency.StateKeyContractAccount(design.Parent()), "contract account", getStateFunc)
+	if err != nil {
+		return errors.Errorf("parent not found, %q: %w", design.Parent(), err)
+	}
+
+	ca, err := extensioncurrency.StateContractAccountValue(st)
+	if err != nil {
+		return errors.Errorf("contract account value not found, %q: %w", design.Parent(), err)
+	}
+
+	if !ca.IsActive() {
+		return errors.Errorf("deactivated contract account, %q", design.Parent())
+	}
+
+	st, err = existsState(StateKeyNFT(nid), "key of nft", getStateFunc)
+	if err != nil {
+		return errors.Errorf("nft not found, %q: %w", nid, err)
+	}
+
+	nv, err := StateNFTValue(st)
+	if err != nil {
+		return errors.Errorf("nft value not found, %q: %w", nid, err)
+	}
+
+	if !nv.Active() {
+		return errors.Errorf("burned nft, %q", nid)
+	}
+
+	if err := checkNotFrozen(nid, getStateFunc); err != nil {
+		return errors.Errorf("nft not available, %q: %w", nid, err)
+	}
+
+	if err := checkNotInAuction(nid, getStateFunc); err != nil {
+		return errors.Errorf("nft not available, %q: %w", nid, err)
+	}
+
+	if err := checkNotAttached(nid, getStateFunc); err != nil {
+		return errors.Errorf("nft not available, %q: %w", nid, err)
+	}
+
+	if err := checkTransferable(nid.Collection(), getStateFunc); err != nil {
+		return errors.Errorf("nft not transferable, %q: %w", nid, err)
+	}
+
+	if err := checkSigned(nv, getStateFunc); err != nil {
+		return errors.Errorf("nft not transferable, %q: %w", nid, err)
+	}
+
+	if err := checkChildrenMovable(nid, getStateFunc); err != nil {
+		return errors.Errorf("nft not transferable, %q: %w", nid, err)
+	}
+
+	if !nv.Owner().Equal(ipp.sender) {
+		if st, err := existsState(StateKeyAgentBox(nv.Owner(), nv.ID().Collection()), "agents", getStateFunc); err != nil {
+			return errors.Errorf("unauthorized sender, %q: %w", ipp.sender, err)
+		} else if box, err := StateAgentBoxValue(st); err != nil {
+			return errors.Errorf("agent box value not found, %q: %w", ipp.sender, err)
+		} else if !box.Exists(ipp.sender) {
+			return errors.Errorf("unauthorized sender, %q", ipp.sender)
+		}
+	}
+
+	st, err = existsState(StateKeyOffer(nid, ipp.item.Offerer()), "key of offer", getStateFunc)
+	if err != nil {
+		return errors.Errorf("offer not found, %q: %w", nid, err)
+	}
+
+	o, err := StateOfferValue(st)
+	if err != nil {
+		return errors.Errorf("offer value not found, %q: %w", nid, err)
+	}
+
+	if !o.Active() {
+		return errors.Errorf("offer already closed, %q", nid)
+	}
+
+	if o.Offerer().Equal(nv.Owner()) {
+		return errors.Errorf("offerer already owns nft, %q", nid)
+	}
+
+	return nil
+}
+
+func (ipp *AcceptOfferItemProcessor) Process(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, error) {
+	nid := ipp.item.NFT()
+
+	st, err := existsState(StateKeyNFT(nid), "key of nft", getStateFunc)
+	if err != nil {
+		return nil, errors.Errorf("nft not found, %q: %w", nid, err)
+	}
+
+	nv, err := StateNFTValue(st)
+	if err != nil {
+		return nil, errors.Errorf("nft value not found, %q: %w", nid, err)
+	}
+
+	ost, err := existsState(StateKeyOffer(nid, ipp.item.Offerer()), "key of offer", getStateFunc)
+	if err != nil {
+		return nil, errors.Errorf("offer not found, %q: %w", nid, err)
+	}
+
+	o, err := StateOfferValue(ost)
+	if err != nil {
+		return nil, errors.Errorf("offer value not found, %q: %w", nid, err)
+	}
+
+	if err := payNFTPrice(ipp.balances, nv, nv.Owner(), o.Amount(), getStateFunc); err != nil {
+		return nil, errors.Errorf("failed to settle price, %q: %w", nid, err)
+	}
+
+	n := nft.NewNFT(nid, nv.Active(), o.Offerer(), nv.NFTHash(), nv.URI(), o.Offerer(), nv.Creators(), nv.Copyrighters(), o.Offerer(), 0, nv.Royalty(), nv.RoyaltyReceiver())
+	if err := n.IsValid(nil); err != nil {
+		return nil, errors.Errorf("invalid nft, %q: %w", nid, err)
+	}
+
+	sts := []base.StateMergeValue{
+		NewNFTStateMergeValue(st.Key(), NewNFTStateValue(n)),
+		NewOfferStateMergeValue(ost.Key(), NewOfferStateValue(NewOffer(nid, false, o.Offerer(), o.Amount()))),
+	}
+
+	sv, err := cancelListing(nid, getStateFunc)
+	if err != nil {
+		return nil, errors.Errorf("failed to cancel listing, %q: %w", nid, err)
+	}
+	if sv != nil {
+		sts = append(sts, sv)
+	}
+
+	s, err := moveChildren(nid, o.Offerer(), getStateFunc)
+	if err != nil {
+		return nil, errors.Errorf("failed to move children, %q: %w", nid, err)
+	}
+	sts = append(sts, s...)
+
+	return sts, nil
+}
+
+func (ipp *AcceptOfferItemProcessor) Close() error {
+	ipp.h = nil
+	ipp.sender = nil
+	ipp.item = AcceptOfferItem{}
+	ipp.balances = nil
+
+	acceptOfferItemProcessorPool.Put(ipp)
+
+	return nil
+}
+
+type AcceptOfferProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewAcceptOfferProcessor() extensioncurrency.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringErrorFunc("failed to create new AcceptOfferProcessor")
+
+		nopp := acceptOfferProcessorPool.Get()
+		opp, ok := nopp.(*AcceptOfferProcessor)
+		if !ok {
+			return nil, e(nil, "expected AcceptOfferProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e(err, "")
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *AcceptOfferProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	e := util.StringErrorFunc("failed to preprocess AcceptOffer")
+
+	fact, ok := op.Fact().(AcceptOfferFact)
+	if !ok {
+		return ctx, nil, e(nil, "expected AcceptOfferFact, not %T", op.Fact())
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, nil, e(err, "")
+	}
+
+	if err := checkExistsState(currency.StateKeyAccount(fact.Sender()), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("sender not found, %q: %w", fact.Sender(), err), nil
+	}
+
+	if err := checkFactSignsByState(fact.sender, op.Signs(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("invalid signing: %w", err), nil
+	}
+
+	for _, item := range fact.Items() {
+		ip := acceptOfferItemProcessorPool.Get()
+		ipc, ok := ip.(*AcceptOfferItemProcessor)
+		if !ok {
+			return nil, nil, e(nil, "expected AcceptOfferItemProcessor, not %T", ip)
+		}
+
+		ipc.h = op.Hash()
+		ipc.sender = fact.Sender()
+		ipc.item = item
+		ipc.balances = nil
+
+		if err := ipc.PreProcess(ctx, op, getStateFunc); err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("fail to preprocess AcceptOfferItem: %w", err), nil
+		}
+
+		ipc.Close()
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *AcceptOfferProcessor) Process( // nolint:dupl
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	e := util.StringErrorFunc("failed to process AcceptOffer")
+
+	fact, ok := op.Fact().(AcceptOfferFact)
+	if !ok {
+		return nil, nil, e(nil, "expected AcceptOfferFact, not %T", op.Fact())
+	}
+
+	balances := newBalanceChanges(getStateFunc)
+
+	var sts []base.StateMergeValue // nolint:prealloc
+	for _, item := range fact.Items() {
+		ip := acceptOfferItemProcessorPool.Get()
+		ipc, ok := ip.(*AcceptOfferItemProcessor)
+		if !ok {
+			return nil, nil, e(nil, "expected AcceptOfferItemProcessor, not %T", ip)
+		}
+
+		ipc.h = op.Hash()
+		ipc.sender = fact.Sender()
+		ipc.item = item
+		ipc.balances = balances
+
+		s, err := ipc.Process(ctx, op, getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("failed to process AcceptOfferItem: %w", err), nil
+		}
+		sts = append(sts, s...)
+
+		ipc.Close()
+	}
+
+	fitems := fact.Items()
+	items := make([]CollectionItem, len(fitems))
+	for i := range fact.Items() {
+		items[i] = fitems[i]
+	}
+
+	required, err := CalculateCollectionItemsFee(getStateFunc, items)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to calculate fee: %w", err), nil
+	}
+
+	if err := balances.subFee(fact.Sender(), required); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to check enough balance: %w", err), nil
+	}
+
+	sts = append(sts, balances.stateMergeValues()...)
+
+	return sts, nil, nil
+}
+
+func (opp *AcceptOfferProcessor) Close() error {
+	acceptOfferProcessorPool.Put(opp)
+
+	return nil
+}
@@ -0,0 +1,150 @@
+package collection
+
+import (
+	extensioncurrency "github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	AddWhitesFactHint = hint.MustNewHint("mitum-nft-add-whites-operation-fact-v0.0.1")
+	AddWhitesHint     = hint.MustNewHint("mitum-nft-add-whites-operation-v0.0.1")
+)
+
+type AddWhitesFact struct {
+	base.BaseFact
+	sender     base.Address
+	collection extensioncurrency.ContractID
+	whites     []White
+	currency   currency.CurrencyID
+}
+
+func NewAddWhitesFact(
+	token []byte, sender base.Address,
+	collection extensioncurrency.ContractID,
+	whites []White,
+	currency currency.CurrencyID,
+) AddWhitesFact {
+	bf := base.NewBaseFact(AddWhitesFactHint, token)
+
+	fact := AddWhitesFact{
+		BaseFact:   bf,
+		sender:     sender,
+		collection: collection,
+		whites:     whites,
+		currency:   currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact AddWhitesFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := currency.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if err := util.CheckIsValiders(
+		nil, false,
+		fact.sender,
+		fact.collection,
+		fact.currency,
+	); err != nil {
+		return err
+	}
+
+	if l := len(fact.whites); l < 1 {
+		return util.ErrInvalid.Errorf("empty whites for AddWhitesFact")
+	} else if l > MaxWhites {
+		return util.ErrInvalid.Errorf("whites over allowed, %d > %d", l, MaxWhites)
+	}
+
+	founds := map[string]struct{}{}
+	for _, white := range fact.whites {
+		if err := white.IsValid(nil); err != nil {
+			return err
+		}
+
+		if _, found := founds[white.Account().String()]; found {
+			return util.ErrInvalid.Errorf("duplicate white found, %q", white.Account())
+		}
+		founds[white.Account().String()] = struct{}{}
+	}
+
+	return nil
+}
+
+func (fact AddWhitesFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact AddWhitesFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact AddWhitesFact) Bytes() []byte {
+	ws := make([][]byte, len(fact.whites))
+	for i, white := range fact.whites {
+		ws[i] = white.Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.collection.Bytes(),
+		util.ConcatBytesSlice(ws...),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact AddWhitesFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact AddWhitesFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact AddWhitesFact) Collection() extensioncurrency.ContractID {
+	return fact.collection
+}
+
+func (fact AddWhitesFact) Whites() []White {
+	return fact.whites
+}
+
+func (fact AddWhitesFact) Currency() currency.CurrencyID {
+	return fact.currency
+}
+
+func (fact AddWhitesFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, len(fact.whites)+1)
+	as[0] = fact.sender
+	for i, white := range fact.whites {
+		as[i+1] = white.Account()
+	}
+	return as, nil
+}
+
+type AddWhites struct {
+	currency.BaseOperation
+}
+
+func NewAddWhites(fact AddWhitesFact) (AddWhites, error) {
+	return AddWhites{BaseOperation: currency.NewBaseOperation(AddWhitesHint, fact)}, nil
+}
+
+func (op *AddWhites) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package collection
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	bsonenc "github.com/ProtoconNet/mitum-currency/v2/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (fact AddWhitesFact) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":      fact.Hint().String(),
+			"hash":       fact.BaseFact.Hash().String(),
+			"token":      fact.BaseFact.Token(),
+			"sender":     fact.sender,
+			"collection": fact.collection,
+			"whites":     fact.whites,
+			"currency":   fact.currency,
+		})
+}
+
+type AddWhitesFactBSONUnmarshaler struct {
+	Hint       string   `bson:"_hint"`
+	Sender     string   `bson:"sender"`
+	Collection string   `bson:"collection"`
+	Whites     bson.Raw `bson:"whites"`
+	Currency   string   `bson:"currency"`
+}
+
+func (fact *AddWhitesFact) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode bson of AddWhitesFact")
+
+	var u currency.BaseFactBSONUnmarshaler
+
+	err := enc.Unmarshal(b, &u)
+	if err != nil {
+		return e(err, "")
+	}
+
+	fact.BaseFact.SetHash(valuehash.NewBytesFromString(u.Hash))
+	fact.BaseFact.SetToken(u.Token)
+
+	var uf AddWhitesFactBSONUnmarshaler
+	if err := bson.Unmarshal(b, &uf); err != nil {
+		return e(err, "")
+	}
+
+	ht, err := hint.ParseHint(uf.Hint)
+	if err != nil {
+		return e(err, "")
+	}
+	fact.BaseHinter = hint.NewBaseHinter(ht)
+
+	return fact.unmarshal(enc, uf.Sender, uf.Collection, uf.Whites, uf.Currency)
+}
+
+func (op AddWhites) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint": op.Hint().String(),
+			"hash":  op.Hash().String(),
+			"fact":  op.Fact(),
+			"signs": op.Signs(),
+		})
+}
+
+func (op *AddWhites) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode bson of AddWhites")
+
+	var ubo currency.BaseOperation
+	if err := ubo.DecodeBSON(b, enc); err != nil {
+		return e(err, "")
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
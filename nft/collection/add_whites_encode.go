@@ -0,0 +1,45 @@
+package collection
+
+import (
+	extensioncurrency "github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+func (fact *AddWhitesFact) unmarshal(
+	enc encoder.Encoder,
+	sd string,
+	col string,
+	bws []byte,
+	cid string,
+) error {
+	e := util.StringErrorFunc("failed to unmarshal AddWhitesFact")
+
+	fact.collection = extensioncurrency.ContractID(col)
+	fact.currency = currency.CurrencyID(cid)
+
+	sender, err := base.DecodeAddress(sd, enc)
+	if err != nil {
+		return e(err, "")
+	}
+	fact.sender = sender
+
+	hws, err := enc.DecodeSlice(bws)
+	if err != nil {
+		return e(err, "")
+	}
+
+	whites := make([]White, len(hws))
+	for i, hw := range hws {
+		white, ok := hw.(White)
+		if !ok {
+			return e(util.ErrWrongType.Errorf("expected White, not %T", hw), "")
+		}
+		whites[i] = white
+	}
+	fact.whites = whites
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package collection
+
+import (
+	"encoding/json"
+
+	extensioncurrency "github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+)
+
+type AddWhitesFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender     base.Address                 `json:"sender"`
+	Collection extensioncurrency.ContractID `json:"collection"`
+	Whites     []White                      `json:"whites"`
+	Currency   currency.CurrencyID          `json:"currency"`
+}
+
+func (fact AddWhitesFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(AddWhitesFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Collection:            fact.collection,
+		Whites:                fact.whites,
+		Currency:              fact.currency,
+	})
+}
+
+type AddWhitesFactJSONUnmarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender     string          `json:"sender"`
+	Collection string          `json:"collection"`
+	Whites     json.RawMessage `json:"whites"`
+	Currency   string          `json:"currency"`
+}
+
+func (fact *AddWhitesFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of AddWhitesFact")
+
+	var u AddWhitesFactJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(u.BaseFactJSONUnmarshaler)
+
+	return fact.unmarshal(enc, u.Sender, u.Collection, u.Whites, u.Currency)
+}
+
+type addWhitesMarshaler struct {
+	currency.BaseOperationJSONMarshaler
+}
+
+func (op AddWhites) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(addWhitesMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *AddWhites) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of AddWhites")
+
+	var ubo currency.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e(err, "")
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
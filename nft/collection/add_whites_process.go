@@ -0,0 +1,154 @@
+package collection
+
+import (
+	"context"
+	"sync"
+
+	extensioncurrency "github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var addWhitesProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(AddWhitesProcessor)
+	},
+}
+
+func (AddWhites) Process(
+	ctx context.Context, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type AddWhitesProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewAddWhitesProcessor() extensioncurrency.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringErrorFunc("failed to create new AddWhitesProcessor")
+
+		nopp := addWhitesProcessorPool.Get()
+		opp, ok := nopp.(*AddWhitesProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected AddWhitesProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e(err, "")
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *AddWhitesProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	e := util.StringErrorFunc("failed to preprocess AddWhites")
+
+	fact, ok := op.Fact().(AddWhitesFact)
+	if !ok {
+		return ctx, nil, e(nil, "expected AddWhitesFact, not %T", op.Fact())
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, nil, e(err, "")
+	}
+
+	if err := checkExistsState(currency.StateKeyAccount(fact.Sender()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("sender not found, %q: %w", fact.Sender(), err), nil
+	}
+
+	if err := checkNotExistsState(extensioncurrency.StateKeyContractAccount(fact.Sender()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("contract account cannot update collection policy, %q: %w", fact.Sender(), err), nil
+	}
+
+	if err := checkFactSignsByState(fact.Sender(), op.Signs(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("invalid signing: %w", err), nil
+	}
+
+	if err := checkExistsState(extensioncurrency.StateKeyCurrencyDesign(fact.Currency()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("currency not found, %q: %w", fact.Currency(), err), nil
+	}
+
+	if err := checkCollectionCreator(fact.Collection(), fact.Sender(), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("collection not available, %q: %w", fact.Collection(), err), nil
+	}
+
+	if err := checkWhiteAccounts(fact.Whites(), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("invalid whites: %w", err), nil
+	}
+
+	for _, white := range fact.Whites() {
+		switch _, isWhite, err := whiteOf(fact.Collection(), white.Account(), getStateFunc); {
+		case err != nil:
+			return nil, base.NewBaseOperationProcessReasonError("failed to get white, %q: %w", white.Account(), err), nil
+		case isWhite:
+			return nil, base.NewBaseOperationProcessReasonError("white already exists, %q", white.Account()), nil
+		}
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *AddWhitesProcessor) Process(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	e := util.StringErrorFunc("failed to process AddWhites")
+
+	fact, ok := op.Fact().(AddWhitesFact)
+	if !ok {
+		return nil, nil, e(nil, "expected AddWhitesFact, not %T", op.Fact())
+	}
+
+	if err := checkCollectionCreator(fact.Collection(), fact.Sender(), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("collection not available, %q: %w", fact.Collection(), err), nil
+	}
+
+	sts := make([]base.StateMergeValue, 0, len(fact.Whites())+1)
+	for _, white := range fact.Whites() {
+		sts = append(sts, NewWhiteStateMergeValue(
+			StateKeyWhite(fact.Collection(), white.Account()),
+			NewWhiteStateValue(fact.Collection(), white, true),
+		))
+	}
+
+	currencyPolicy, err := existsCurrencyPolicy(fact.Currency(), getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("currency not found, %q: %w", fact.Currency(), err), nil
+	}
+
+	fee, err := currencyPolicy.Feeer().Fee(currency.ZeroBig)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to check fee of currency, %q: %w", fact.Currency(), err), nil
+	}
+
+	balances := newBalanceChanges(getStateFunc)
+	if err := balances.sub(fact.Sender(), currency.NewAmount(fee, fact.Currency())); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to check enough balance: %w", err), nil
+	}
+
+	sts = append(sts, balances.stateMergeValues()...)
+
+	return sts, nil, nil
+}
+
+func (opp *AddWhitesProcessor) Close() error {
+	addWhitesProcessorPool.Put(opp)
+
+	return nil
+}
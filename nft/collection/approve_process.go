@@ -87,6 +87,22 @@ func (ipp *ApproveItemProcessor) PreProcess(
 		return errors.Errorf("burned nft, %q", nid)
 	}
 
+	if err := checkNotFrozen(nid, getStateFunc); err != nil {
+		return errors.Errorf("nft not available, %q: %w", nid, err)
+	}
+
+	if err := checkNotInAuction(nid, getStateFunc); err != nil {
+		return errors.Errorf("nft not available, %q: %w", nid, err)
+	}
+
+	if err := checkTransferable(nid.Collection(), getStateFunc); err != nil {
+		return errors.Errorf("nft not transferable, %q: %w", nid, err)
+	}
+
+	if err := checkSigned(nv, getStateFunc); err != nil {
+		return errors.Errorf("nft not transferable, %q: %w", nid, err)
+	}
+
 	if ipp.item.Approved().Equal(nv.Approved()) {
 		return errors.Errorf("already approved, %q", ipp.item.Approved())
 	}
@@ -129,13 +145,21 @@ func (ipp *ApproveItemProcessor) Process(
 		return nil, errors.Errorf("nft value not found, %q: %w", nid, err)
 	}
 
-	n := nft.NewNFT(nv.ID(), nv.Active(), nv.Owner(), nv.NFTHash(), nv.URI(), ipp.item.Approved(), nv.Creators(), nv.Copyrighters())
+	n := nft.NewNFT(nv.ID(), nv.Active(), nv.Owner(), nv.NFTHash(), nv.URI(), ipp.item.Approved(), nv.Creators(), nv.Copyrighters(), nv.User(), nv.UserExpiry(), nv.Royalty(), nv.RoyaltyReceiver())
 	if err := n.IsValid(nil); err != nil {
 		return nil, err
 	}
 
 	sts := []base.StateMergeValue{NewNFTStateMergeValue(st.Key(), NewNFTStateValue(n))}
 
+	sv, err := cancelListing(nid, getStateFunc)
+	if err != nil {
+		return nil, errors.Errorf("failed to cancel listing, %q: %w", nid, err)
+	}
+	if sv != nil {
+		sts = append(sts, sv)
+	}
+
 	return sts, nil
 }
 
@@ -0,0 +1,133 @@
+package collection
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	AttachChildFactHint = hint.MustNewHint("mitum-nft-attach-child-operation-fact-v0.0.1")
+	AttachChildHint     = hint.MustNewHint("mitum-nft-attach-child-operation-v0.0.1")
+)
+
+var MaxAttachChildItems = 10
+
+type AttachChildFact struct {
+	base.BaseFact
+	sender base.Address
+	items  []AttachChildItem
+}
+
+func NewAttachChildFact(token []byte, sender base.Address, items []AttachChildItem) AttachChildFact {
+	bf := base.NewBaseFact(AttachChildFactHint, token)
+
+	fact := AttachChildFact{
+		BaseFact: bf,
+		sender:   sender,
+		items:    items,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact AttachChildFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := currency.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if l := len(fact.items); l < 1 {
+		return util.ErrInvalid.Errorf("empty items for AttachChildFact")
+	} else if l > int(MaxAttachChildItems) {
+		return util.ErrInvalid.Errorf("items over allowed, %d > %d", l, MaxAttachChildItems)
+	}
+
+	if err := fact.sender.IsValid(nil); err != nil {
+		return err
+	}
+
+	children := map[string]struct{}{}
+	for _, item := range fact.items {
+		if err := item.IsValid(nil); err != nil {
+			return err
+		}
+
+		c := item.Child()
+		if _, found := children[c.String()]; found {
+			return util.ErrInvalid.Errorf("duplicate child found, %q", c)
+		}
+
+		children[c.String()] = struct{}{}
+	}
+
+	for _, item := range fact.items {
+		if _, found := children[item.Parent().String()]; found {
+			return util.ErrInvalid.Errorf("child cannot be a parent in the same operation, %q", item.Parent())
+		}
+	}
+
+	return nil
+}
+
+func (fact AttachChildFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact AttachChildFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact AttachChildFact) Bytes() []byte {
+	is := make([][]byte, len(fact.items))
+	for i := range fact.items {
+		is[i] = fact.items[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		util.ConcatBytesSlice(is...),
+	)
+}
+
+func (fact AttachChildFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact AttachChildFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact AttachChildFact) Items() []AttachChildItem {
+	return fact.items
+}
+
+func (fact AttachChildFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 1)
+	as[0] = fact.sender
+
+	return as, nil
+}
+
+type AttachChild struct {
+	currency.BaseOperation
+}
+
+func NewAttachChild(fact AttachChildFact) (AttachChild, error) {
+	return AttachChild{BaseOperation: currency.NewBaseOperation(AttachChildHint, fact)}, nil
+}
+
+func (op *AttachChild) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
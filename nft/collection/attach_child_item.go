@@ -0,0 +1,58 @@
+package collection
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+var AttachChildItemHint = hint.MustNewHint("mitum-nft-attach-child-item-v0.0.1")
+
+type AttachChildItem struct {
+	hint.BaseHinter
+	parent   nft.NFTID
+	child    nft.NFTID
+	currency currency.CurrencyID
+}
+
+func NewAttachChildItem(parent nft.NFTID, child nft.NFTID, currency currency.CurrencyID) AttachChildItem {
+	return AttachChildItem{
+		BaseHinter: hint.NewBaseHinter(AttachChildItemHint),
+		parent:     parent,
+		child:      child,
+		currency:   currency,
+	}
+}
+
+func (it AttachChildItem) IsValid([]byte) error {
+	if err := util.CheckIsValiders(nil, false, it.BaseHinter, it.parent, it.child, it.currency); err != nil {
+		return err
+	}
+
+	if it.parent.Equal(it.child) {
+		return util.ErrInvalid.Errorf("nft cannot be a child of itself, %q", it.child)
+	}
+
+	return nil
+}
+
+func (it AttachChildItem) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		it.parent.Bytes(),
+		it.child.Bytes(),
+		it.currency.Bytes(),
+	)
+}
+
+func (it AttachChildItem) Parent() nft.NFTID {
+	return it.parent
+}
+
+func (it AttachChildItem) Child() nft.NFTID {
+	return it.child
+}
+
+func (it AttachChildItem) Currency() currency.CurrencyID {
+	return it.currency
+}
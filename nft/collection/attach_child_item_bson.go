@@ -0,0 +1,43 @@
+package collection
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	bsonenc "github.com/ProtoconNet/mitum-currency/v2/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (it AttachChildItem) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":    it.Hint().String(),
+			"parent":   it.parent,
+			"child":    it.child,
+			"currency": it.currency,
+		},
+	)
+}
+
+type AttachChildItemBSONUnmarshaler struct {
+	Hint     string   `bson:"_hint"`
+	Parent   bson.Raw `bson:"parent"`
+	Child    bson.Raw `bson:"child"`
+	Currency string   `bson:"currency"`
+}
+
+func (it *AttachChildItem) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode bson of AttachChildItem")
+
+	var u AttachChildItemBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e(err, "")
+	}
+
+	return it.unmarshal(enc, ht, u.Parent, u.Child, u.Currency)
+}
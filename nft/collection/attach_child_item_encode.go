@@ -0,0 +1,41 @@
+package collection
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (it *AttachChildItem) unmarshal(
+	enc encoder.Encoder,
+	ht hint.Hint,
+	bp []byte,
+	bc []byte,
+	cid string,
+) error {
+	e := util.StringErrorFunc("failed to unmarshal AttachChildItem")
+
+	it.BaseHinter = hint.NewBaseHinter(ht)
+
+	if hinter, err := enc.Decode(bp); err != nil {
+		return e(err, "")
+	} else if n, ok := hinter.(nft.NFTID); !ok {
+		return e(util.ErrWrongType.Errorf("expected NFTID, not %T", hinter), "")
+	} else {
+		it.parent = n
+	}
+
+	if hinter, err := enc.Decode(bc); err != nil {
+		return e(err, "")
+	} else if n, ok := hinter.(nft.NFTID); !ok {
+		return e(util.ErrWrongType.Errorf("expected NFTID, not %T", hinter), "")
+	} else {
+		it.child = n
+	}
+
+	it.currency = currency.CurrencyID(cid)
+
+	return nil
+}
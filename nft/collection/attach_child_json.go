@@ -0,0 +1,66 @@
+package collection
+
+import (
+	"encoding/json"
+
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+)
+
+type AttachChildFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender base.Address      `json:"sender"`
+	Items  []AttachChildItem `json:"items"`
+}
+
+func (fact AttachChildFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(AttachChildFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Items:                 fact.items,
+	})
+}
+
+type AttachChildFactJSONUnmarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender string          `json:"sender"`
+	Items  json.RawMessage `json:"items"`
+}
+
+func (fact *AttachChildFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of AttachChildFact")
+
+	var u AttachChildFactJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(u.BaseFactJSONUnmarshaler)
+
+	return fact.unmarshal(enc, u.Sender, u.Items)
+}
+
+type attachChildMarshaler struct {
+	currency.BaseOperationJSONMarshaler
+}
+
+func (op AttachChild) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(attachChildMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *AttachChild) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of AttachChild")
+
+	var ubo currency.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e(err, "")
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
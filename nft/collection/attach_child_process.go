@@ -0,0 +1,422 @@
+package collection
+
+import (
+	"context"
+	"sync"
+
+	extensioncurrency "github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var attachChildItemProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(AttachChildItemProcessor)
+	},
+}
+
+var attachChildProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(AttachChildProcessor)
+	},
+}
+
+func (AttachChild) Process(
+	ctx context.Context, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type AttachChildItemProcessor struct {
+	h      util.Hash
+	sender base.Address
+	item   AttachChildItem
+	box    *NFTBox
+}
+
+func (ipp *AttachChildItemProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) error {
+	parent := ipp.item.Parent()
+	child := ipp.item.Child()
+
+	for _, nid := range []nft.NFTID{parent, child} {
+		if err := checkActiveCollection(nid.Collection(), getStateFunc); err != nil {
+			return err
+		}
+
+		st, err := existsState(StateKeyNFT(nid), "key of nft", getStateFunc)
+		if err != nil {
+			return errors.Errorf("nft not found, %q: %w", nid, err)
+		}
+
+		nv, err := StateNFTValue(st)
+		if err != nil {
+			return errors.Errorf("nft value not found, %q: %w", nid, err)
+		}
+
+		if !nv.Active() {
+			return errors.Errorf("burned nft, %q", nid)
+		}
+
+		if err := checkNotFrozen(nid, getStateFunc); err != nil {
+			return errors.Errorf("nft not available, %q: %w", nid, err)
+		}
+
+		if err := checkNotInAuction(nid, getStateFunc); err != nil {
+			return errors.Errorf("nft not available, %q: %w", nid, err)
+		}
+	}
+
+	if err := checkTransferable(child.Collection(), getStateFunc); err != nil {
+		return errors.Errorf("nft not transferable, %q: %w", child, err)
+	}
+
+	st, err := existsState(StateKeyNFT(child), "key of nft", getStateFunc)
+	if err != nil {
+		return errors.Errorf("nft not found, %q: %w", child, err)
+	}
+
+	cv, err := StateNFTValue(st)
+	if err != nil {
+		return errors.Errorf("nft value not found, %q: %w", child, err)
+	}
+
+	if err := checkSigned(cv, getStateFunc); err != nil {
+		return errors.Errorf("nft not transferable, %q: %w", child, err)
+	}
+
+	if err := checkChildrenMovable(child, getStateFunc); err != nil {
+		return errors.Errorf("nft not transferable, %q: %w", child, err)
+	}
+
+	if err := checkNotAttached(child, getStateFunc); err != nil {
+		return errors.Errorf("nft not available, %q: %w", child, err)
+	}
+
+	ancestors, err := ancestorsOf(parent, getStateFunc)
+	if err != nil {
+		return errors.Errorf("failed to find ancestors, %q: %w", parent, err)
+	}
+
+	for _, a := range ancestors {
+		if a.Equal(child) {
+			return errors.Errorf("cyclic nesting, %q is an ancestor of %q", child, parent)
+		}
+	}
+
+	depth, err := childrenDepth(child, getStateFunc)
+	if err != nil {
+		return errors.Errorf("failed to find children depth, %q: %w", child, err)
+	}
+
+	if d := len(ancestors) + 1 + depth; d > MaxNFTDepth {
+		return errors.Errorf("nesting over allowed, %d > %d", d, MaxNFTDepth)
+	}
+
+	root := parent
+	if len(ancestors) > 0 {
+		root = ancestors[len(ancestors)-1]
+	}
+
+	for _, nid := range []nft.NFTID{root, child} {
+		st, err := existsState(StateKeyNFT(nid), "key of nft", getStateFunc)
+		if err != nil {
+			return errors.Errorf("nft not found, %q: %w", nid, err)
+		}
+
+		nv, err := StateNFTValue(st)
+		if err != nil {
+			return errors.Errorf("nft value not found, %q: %w", nid, err)
+		}
+
+		if err := checkNFTAuthorized(nv, ipp.sender, getStateFunc); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (ipp *AttachChildItemProcessor) Process(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, error) {
+	parent := ipp.item.Parent()
+	child := ipp.item.Child()
+
+	root, err := rootOf(parent, getStateFunc)
+	if err != nil {
+		return nil, errors.Errorf("failed to find root, %q: %w", parent, err)
+	}
+
+	st, err := existsState(StateKeyNFT(root), "key of nft", getStateFunc)
+	if err != nil {
+		return nil, errors.Errorf("nft not found, %q: %w", root, err)
+	}
+
+	rv, err := StateNFTValue(st)
+	if err != nil {
+		return nil, errors.Errorf("nft value not found, %q: %w", root, err)
+	}
+	owner := rv.Owner()
+
+	st, err = existsState(StateKeyNFT(child), "key of nft", getStateFunc)
+	if err != nil {
+		return nil, errors.Errorf("nft not found, %q: %w", child, err)
+	}
+
+	nv, err := StateNFTValue(st)
+	if err != nil {
+		return nil, errors.Errorf("nft value not found, %q: %w", child, err)
+	}
+
+	n := nft.NewNFT(child, nv.Active(), owner, nv.NFTHash(), nv.URI(), owner, nv.Creators(), nv.Copyrighters(), owner, 0, nv.Royalty(), nv.RoyaltyReceiver())
+	if err := n.IsValid(nil); err != nil {
+		return nil, errors.Errorf("invalid nft, %q: %w", child, err)
+	}
+
+	l := NewNFTLink(child, parent, true)
+	if err := l.IsValid(nil); err != nil {
+		return nil, errors.Errorf("invalid nft link, %q: %w", child, err)
+	}
+
+	if err := ipp.box.Append(child); err != nil {
+		return nil, errors.Errorf("failed to append child, %q: %w", child, err)
+	}
+
+	if l := len(ipp.box.NFTs()); l > MaxNFTChildren {
+		return nil, errors.Errorf("children over allowed, %d > %d", l, MaxNFTChildren)
+	}
+
+	sts := make([]base.StateMergeValue, 2)
+
+	sts[0] = NewNFTStateMergeValue(StateKeyNFT(child), NewNFTStateValue(n))
+	sts[1] = NewNFTParentStateMergeValue(StateKeyNFTParent(child), NewNFTParentStateValue(l))
+
+	sv, err := cancelListing(child, getStateFunc)
+	if err != nil {
+		return nil, errors.Errorf("failed to cancel listing, %q: %w", child, err)
+	}
+	if sv != nil {
+		sts = append(sts, sv)
+	}
+
+	s, err := moveChildren(child, owner, getStateFunc)
+	if err != nil {
+		return nil, errors.Errorf("failed to move children, %q: %w", child, err)
+	}
+	sts = append(sts, s...)
+
+	return sts, nil
+}
+
+func (ipp *AttachChildItemProcessor) Close() error {
+	ipp.h = nil
+	ipp.sender = nil
+	ipp.item = AttachChildItem{}
+	ipp.box = nil
+
+	attachChildItemProcessorPool.Put(ipp)
+
+	return nil
+}
+
+type AttachChildProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewAttachChildProcessor() extensioncurrency.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringErrorFunc("failed to create new AttachChildProcessor")
+
+		nopp := attachChildProcessorPool.Get()
+		opp, ok := nopp.(*AttachChildProcessor)
+		if !ok {
+			return nil, e(nil, "expected AttachChildProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e(err, "")
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *AttachChildProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	e := util.StringErrorFunc("failed to preprocess AttachChild")
+
+	fact, ok := op.Fact().(AttachChildFact)
+	if !ok {
+		return ctx, nil, e(nil, "expected AttachChildFact, not %T", op.Fact())
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, nil, e(err, "")
+	}
+
+	if err := checkExistsState(currency.StateKeyAccount(fact.Sender()), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("sender not found, %q: %w", fact.Sender(), err), nil
+	}
+
+	if err := checkNotExistsState(extensioncurrency.StateKeyContractAccount(fact.Sender()), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("contract account cannot attach nfts, %q", fact.Sender()), nil
+	}
+
+	if err := checkFactSignsByState(fact.sender, op.Signs(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("invalid signing: %w", err), nil
+	}
+
+	for _, item := range fact.Items() {
+		ip := attachChildItemProcessorPool.Get()
+		ipc, ok := ip.(*AttachChildItemProcessor)
+		if !ok {
+			return nil, nil, e(nil, "expected AttachChildItemProcessor, not %T", ip)
+		}
+
+		ipc.h = op.Hash()
+		ipc.sender = fact.Sender()
+		ipc.item = item
+		ipc.box = nil
+
+		if err := ipc.PreProcess(ctx, op, getStateFunc); err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("fail to preprocess AttachChildItem: %w", err), nil
+		}
+
+		ipc.Close()
+	}
+
+	children := map[string]struct{}{}
+	for _, item := range fact.Items() {
+		children[item.Child().String()] = struct{}{}
+	}
+
+	for _, item := range fact.Items() {
+		ancestors, err := ancestorsOf(item.Parent(), getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("failed to find ancestors, %q: %w", item.Parent(), err), nil
+		}
+
+		for _, a := range ancestors {
+			if _, found := children[a.String()]; found {
+				return nil, base.NewBaseOperationProcessReasonError("parent moves with another child in the same operation, %q", item.Parent()), nil
+			}
+		}
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *AttachChildProcessor) Process( // nolint:dupl
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	e := util.StringErrorFunc("failed to process AttachChild")
+
+	fact, ok := op.Fact().(AttachChildFact)
+	if !ok {
+		return nil, nil, e(nil, "expected AttachChildFact, not %T", op.Fact())
+	}
+
+	boxes := map[string]*NFTBox{}
+	for _, item := range fact.Items() {
+		parent := item.Parent()
+		if _, found := boxes[parent.String()]; found {
+			continue
+		}
+
+		box, err := childrenOf(parent, getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("failed to find children, %q: %w", parent, err), nil
+		}
+		boxes[parent.String()] = &box
+	}
+
+	var sts []base.StateMergeValue // nolint:prealloc
+	for _, item := range fact.Items() {
+		ip := attachChildItemProcessorPool.Get()
+		ipc, ok := ip.(*AttachChildItemProcessor)
+		if !ok {
+			return nil, nil, e(nil, "expected AttachChildItemProcessor, not %T", ip)
+		}
+
+		ipc.h = op.Hash()
+		ipc.sender = fact.Sender()
+		ipc.item = item
+		ipc.box = boxes[item.Parent().String()]
+
+		s, err := ipc.Process(ctx, op, getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("failed to process AttachChildItem: %w", err), nil
+		}
+		sts = append(sts, s...)
+
+		ipc.Close()
+	}
+
+	for _, item := range fact.Items() {
+		parent := item.Parent()
+		box, found := boxes[parent.String()]
+		if !found {
+			continue
+		}
+
+		sts = append(sts, NewNFTChildrenStateMergeValue(StateKeyNFTChildren(parent), NewNFTChildrenStateValue(*box)))
+		delete(boxes, parent.String())
+	}
+
+	required, err := opp.calculateItemsFee(op, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to calculate fee: %w", err), nil
+	}
+	sb, err := currency.CheckEnoughBalance(fact.sender, required, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to check enough balance: %w", err), nil
+	}
+
+	for i := range sb {
+		v, ok := sb[i].Value().(currency.BalanceStateValue)
+		if !ok {
+			return nil, nil, e(nil, "expected BalanceStateValue, not %T", sb[i].Value())
+		}
+		stv := currency.NewBalanceStateValue(v.Amount.WithBig(v.Amount.Big().Sub(required[i][0])))
+		sts = append(sts, currency.NewBalanceStateMergeValue(sb[i].Key(), stv))
+	}
+
+	return sts, nil, nil
+}
+
+func (opp *AttachChildProcessor) Close() error {
+	attachChildProcessorPool.Put(opp)
+
+	return nil
+}
+
+func (opp *AttachChildProcessor) calculateItemsFee(op base.Operation, getStateFunc base.GetStateFunc) (map[currency.CurrencyID][2]currency.Big, error) {
+	fact, ok := op.Fact().(AttachChildFact)
+	if !ok {
+		return nil, errors.Errorf("expected AttachChildFact, not %T", op.Fact())
+	}
+
+	items := make([]CollectionItem, len(fact.items))
+	for i := range fact.items {
+		items[i] = fact.items[i]
+	}
+
+	return CalculateCollectionItemsFee(getStateFunc, items)
+}
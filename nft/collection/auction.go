@@ -0,0 +1,125 @@
+package collection
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+var AuctionHint = hint.MustNewHint("mitum-nft-auction-v0.0.1")
+
+// Auction keeps the highest bid escrowed until settlement.
+// Before the first bid, bidder is the seller and bid is zero.
+type Auction struct {
+	hint.BaseHinter
+	nft    nft.NFTID
+	active bool
+	seller base.Address
+	price  currency.Amount
+	end    base.Height
+	bidder base.Address
+	bid    currency.Amount
+}
+
+func NewAuction(
+	n nft.NFTID,
+	active bool,
+	seller base.Address,
+	price currency.Amount,
+	end base.Height,
+	bidder base.Address,
+	bid currency.Amount,
+) Auction {
+	return Auction{
+		BaseHinter: hint.NewBaseHinter(AuctionHint),
+		nft:        n,
+		active:     active,
+		seller:     seller,
+		price:      price,
+		end:        end,
+		bidder:     bidder,
+		bid:        bid,
+	}
+}
+
+func (a Auction) IsValid([]byte) error {
+	if err := util.CheckIsValiders(nil, false,
+		a.BaseHinter,
+		a.nft,
+		a.seller,
+		a.price,
+		a.end,
+		a.bidder,
+		a.bid,
+	); err != nil {
+		return err
+	}
+
+	if !a.price.Big().OverZero() {
+		return util.ErrInvalid.Errorf("price must be over zero, %q", a.price.Big())
+	}
+
+	if a.price.Currency() != a.bid.Currency() {
+		return util.ErrInvalid.Errorf("currency of bid not matched with price, %q != %q", a.bid.Currency(), a.price.Currency())
+	}
+
+	if a.HasBid() && a.bid.Big().Compare(a.price.Big()) < 0 {
+		return util.ErrInvalid.Errorf("bid under price, %q < %q", a.bid.Big(), a.price.Big())
+	}
+
+	return nil
+}
+
+func (a Auction) Bytes() []byte {
+	ba := make([]byte, 1)
+
+	if a.active {
+		ba[0] = 1
+	} else {
+		ba[0] = 0
+	}
+
+	return util.ConcatBytesSlice(
+		a.nft.Bytes(),
+		ba,
+		a.seller.Bytes(),
+		a.price.Bytes(),
+		a.end.Bytes(),
+		a.bidder.Bytes(),
+		a.bid.Bytes(),
+	)
+}
+
+func (a Auction) NFT() nft.NFTID {
+	return a.nft
+}
+
+func (a Auction) Active() bool {
+	return a.active
+}
+
+func (a Auction) Seller() base.Address {
+	return a.seller
+}
+
+func (a Auction) Price() currency.Amount {
+	return a.price
+}
+
+func (a Auction) End() base.Height {
+	return a.end
+}
+
+func (a Auction) Bidder() base.Address {
+	return a.bidder
+}
+
+func (a Auction) Bid() currency.Amount {
+	return a.bid
+}
+
+func (a Auction) HasBid() bool {
+	return a.bid.Big().OverZero()
+}
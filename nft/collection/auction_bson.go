@@ -0,0 +1,52 @@
+package collection
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	bsonenc "github.com/ProtoconNet/mitum-currency/v2/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (a Auction) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":      a.Hint().String(),
+			"nft":        a.nft,
+			"active":     a.active,
+			"seller":     a.seller,
+			"price":      a.price,
+			"end_height": a.end,
+			"bidder":     a.bidder,
+			"bid":        a.bid,
+		},
+	)
+}
+
+type AuctionBSONUnmarshaler struct {
+	Hint   string      `bson:"_hint"`
+	NFT    bson.Raw    `bson:"nft"`
+	Active bool        `bson:"active"`
+	Seller string      `bson:"seller"`
+	Price  bson.Raw    `bson:"price"`
+	End    base.Height `bson:"end_height"`
+	Bidder string      `bson:"bidder"`
+	Bid    bson.Raw    `bson:"bid"`
+}
+
+func (a *Auction) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode bson of Auction")
+
+	var u AuctionBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e(err, "")
+	}
+
+	return a.unmarshal(enc, ht, u.NFT, u.Active, u.Seller, u.Price, u.End, u.Bidder, u.Bid)
+}
@@ -0,0 +1,132 @@
+package collection
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var MaxAuctionCreateItems = 10
+
+var (
+	AuctionCreateFactHint = hint.MustNewHint("mitum-nft-auction-create-operation-fact-v0.0.1")
+	AuctionCreateHint     = hint.MustNewHint("mitum-nft-auction-create-operation-v0.0.1")
+)
+
+type AuctionCreateFact struct {
+	base.BaseFact
+	sender base.Address
+	items  []AuctionCreateItem
+}
+
+func NewAuctionCreateFact(token []byte, sender base.Address, items []AuctionCreateItem) AuctionCreateFact {
+	bf := base.NewBaseFact(AuctionCreateFactHint, token)
+	fact := AuctionCreateFact{
+		BaseFact: bf,
+		sender:   sender,
+		items:    items,
+	}
+
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact AuctionCreateFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := currency.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if l := len(fact.items); l < 1 {
+		return util.ErrInvalid.Errorf("empty items for AuctionCreateFact")
+	} else if l > int(MaxAuctionCreateItems) {
+		return util.ErrInvalid.Errorf("items over allowed, %d > %d", l, MaxAuctionCreateItems)
+	}
+
+	if err := fact.sender.IsValid(nil); err != nil {
+		return err
+	}
+
+	founds := map[string]struct{}{}
+	for _, item := range fact.items {
+		if err := item.IsValid(nil); err != nil {
+			return err
+		}
+
+		n := item.NFT()
+		if err := n.IsValid(nil); err != nil {
+			return err
+		}
+
+		if _, found := founds[n.String()]; found {
+			return util.ErrInvalid.Errorf("duplicate nft found, %q", n)
+		}
+
+		founds[n.String()] = struct{}{}
+	}
+
+	return nil
+}
+
+func (fact AuctionCreateFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact AuctionCreateFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact AuctionCreateFact) Bytes() []byte {
+	is := make([][]byte, len(fact.items))
+
+	for i, item := range fact.items {
+		is[i] = item.Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		util.ConcatBytesSlice(is...),
+	)
+}
+
+func (fact AuctionCreateFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact AuctionCreateFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact AuctionCreateFact) Items() []AuctionCreateItem {
+	return fact.items
+}
+
+func (fact AuctionCreateFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 1)
+	as[0] = fact.sender
+
+	return as, nil
+}
+
+type AuctionCreate struct {
+	currency.BaseOperation
+}
+
+func NewAuctionCreate(fact AuctionCreateFact) (AuctionCreate, error) {
+	return AuctionCreate{BaseOperation: currency.NewBaseOperation(AuctionCreateHint, fact)}, nil
+}
+
+func (op *AuctionCreate) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
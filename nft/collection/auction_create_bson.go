@@ -0,0 +1,80 @@
+package collection
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	bsonenc "github.com/ProtoconNet/mitum-currency/v2/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+func (fact AuctionCreateFact) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":  fact.Hint().String(),
+			"hash":   fact.BaseFact.Hash().String(),
+			"token":  fact.BaseFact.Token(),
+			"sender": fact.sender,
+			"items":  fact.items,
+		},
+	)
+}
+
+type AuctionCreateFactBSONUnmarshaler struct {
+	Hint   string   `bson:"_hint"`
+	Sender string   `bson:"sender"`
+	Items  bson.Raw `bson:"items"`
+}
+
+func (fact *AuctionCreateFact) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode bson of AuctionCreateFact")
+
+	var u currency.BaseFactBSONUnmarshaler
+
+	err := enc.Unmarshal(b, &u)
+	if err != nil {
+		return e(err, "")
+	}
+
+	fact.BaseFact.SetHash(valuehash.NewBytesFromString(u.Hash))
+	fact.BaseFact.SetToken(u.Token)
+
+	var uf AuctionCreateFactBSONUnmarshaler
+	if err := bson.Unmarshal(b, &uf); err != nil {
+		return e(err, "")
+	}
+
+	ht, err := hint.ParseHint(uf.Hint)
+	if err != nil {
+		return e(err, "")
+	}
+
+	fact.BaseHinter = hint.NewBaseHinter(ht)
+
+	return fact.unmarshal(enc, uf.Sender, uf.Items)
+}
+
+func (op AuctionCreate) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint": op.Hint().String(),
+			"hash":  op.Hash().String(),
+			"fact":  op.Fact(),
+			"signs": op.Signs(),
+		})
+}
+
+func (op *AuctionCreate) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode bson of AuctionCreate")
+
+	var ubo currency.BaseOperation
+	if err := ubo.DecodeBSON(b, enc); err != nil {
+		return e(err, "")
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package collection
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+var AuctionCreateItemHint = hint.MustNewHint("mitum-nft-auction-create-item-v0.0.1")
+
+type AuctionCreateItem struct {
+	hint.BaseHinter
+	nft      nft.NFTID
+	price    currency.Amount
+	end      base.Height
+	currency currency.CurrencyID
+}
+
+func NewAuctionCreateItem(n nft.NFTID, price currency.Amount, end base.Height, currency currency.CurrencyID) AuctionCreateItem {
+	return AuctionCreateItem{
+		BaseHinter: hint.NewBaseHinter(AuctionCreateItemHint),
+		nft:        n,
+		price:      price,
+		end:        end,
+		currency:   currency,
+	}
+}
+
+func (it AuctionCreateItem) IsValid([]byte) error {
+	if err := util.CheckIsValiders(nil, false, it.BaseHinter, it.nft, it.price, it.end, it.currency); err != nil {
+		return err
+	}
+
+	if !it.price.Big().OverZero() {
+		return util.ErrInvalid.Errorf("price must be over zero, %q", it.price.Big())
+	}
+
+	return nil
+}
+
+func (it AuctionCreateItem) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		it.nft.Bytes(),
+		it.price.Bytes(),
+		it.end.Bytes(),
+		it.currency.Bytes(),
+	)
+}
+
+func (it AuctionCreateItem) NFT() nft.NFTID {
+	return it.nft
+}
+
+func (it AuctionCreateItem) Price() currency.Amount {
+	return it.price
+}
+
+func (it AuctionCreateItem) End() base.Height {
+	return it.end
+}
+
+func (it AuctionCreateItem) Currency() currency.CurrencyID {
+	return it.currency
+}
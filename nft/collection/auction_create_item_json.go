@@ -0,0 +1,49 @@
+package collection
+
+import (
+	"encoding/json"
+
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+type AuctionCreateItemJSONMarshaler struct {
+	hint.BaseHinter
+	NFT      nft.NFTID           `json:"nft"`
+	Price    currency.Amount     `json:"price"`
+	End      base.Height         `json:"end_height"`
+	Currency currency.CurrencyID `json:"currency"`
+}
+
+func (it AuctionCreateItem) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(AuctionCreateItemJSONMarshaler{
+		BaseHinter: it.BaseHinter,
+		NFT:        it.nft,
+		Price:      it.price,
+		End:        it.end,
+		Currency:   it.currency,
+	})
+}
+
+type AuctionCreateItemJSONUnmarshaler struct {
+	Hint     hint.Hint       `json:"_hint"`
+	NFT      json.RawMessage `json:"nft"`
+	Price    json.RawMessage `json:"price"`
+	End      base.Height     `json:"end_height"`
+	Currency string          `json:"currency"`
+}
+
+func (it *AuctionCreateItem) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of AuctionCreateItem")
+
+	var u AuctionCreateItemJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	return it.unmarshal(enc, u.Hint, u.NFT, u.Price, u.End, u.Currency)
+}
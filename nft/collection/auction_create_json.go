@@ -0,0 +1,66 @@
+package collection
+
+import (
+	"encoding/json"
+
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+)
+
+type AuctionCreateFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender base.Address        `json:"sender"`
+	Items  []AuctionCreateItem `json:"items"`
+}
+
+func (fact AuctionCreateFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(AuctionCreateFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Items:                 fact.items,
+	})
+}
+
+type AuctionCreateFactJSONUnmarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender string          `json:"sender"`
+	Items  json.RawMessage `json:"items"`
+}
+
+func (fact *AuctionCreateFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of AuctionCreateFact")
+
+	var uf AuctionCreateFactJSONUnmarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e(err, "")
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	return fact.unmarshal(enc, uf.Sender, uf.Items)
+}
+
+type auctionCreateMarshaler struct {
+	currency.BaseOperationJSONMarshaler
+}
+
+func (op AuctionCreate) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(auctionCreateMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *AuctionCreate) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of AuctionCreate")
+
+	var ubo currency.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e(err, "")
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
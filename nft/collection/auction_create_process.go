@@ -0,0 +1,313 @@
+package collection
+
+import (
+	"context"
+	"sync"
+
+	extensioncurrency "github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var auctionCreateItemProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(AuctionCreateItemProcessor)
+	},
+}
+
+var auctionCreateProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(AuctionCreateProcessor)
+	},
+}
+
+func (AuctionCreate) Process(
+	ctx context.Context, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type AuctionCreateItemProcessor struct {
+	h      util.Hash
+	sender base.Address
+	item   AuctionCreateItem
+	height base.Height
+}
+
+func (ipp *AuctionCreateItemProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) error {
+	nid := ipp.item.NFT()
+
+	st, err := existsState(StateKeyCollectionDesign(nid.Collection()), "key of design", getStateFunc)
+	if err != nil {
+		return errors.Errorf("collection design not found, %q: %w", nid.Collection(), err)
+	}
+
+	design, err := StateCollectionDesignValue(st)
+	if err != nil {
+		return errors.Errorf("collection design value not found, %q: %w", nid.Collection(), err)
+	}
+
+	if !design.Active() {
+		return errors.Errorf("deactivated collection, %q", nid.Collection())
+	}
+
+	st, err = existsState(extensioncurrency.StateKeyContractAccount(design.Parent()), "contract account", getStateFunc)
+	if err != nil {
+		return errors.Errorf("parent not found, %q: %w", design.Parent(), err)
+	}
+
+	ca, err := extensioncurrency.StateContractAccountValue(st)
+	if err != nil {
+		return errors.Errorf("contract account value not found, %q: %w", design.Parent(), err)
+	}
+
+	if !ca.IsActive() {
+		return errors.Errorf("deactivated contract account, %q", design.Parent())
+	}
+
+	st, err = existsState(StateKeyNFT(nid), "key of nft", getStateFunc)
+	if err != nil {
+		return errors.Errorf("nft not found, %q: %w", nid, err)
+	}
+
+	nv, err := StateNFTValue(st)
+	if err != nil {
+		return errors.Errorf("nft value not found, %q: %w", nid, err)
+	}
+
+	if !nv.Active() {
+		return errors.Errorf("burned nft, %q", nid)
+	}
+
+	if err := checkNotFrozen(nid, getStateFunc); err != nil {
+		return errors.Errorf("nft not available, %q: %w", nid, err)
+	}
+
+	if err := checkNotInAuction(nid, getStateFunc); err != nil {
+		return errors.Errorf("nft not available, %q: %w", nid, err)
+	}
+
+	if err := checkNotAttached(nid, getStateFunc); err != nil {
+		return errors.Errorf("nft not available, %q: %w", nid, err)
+	}
+
+	if err := checkTransferable(nid.Collection(), getStateFunc); err != nil {
+		return errors.Errorf("nft not transferable, %q: %w", nid, err)
+	}
+
+	if err := checkSigned(nv, getStateFunc); err != nil {
+		return errors.Errorf("nft not transferable, %q: %w", nid, err)
+	}
+
+	if !(nv.Owner().Equal(ipp.sender) || nv.Approved().Equal(ipp.sender)) {
+		if st, err := existsState(StateKeyAgentBox(nv.Owner(), nv.ID().Collection()), "agents", getStateFunc); err != nil {
+			return errors.Errorf("unauthorized sender, %q: %w", ipp.sender, err)
+		} else if box, err := StateAgentBoxValue(st); err != nil {
+			return errors.Errorf("agent box value not found, %q: %w", ipp.sender, err)
+		} else if !box.Exists(ipp.sender) {
+			return errors.Errorf("unauthorized sender, %q", ipp.sender)
+		}
+	}
+
+	price := ipp.item.Price()
+	if _, err := existsCurrencyPolicy(price.Currency(), getStateFunc); err != nil {
+		return errors.Errorf("currency of price not found, %q: %w", price.Currency(), err)
+	}
+
+	if ipp.item.End() <= ipp.height {
+		return errors.Errorf("end height must be over current height, %q; %v <= %v", nid, ipp.item.End(), ipp.height)
+	}
+
+	return nil
+}
+
+func (ipp *AuctionCreateItemProcessor) Process(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, error) {
+	nid := ipp.item.NFT()
+
+	st, err := existsState(StateKeyNFT(nid), "key of nft", getStateFunc)
+	if err != nil {
+		return nil, errors.Errorf("nft not found, %q: %w", nid, err)
+	}
+
+	nv, err := StateNFTValue(st)
+	if err != nil {
+		return nil, errors.Errorf("nft value not found, %q: %w", nid, err)
+	}
+
+	price := ipp.item.Price()
+
+	a := NewAuction(nid, true, nv.Owner(), price, ipp.item.End(), nv.Owner(), currency.NewZeroAmount(price.Currency()))
+	if err := a.IsValid(nil); err != nil {
+		return nil, errors.Errorf("invalid auction, %q: %w", nid, err)
+	}
+
+	sts := []base.StateMergeValue{NewAuctionStateMergeValue(StateKeyAuction(nid), NewAuctionStateValue(a))}
+
+	sv, err := cancelListing(nid, getStateFunc)
+	if err != nil {
+		return nil, errors.Errorf("failed to cancel listing, %q: %w", nid, err)
+	}
+	if sv != nil {
+		sts = append(sts, sv)
+	}
+
+	return sts, nil
+}
+
+func (ipp *AuctionCreateItemProcessor) Close() error {
+	ipp.h = nil
+	ipp.sender = nil
+	ipp.item = AuctionCreateItem{}
+	ipp.height = 0
+
+	auctionCreateItemProcessorPool.Put(ipp)
+
+	return nil
+}
+
+type AuctionCreateProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewAuctionCreateProcessor() extensioncurrency.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringErrorFunc("failed to create new AuctionCreateProcessor")
+
+		nopp := auctionCreateProcessorPool.Get()
+		opp, ok := nopp.(*AuctionCreateProcessor)
+		if !ok {
+			return nil, e(nil, "expected AuctionCreateProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e(err, "")
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *AuctionCreateProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	e := util.StringErrorFunc("failed to preprocess AuctionCreate")
+
+	fact, ok := op.Fact().(AuctionCreateFact)
+	if !ok {
+		return ctx, nil, e(nil, "expected AuctionCreateFact, not %T", op.Fact())
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, nil, e(err, "")
+	}
+
+	if err := checkExistsState(currency.StateKeyAccount(fact.Sender()), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("sender not found, %q: %w", fact.Sender(), err), nil
+	}
+
+	if err := checkFactSignsByState(fact.sender, op.Signs(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("invalid signing: %w", err), nil
+	}
+
+	for _, item := range fact.Items() {
+		ip := auctionCreateItemProcessorPool.Get()
+		ipc, ok := ip.(*AuctionCreateItemProcessor)
+		if !ok {
+			return nil, nil, e(nil, "expected AuctionCreateItemProcessor, not %T", ip)
+		}
+
+		ipc.h = op.Hash()
+		ipc.sender = fact.Sender()
+		ipc.item = item
+		ipc.height = opp.Height()
+
+		if err := ipc.PreProcess(ctx, op, getStateFunc); err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("fail to preprocess AuctionCreateItem: %w", err), nil
+		}
+
+		ipc.Close()
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *AuctionCreateProcessor) Process( // nolint:dupl
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	e := util.StringErrorFunc("failed to process AuctionCreate")
+
+	fact, ok := op.Fact().(AuctionCreateFact)
+	if !ok {
+		return nil, nil, e(nil, "expected AuctionCreateFact, not %T", op.Fact())
+	}
+
+	var sts []base.StateMergeValue // nolint:prealloc
+	for _, item := range fact.Items() {
+		ip := auctionCreateItemProcessorPool.Get()
+		ipc, ok := ip.(*AuctionCreateItemProcessor)
+		if !ok {
+			return nil, nil, e(nil, "expected AuctionCreateItemProcessor, not %T", ip)
+		}
+
+		ipc.h = op.Hash()
+		ipc.sender = fact.Sender()
+		ipc.item = item
+		ipc.height = opp.Height()
+
+		s, err := ipc.Process(ctx, op, getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("failed to process AuctionCreateItem: %w", err), nil
+		}
+		sts = append(sts, s...)
+
+		ipc.Close()
+	}
+
+	fitems := fact.Items()
+	items := make([]CollectionItem, len(fitems))
+	for i := range fact.Items() {
+		items[i] = fitems[i]
+	}
+
+	required, err := CalculateCollectionItemsFee(getStateFunc, items)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to calculate fee: %w", err), nil
+	}
+	sb, err := currency.CheckEnoughBalance(fact.sender, required, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to check enough balance: %w", err), nil
+	}
+
+	for i := range sb {
+		v, ok := sb[i].Value().(currency.BalanceStateValue)
+		if !ok {
+			return nil, nil, e(nil, "expected BalanceStateValue, not %T", sb[i].Value())
+		}
+		stv := currency.NewBalanceStateValue(v.Amount.WithBig(v.Amount.Big().Sub(required[i][0])))
+		sts = append(sts, currency.NewBalanceStateMergeValue(sb[i].Key(), stv))
+	}
+
+	return sts, nil, nil
+}
+
+func (opp *AuctionCreateProcessor) Close() error {
+	auctionCreateProcessorPool.Put(opp)
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package collection
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (a *Auction) unmarshal(
+	enc encoder.Encoder,
+	ht hint.Hint,
+	bn []byte,
+	ac bool,
+	sl string,
+	bp []byte,
+	end base.Height,
+	bd string,
+	bb []byte,
+) error {
+	e := util.StringErrorFunc("failed to unmarshal Auction")
+
+	a.BaseHinter = hint.NewBaseHinter(ht)
+	a.active = ac
+	a.end = end
+
+	if hinter, err := enc.Decode(bn); err != nil {
+		return e(err, "")
+	} else if n, ok := hinter.(nft.NFTID); !ok {
+		return e(util.ErrWrongType.Errorf("expected NFTID, not %T", hinter), "")
+	} else {
+		a.nft = n
+	}
+
+	seller, err := base.DecodeAddress(sl, enc)
+	if err != nil {
+		return e(err, "")
+	}
+	a.seller = seller
+
+	if hinter, err := enc.Decode(bp); err != nil {
+		return e(err, "")
+	} else if am, ok := hinter.(currency.Amount); !ok {
+		return e(util.ErrWrongType.Errorf("expected Amount, not %T", hinter), "")
+	} else {
+		a.price = am
+	}
+
+	bidder, err := base.DecodeAddress(bd, enc)
+	if err != nil {
+		return e(err, "")
+	}
+	a.bidder = bidder
+
+	if hinter, err := enc.Decode(bb); err != nil {
+		return e(err, "")
+	} else if am, ok := hinter.(currency.Amount); !ok {
+		return e(util.ErrWrongType.Errorf("expected Amount, not %T", hinter), "")
+	} else {
+		a.bid = am
+	}
+
+	return nil
+}
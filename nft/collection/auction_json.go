@@ -0,0 +1,58 @@
+package collection
+
+import (
+	"encoding/json"
+
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+type AuctionJSONMarshaler struct {
+	hint.BaseHinter
+	NFT    nft.NFTID       `json:"nft"`
+	Active bool            `json:"active"`
+	Seller base.Address    `json:"seller"`
+	Price  currency.Amount `json:"price"`
+	End    base.Height     `json:"end_height"`
+	Bidder base.Address    `json:"bidder"`
+	Bid    currency.Amount `json:"bid"`
+}
+
+func (a Auction) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(AuctionJSONMarshaler{
+		BaseHinter: a.BaseHinter,
+		NFT:        a.nft,
+		Active:     a.active,
+		Seller:     a.seller,
+		Price:      a.price,
+		End:        a.end,
+		Bidder:     a.bidder,
+		Bid:        a.bid,
+	})
+}
+
+type AuctionJSONUnmarshaler struct {
+	Hint   hint.Hint       `json:"_hint"`
+	NFT    json.RawMessage `json:"nft"`
+	Active bool            `json:"active"`
+	Seller string          `json:"seller"`
+	Price  json.RawMessage `json:"price"`
+	End    base.Height     `json:"end_height"`
+	Bidder string          `json:"bidder"`
+	Bid    json.RawMessage `json:"bid"`
+}
+
+func (a *Auction) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of Auction")
+
+	var u AuctionJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	return a.unmarshal(enc, u.Hint, u.NFT, u.Active, u.Seller, u.Price, u.End, u.Bidder, u.Bid)
+}
@@ -0,0 +1,132 @@
+package collection
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var MaxAuctionSettleItems = 10
+
+var (
+	AuctionSettleFactHint = hint.MustNewHint("mitum-nft-auction-settle-operation-fact-v0.0.1")
+	AuctionSettleHint     = hint.MustNewHint("mitum-nft-auction-settle-operation-v0.0.1")
+)
+
+type AuctionSettleFact struct {
+	base.BaseFact
+	sender base.Address
+	items  []AuctionSettleItem
+}
+
+func NewAuctionSettleFact(token []byte, sender base.Address, items []AuctionSettleItem) AuctionSettleFact {
+	bf := base.NewBaseFact(AuctionSettleFactHint, token)
+	fact := AuctionSettleFact{
+		BaseFact: bf,
+		sender:   sender,
+		items:    items,
+	}
+
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact AuctionSettleFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := currency.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if l := len(fact.items); l < 1 {
+		return util.ErrInvalid.Errorf("empty items for AuctionSettleFact")
+	} else if l > int(MaxAuctionSettleItems) {
+		return util.ErrInvalid.Errorf("items over allowed, %d > %d", l, MaxAuctionSettleItems)
+	}
+
+	if err := fact.sender.IsValid(nil); err != nil {
+		return err
+	}
+
+	founds := map[string]struct{}{}
+	for _, item := range fact.items {
+		if err := item.IsValid(nil); err != nil {
+			return err
+		}
+
+		n := item.NFT()
+		if err := n.IsValid(nil); err != nil {
+			return err
+		}
+
+		if _, found := founds[n.String()]; found {
+			return util.ErrInvalid.Errorf("duplicate nft found, %q", n)
+		}
+
+		founds[n.String()] = struct{}{}
+	}
+
+	return nil
+}
+
+func (fact AuctionSettleFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact AuctionSettleFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact AuctionSettleFact) Bytes() []byte {
+	is := make([][]byte, len(fact.items))
+
+	for i, item := range fact.items {
+		is[i] = item.Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		util.ConcatBytesSlice(is...),
+	)
+}
+
+func (fact AuctionSettleFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact AuctionSettleFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact AuctionSettleFact) Items() []AuctionSettleItem {
+	return fact.items
+}
+
+func (fact AuctionSettleFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 1)
+	as[0] = fact.sender
+
+	return as, nil
+}
+
+type AuctionSettle struct {
+	currency.BaseOperation
+}
+
+func NewAuctionSettle(fact AuctionSettleFact) (AuctionSettle, error) {
+	return AuctionSettle{BaseOperation: currency.NewBaseOperation(AuctionSettleHint, fact)}, nil
+}
+
+func (op *AuctionSettle) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
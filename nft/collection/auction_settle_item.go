@@ -0,0 +1,48 @@
+package collection
+
+import (
+	"github.com/ProtoconNet/mitum-nft/nft"
+
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+var AuctionSettleItemHint = hint.MustNewHint("mitum-nft-auction-settle-item-v0.0.1")
+
+type AuctionSettleItem struct {
+	hint.BaseHinter
+	nft      nft.NFTID
+	currency currency.CurrencyID
+}
+
+func NewAuctionSettleItem(n nft.NFTID, currency currency.CurrencyID) AuctionSettleItem {
+	return AuctionSettleItem{
+		BaseHinter: hint.NewBaseHinter(AuctionSettleItemHint),
+		nft:        n,
+		currency:   currency,
+	}
+}
+
+func (it AuctionSettleItem) IsValid([]byte) error {
+	return util.CheckIsValiders(nil, false,
+		it.BaseHinter,
+		it.nft,
+		it.currency,
+	)
+}
+
+func (it AuctionSettleItem) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		it.nft.Bytes(),
+		it.currency.Bytes(),
+	)
+}
+
+func (it AuctionSettleItem) NFT() nft.NFTID {
+	return it.nft
+}
+
+func (it AuctionSettleItem) Currency() currency.CurrencyID {
+	return it.currency
+}
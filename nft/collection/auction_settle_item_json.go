@@ -0,0 +1,43 @@
+package collection
+
+import (
+	"encoding/json"
+
+	"github.com/ProtoconNet/mitum-nft/nft"
+
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+type AuctionSettleItemJSONMarshaler struct {
+	hint.BaseHinter
+	NFT      nft.NFTID           `json:"nft"`
+	Currency currency.CurrencyID `json:"currency"`
+}
+
+func (it AuctionSettleItem) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(AuctionSettleItemJSONMarshaler{
+		BaseHinter: it.BaseHinter,
+		NFT:        it.nft,
+		Currency:   it.currency,
+	})
+}
+
+type AuctionSettleItemJSONUnmarshaler struct {
+	Hint     hint.Hint       `json:"_hint"`
+	NFT      json.RawMessage `json:"nft"`
+	Currency string          `json:"currency"`
+}
+
+func (it *AuctionSettleItem) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed decode json of AuctionSettleItem")
+
+	var u AuctionSettleItemJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	return it.unmarshal(enc, u.Hint, u.NFT, u.Currency)
+}
@@ -0,0 +1,66 @@
+package collection
+
+import (
+	"encoding/json"
+
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+)
+
+type AuctionSettleFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender base.Address        `json:"sender"`
+	Items  []AuctionSettleItem `json:"items"`
+}
+
+func (fact AuctionSettleFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(AuctionSettleFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Items:                 fact.items,
+	})
+}
+
+type AuctionSettleFactJSONUnmarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender string          `json:"sender"`
+	Items  json.RawMessage `json:"items"`
+}
+
+func (fact *AuctionSettleFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of AuctionSettleFact")
+
+	var uf AuctionSettleFactJSONUnmarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e(err, "")
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	return fact.unmarshal(enc, uf.Sender, uf.Items)
+}
+
+type auctionSettleMarshaler struct {
+	currency.BaseOperationJSONMarshaler
+}
+
+func (op AuctionSettle) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(auctionSettleMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *AuctionSettle) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of AuctionSettle")
+
+	var ubo currency.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e(err, "")
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
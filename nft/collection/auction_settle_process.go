@@ -0,0 +1,295 @@
+package collection
+
+import (
+	"context"
+	"sync"
+
+	extensioncurrency "github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var auctionSettleItemProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(AuctionSettleItemProcessor)
+	},
+}
+
+var auctionSettleProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(AuctionSettleProcessor)
+	},
+}
+
+func (AuctionSettle) Process(
+	ctx context.Context, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type AuctionSettleItemProcessor struct {
+	h        util.Hash
+	sender   base.Address
+	item     AuctionSettleItem
+	height   base.Height
+	balances *balanceChanges
+}
+
+func (ipp *AuctionSettleItemProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) error {
+	nid := ipp.item.NFT()
+
+	st, err := existsState(StateKeyNFT(nid), "key of nft", getStateFunc)
+	if err != nil {
+		return errors.Errorf("nft not found, %q: %w", nid, err)
+	}
+
+	nv, err := StateNFTValue(st)
+	if err != nil {
+		return errors.Errorf("nft value not found, %q: %w", nid, err)
+	}
+
+	if _, err := isTransferBlocked(nv, getStateFunc); err != nil {
+		return errors.Errorf("failed to check nft transfer, %q: %w", nid, err)
+	}
+
+	st, err = existsState(StateKeyAuction(nid), "key of auction", getStateFunc)
+	if err != nil {
+		return errors.Errorf("auction not found, %q: %w", nid, err)
+	}
+
+	a, err := StateAuctionValue(st)
+	if err != nil {
+		return errors.Errorf("auction value not found, %q: %w", nid, err)
+	}
+
+	if !a.Active() {
+		return errors.Errorf("auction already closed, %q", nid)
+	}
+
+	if ipp.height < a.End() {
+		return errors.Errorf("auction not ended yet, %q; %v < %v", nid, ipp.height, a.End())
+	}
+
+	return nil
+}
+
+func (ipp *AuctionSettleItemProcessor) Process(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, error) {
+	nid := ipp.item.NFT()
+
+	st, err := existsState(StateKeyNFT(nid), "key of nft", getStateFunc)
+	if err != nil {
+		return nil, errors.Errorf("nft not found, %q: %w", nid, err)
+	}
+
+	nv, err := StateNFTValue(st)
+	if err != nil {
+		return nil, errors.Errorf("nft value not found, %q: %w", nid, err)
+	}
+
+	ast, err := existsState(StateKeyAuction(nid), "key of auction", getStateFunc)
+	if err != nil {
+		return nil, errors.Errorf("auction not found, %q: %w", nid, err)
+	}
+
+	a, err := StateAuctionValue(ast)
+	if err != nil {
+		return nil, errors.Errorf("auction value not found, %q: %w", nid, err)
+	}
+
+	sts := []base.StateMergeValue{
+		NewAuctionStateMergeValue(ast.Key(), NewAuctionStateValue(
+			NewAuction(nid, false, a.Seller(), a.Price(), a.End(), a.Bidder(), a.Bid()),
+		)),
+	}
+
+	if !a.HasBid() {
+		return sts, nil
+	}
+
+	// frozen or unverified nfts, or their descendants, can not move, so the auction closes and the highest bid goes back to the bidder.
+	switch blocked, err := isTransferBlocked(nv, getStateFunc); {
+	case err != nil:
+		return nil, errors.Errorf("failed to check nft transfer, %q: %w", nid, err)
+	case blocked, checkChildrenMovable(nid, getStateFunc) != nil:
+		if err := ipp.balances.add(a.Bidder(), a.Bid()); err != nil {
+			return nil, errors.Errorf("failed to refund bid, %q: %w", nid, err)
+		}
+
+		return sts, nil
+	}
+
+	if err := payNFTPrice(ipp.balances, nv, a.Seller(), a.Bid(), getStateFunc); err != nil {
+		return nil, errors.Errorf("failed to settle price, %q: %w", nid, err)
+	}
+
+	n := nft.NewNFT(nid, nv.Active(), a.Bidder(), nv.NFTHash(), nv.URI(), a.Bidder(), nv.Creators(), nv.Copyrighters(), a.Bidder(), 0, nv.Royalty(), nv.RoyaltyReceiver())
+	if err := n.IsValid(nil); err != nil {
+		return nil, errors.Errorf("invalid nft, %q: %w", nid, err)
+	}
+
+	sts = append(sts, NewNFTStateMergeValue(st.Key(), NewNFTStateValue(n)))
+
+	s, err := moveChildren(nid, a.Bidder(), getStateFunc)
+	if err != nil {
+		return nil, errors.Errorf("failed to move children, %q: %w", nid, err)
+	}
+
+	return append(sts, s...), nil
+}
+
+func (ipp *AuctionSettleItemProcessor) Close() error {
+	ipp.h = nil
+	ipp.sender = nil
+	ipp.item = AuctionSettleItem{}
+	ipp.height = 0
+	ipp.balances = nil
+
+	auctionSettleItemProcessorPool.Put(ipp)
+
+	return nil
+}
+
+type AuctionSettleProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewAuctionSettleProcessor() extensioncurrency.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringErrorFunc("failed to create new AuctionSettleProcessor")
+
+		nopp := auctionSettleProcessorPool.Get()
+		opp, ok := nopp.(*AuctionSettleProcessor)
+		if !ok {
+			return nil, e(nil, "expected AuctionSettleProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e(err, "")
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *AuctionSettleProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	e := util.StringErrorFunc("failed to preprocess AuctionSettle")
+
+	fact, ok := op.Fact().(AuctionSettleFact)
+	if !ok {
+		return ctx, nil, e(nil, "expected AuctionSettleFact, not %T", op.Fact())
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, nil, e(err, "")
+	}
+
+	if err := checkExistsState(currency.StateKeyAccount(fact.Sender()), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("sender not found, %q: %w", fact.Sender(), err), nil
+	}
+
+	if err := checkFactSignsByState(fact.sender, op.Signs(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("invalid signing: %w", err), nil
+	}
+
+	for _, item := range fact.Items() {
+		ip := auctionSettleItemProcessorPool.Get()
+		ipc, ok := ip.(*AuctionSettleItemProcessor)
+		if !ok {
+			return nil, nil, e(nil, "expected AuctionSettleItemProcessor, not %T", ip)
+		}
+
+		ipc.h = op.Hash()
+		ipc.sender = fact.Sender()
+		ipc.item = item
+		ipc.height = opp.Height()
+		ipc.balances = nil
+
+		if err := ipc.PreProcess(ctx, op, getStateFunc); err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("fail to preprocess AuctionSettleItem: %w", err), nil
+		}
+
+		ipc.Close()
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *AuctionSettleProcessor) Process( // nolint:dupl
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	e := util.StringErrorFunc("failed to process AuctionSettle")
+
+	fact, ok := op.Fact().(AuctionSettleFact)
+	if !ok {
+		return nil, nil, e(nil, "expected AuctionSettleFact, not %T", op.Fact())
+	}
+
+	balances := newBalanceChanges(getStateFunc)
+
+	var sts []base.StateMergeValue // nolint:prealloc
+	for _, item := range fact.Items() {
+		ip := auctionSettleItemProcessorPool.Get()
+		ipc, ok := ip.(*AuctionSettleItemProcessor)
+		if !ok {
+			return nil, nil, e(nil, "expected AuctionSettleItemProcessor, not %T", ip)
+		}
+
+		ipc.h = op.Hash()
+		ipc.sender = fact.Sender()
+		ipc.item = item
+		ipc.height = opp.Height()
+		ipc.balances = balances
+
+		s, err := ipc.Process(ctx, op, getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("failed to process AuctionSettleItem: %w", err), nil
+		}
+		sts = append(sts, s...)
+
+		ipc.Close()
+	}
+
+	fitems := fact.Items()
+	items := make([]CollectionItem, len(fitems))
+	for i := range fact.Items() {
+		items[i] = fitems[i]
+	}
+
+	required, err := CalculateCollectionItemsFee(getStateFunc, items)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to calculate fee: %w", err), nil
+	}
+
+	if err := balances.subFee(fact.Sender(), required); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to check enough balance: %w", err), nil
+	}
+
+	sts = append(sts, balances.stateMergeValues()...)
+
+	return sts, nil, nil
+}
+
+func (opp *AuctionSettleProcessor) Close() error {
+	auctionSettleProcessorPool.Put(opp)
+
+	return nil
+}
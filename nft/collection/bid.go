@@ -0,0 +1,132 @@
+package collection
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var MaxBidItems = 10
+
+var (
+	BidFactHint = hint.MustNewHint("mitum-nft-bid-operation-fact-v0.0.1")
+	BidHint     = hint.MustNewHint("mitum-nft-bid-operation-v0.0.1")
+)
+
+type BidFact struct {
+	base.BaseFact
+	sender base.Address
+	items  []BidItem
+}
+
+func NewBidFact(token []byte, sender base.Address, items []BidItem) BidFact {
+	bf := base.NewBaseFact(BidFactHint, token)
+	fact := BidFact{
+		BaseFact: bf,
+		sender:   sender,
+		items:    items,
+	}
+
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact BidFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := currency.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if l := len(fact.items); l < 1 {
+		return util.ErrInvalid.Errorf("empty items for BidFact")
+	} else if l > int(MaxBidItems) {
+		return util.ErrInvalid.Errorf("items over allowed, %d > %d", l, MaxBidItems)
+	}
+
+	if err := fact.sender.IsValid(nil); err != nil {
+		return err
+	}
+
+	founds := map[string]struct{}{}
+	for _, item := range fact.items {
+		if err := item.IsValid(nil); err != nil {
+			return err
+		}
+
+		n := item.NFT()
+		if err := n.IsValid(nil); err != nil {
+			return err
+		}
+
+		if _, found := founds[n.String()]; found {
+			return util.ErrInvalid.Errorf("duplicate nft found, %q", n)
+		}
+
+		founds[n.String()] = struct{}{}
+	}
+
+	return nil
+}
+
+func (fact BidFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact BidFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact BidFact) Bytes() []byte {
+	is := make([][]byte, len(fact.items))
+
+	for i, item := range fact.items {
+		is[i] = item.Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		util.ConcatBytesSlice(is...),
+	)
+}
+
+func (fact BidFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact BidFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact BidFact) Items() []BidItem {
+	return fact.items
+}
+
+func (fact BidFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 1)
+	as[0] = fact.sender
+
+	return as, nil
+}
+
+type Bid struct {
+	currency.BaseOperation
+}
+
+func NewBid(fact BidFact) (Bid, error) {
+	return Bid{BaseOperation: currency.NewBaseOperation(BidHint, fact)}, nil
+}
+
+func (op *Bid) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,35 @@
+package collection
+
+import (
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+func (fact *BidFact) unmarshal(enc encoder.Encoder, sd string, bit []byte) error {
+	e := util.StringErrorFunc("failed to unmarshal BidFact")
+
+	sender, err := base.DecodeAddress(sd, enc)
+	if err != nil {
+		return e(err, "")
+	}
+	fact.sender = sender
+
+	hit, err := enc.DecodeSlice(bit)
+	if err != nil {
+		return e(err, "")
+	}
+
+	items := make([]BidItem, len(hit))
+	for i, hinter := range hit {
+		item, ok := hinter.(BidItem)
+		if !ok {
+			return e(util.ErrWrongType.Errorf("expected BidItem, not %T", hinter), "")
+		}
+
+		items[i] = item
+	}
+	fact.items = items
+
+	return nil
+}
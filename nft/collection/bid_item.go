@@ -0,0 +1,58 @@
+package collection
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+var BidItemHint = hint.MustNewHint("mitum-nft-bid-item-v0.0.1")
+
+type BidItem struct {
+	hint.BaseHinter
+	nft      nft.NFTID
+	amount   currency.Amount
+	currency currency.CurrencyID
+}
+
+func NewBidItem(n nft.NFTID, amount currency.Amount, currency currency.CurrencyID) BidItem {
+	return BidItem{
+		BaseHinter: hint.NewBaseHinter(BidItemHint),
+		nft:        n,
+		amount:     amount,
+		currency:   currency,
+	}
+}
+
+func (it BidItem) IsValid([]byte) error {
+	if err := util.CheckIsValiders(nil, false, it.BaseHinter, it.nft, it.amount, it.currency); err != nil {
+		return err
+	}
+
+	if !it.amount.Big().OverZero() {
+		return util.ErrInvalid.Errorf("amount must be over zero, %q", it.amount.Big())
+	}
+
+	return nil
+}
+
+func (it BidItem) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		it.nft.Bytes(),
+		it.amount.Bytes(),
+		it.currency.Bytes(),
+	)
+}
+
+func (it BidItem) NFT() nft.NFTID {
+	return it.nft
+}
+
+func (it BidItem) Amount() currency.Amount {
+	return it.amount
+}
+
+func (it BidItem) Currency() currency.CurrencyID {
+	return it.currency
+}
@@ -0,0 +1,45 @@
+package collection
+
+import (
+	"encoding/json"
+
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+type BidItemJSONMarshaler struct {
+	hint.BaseHinter
+	NFT      nft.NFTID           `json:"nft"`
+	Amount   currency.Amount     `json:"amount"`
+	Currency currency.CurrencyID `json:"currency"`
+}
+
+func (it BidItem) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(BidItemJSONMarshaler{
+		BaseHinter: it.BaseHinter,
+		NFT:        it.nft,
+		Amount:     it.amount,
+		Currency:   it.currency,
+	})
+}
+
+type BidItemJSONUnmarshaler struct {
+	Hint     hint.Hint       `json:"_hint"`
+	NFT      json.RawMessage `json:"nft"`
+	Amount   json.RawMessage `json:"amount"`
+	Currency string          `json:"currency"`
+}
+
+func (it *BidItem) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of BidItem")
+
+	var u BidItemJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	return it.unmarshal(enc, u.Hint, u.NFT, u.Amount, u.Currency)
+}
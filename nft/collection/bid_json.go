@@ -0,0 +1,66 @@
+package collection
+
+import (
+	"encoding/json"
+
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+)
+
+type BidFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender base.Address `json:"sender"`
+	Items  []BidItem    `json:"items"`
+}
+
+func (fact BidFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(BidFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Items:                 fact.items,
+	})
+}
+
+type BidFactJSONUnmarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender string          `json:"sender"`
+	Items  json.RawMessage `json:"items"`
+}
+
+func (fact *BidFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of BidFact")
+
+	var uf BidFactJSONUnmarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e(err, "")
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	return fact.unmarshal(enc, uf.Sender, uf.Items)
+}
+
+type bidMarshaler struct {
+	currency.BaseOperationJSONMarshaler
+}
+
+func (op Bid) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(bidMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *Bid) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of Bid")
+
+	var ubo currency.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e(err, "")
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,314 @@
+package collection
+
+import (
+	"context"
+	"sync"
+
+	extensioncurrency "github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var bidItemProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(BidItemProcessor)
+	},
+}
+
+var bidProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(BidProcessor)
+	},
+}
+
+func (Bid) Process(
+	ctx context.Context, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type BidItemProcessor struct {
+	h        util.Hash
+	sender   base.Address
+	item     BidItem
+	height   base.Height
+	balances *balanceChanges
+}
+
+func (ipp *BidItemProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) error {
+	nid := ipp.item.NFT()
+
+	st, err := existsState(StateKeyCollectionDesign(nid.Collection()), "key of design", getStateFunc)
+	if err != nil {
+		return errors.Errorf("collection design not found, %q: %w", nid.Collection(), err)
+	}
+
+	design, err := StateCollectionDesignValue(st)
+	if err != nil {
+		return errors.Errorf("collection design value not found, %q: %w", nid.Collection(), err)
+	}
+
+	if !design.Active() {
+		return errors.Errorf("deactivated collection, %q", nid.Collection())
+	}
+
+	st, err = existsState(extensioncurrency.StateKeyContractAccount(design.Parent()), "contract account", getStateFunc)
+	if err != nil {
+		return errors.Errorf("parent not found, %q: %w", design.Parent(), err)
+	}
+
+	ca, err := extensioncurrency.StateContractAccountValue(st)
+	if err != nil {
+		return errors.Errorf("contract account value not found, %q: %w", design.Parent(), err)
+	}
+
+	if !ca.IsActive() {
+		return errors.Errorf("deactivated contract account, %q", design.Parent())
+	}
+
+	st, err = existsState(StateKeyNFT(nid), "key of nft", getStateFunc)
+	if err != nil {
+		return errors.Errorf("nft not found, %q: %w", nid, err)
+	}
+
+	nv, err := StateNFTValue(st)
+	if err != nil {
+		return errors.Errorf("nft value not found, %q: %w", nid, err)
+	}
+
+	if !nv.Active() {
+		return errors.Errorf("burned nft, %q", nid)
+	}
+
+	if err := checkNotFrozen(nid, getStateFunc); err != nil {
+		return errors.Errorf("nft not available, %q: %w", nid, err)
+	}
+
+	st, err = existsState(StateKeyAuction(nid), "key of auction", getStateFunc)
+	if err != nil {
+		return errors.Errorf("auction not found, %q: %w", nid, err)
+	}
+
+	a, err := StateAuctionValue(st)
+	if err != nil {
+		return errors.Errorf("auction value not found, %q: %w", nid, err)
+	}
+
+	if !a.Active() {
+		return errors.Errorf("auction already closed, %q", nid)
+	}
+
+	if ipp.height >= a.End() {
+		return errors.Errorf("auction already ended, %q; %v >= %v", nid, ipp.height, a.End())
+	}
+
+	if a.Seller().Equal(ipp.sender) {
+		return errors.Errorf("seller cannot bid, %q", nid)
+	}
+
+	am := ipp.item.Amount()
+	if am.Currency() != a.Price().Currency() {
+		return errors.Errorf("currency of bid not matched with auction, %q; %q != %q", nid, am.Currency(), a.Price().Currency())
+	}
+
+	if a.HasBid() {
+		if am.Big().Compare(a.Bid().Big()) <= 0 {
+			return errors.Errorf("bid must be over highest bid, %q; %v <= %v", nid, am.Big(), a.Bid().Big())
+		}
+	} else if am.Big().Compare(a.Price().Big()) < 0 {
+		return errors.Errorf("bid must not be under start price, %q; %v < %v", nid, am.Big(), a.Price().Big())
+	}
+
+	return nil
+}
+
+func (ipp *BidItemProcessor) Process(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, error) {
+	nid := ipp.item.NFT()
+
+	ast, err := existsState(StateKeyAuction(nid), "key of auction", getStateFunc)
+	if err != nil {
+		return nil, errors.Errorf("auction not found, %q: %w", nid, err)
+	}
+
+	a, err := StateAuctionValue(ast)
+	if err != nil {
+		return nil, errors.Errorf("auction value not found, %q: %w", nid, err)
+	}
+
+	if err := ipp.balances.sub(ipp.sender, ipp.item.Amount()); err != nil {
+		return nil, errors.Errorf("failed to escrow bid, %q: %w", nid, err)
+	}
+
+	if a.HasBid() {
+		if err := ipp.balances.add(a.Bidder(), a.Bid()); err != nil {
+			return nil, errors.Errorf("failed to refund previous bid, %q: %w", nid, err)
+		}
+	}
+
+	na := NewAuction(nid, a.Active(), a.Seller(), a.Price(), a.End(), ipp.sender, ipp.item.Amount())
+	if err := na.IsValid(nil); err != nil {
+		return nil, errors.Errorf("invalid auction, %q: %w", nid, err)
+	}
+
+	return []base.StateMergeValue{NewAuctionStateMergeValue(ast.Key(), NewAuctionStateValue(na))}, nil
+}
+
+func (ipp *BidItemProcessor) Close() error {
+	ipp.h = nil
+	ipp.sender = nil
+	ipp.item = BidItem{}
+	ipp.height = 0
+	ipp.balances = nil
+
+	bidItemProcessorPool.Put(ipp)
+
+	return nil
+}
+
+type BidProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewBidProcessor() extensioncurrency.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringErrorFunc("failed to create new BidProcessor")
+
+		nopp := bidProcessorPool.Get()
+		opp, ok := nopp.(*BidProcessor)
+		if !ok {
+			return nil, e(nil, "expected BidProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e(err, "")
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *BidProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	e := util.StringErrorFunc("failed to preprocess Bid")
+
+	fact, ok := op.Fact().(BidFact)
+	if !ok {
+		return ctx, nil, e(nil, "expected BidFact, not %T", op.Fact())
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, nil, e(err, "")
+	}
+
+	if err := checkExistsState(currency.StateKeyAccount(fact.Sender()), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("sender not found, %q: %w", fact.Sender(), err), nil
+	}
+
+	if err := checkNotExistsState(extensioncurrency.StateKeyContractAccount(fact.Sender()), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("contract account cannot bid, %q", fact.Sender()), nil
+	}
+
+	if err := checkFactSignsByState(fact.sender, op.Signs(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("invalid signing: %w", err), nil
+	}
+
+	for _, item := range fact.Items() {
+		ip := bidItemProcessorPool.Get()
+		ipc, ok := ip.(*BidItemProcessor)
+		if !ok {
+			return nil, nil, e(nil, "expected BidItemProcessor, not %T", ip)
+		}
+
+		ipc.h = op.Hash()
+		ipc.sender = fact.Sender()
+		ipc.item = item
+		ipc.height = opp.Height()
+		ipc.balances = nil
+
+		if err := ipc.PreProcess(ctx, op, getStateFunc); err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("fail to preprocess BidItem: %w", err), nil
+		}
+
+		ipc.Close()
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *BidProcessor) Process( // nolint:dupl
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	e := util.StringErrorFunc("failed to process Bid")
+
+	fact, ok := op.Fact().(BidFact)
+	if !ok {
+		return nil, nil, e(nil, "expected BidFact, not %T", op.Fact())
+	}
+
+	balances := newBalanceChanges(getStateFunc)
+
+	var sts []base.StateMergeValue // nolint:prealloc
+	for _, item := range fact.Items() {
+		ip := bidItemProcessorPool.Get()
+		ipc, ok := ip.(*BidItemProcessor)
+		if !ok {
+			return nil, nil, e(nil, "expected BidItemProcessor, not %T", ip)
+		}
+
+		ipc.h = op.Hash()
+		ipc.sender = fact.Sender()
+		ipc.item = item
+		ipc.height = opp.Height()
+		ipc.balances = balances
+
+		s, err := ipc.Process(ctx, op, getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("failed to process BidItem: %w", err), nil
+		}
+		sts = append(sts, s...)
+
+		ipc.Close()
+	}
+
+	fitems := fact.Items()
+	items := make([]CollectionItem, len(fitems))
+	for i := range fact.Items() {
+		items[i] = fitems[i]
+	}
+
+	required, err := CalculateCollectionItemsFee(getStateFunc, items)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to calculate fee: %w", err), nil
+	}
+
+	if err := balances.subFee(fact.Sender(), required); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to check enough balance: %w", err), nil
+	}
+
+	sts = append(sts, balances.stateMergeValues()...)
+
+	return sts, nil, nil
+}
+
+func (opp *BidProcessor) Close() error {
+	bidProcessorPool.Put(opp)
+
+	return nil
+}
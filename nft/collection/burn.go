@@ -0,0 +1,132 @@
+package collection
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var MaxBurnItems = 10
+
+var (
+	BurnFactHint = hint.MustNewHint("mitum-nft-burn-operation-fact-v0.0.1")
+	BurnHint     = hint.MustNewHint("mitum-nft-burn-operation-v0.0.1")
+)
+
+type BurnFact struct {
+	base.BaseFact
+	sender base.Address
+	items  []BurnItem
+}
+
+func NewBurnFact(token []byte, sender base.Address, items []BurnItem) BurnFact {
+	bf := base.NewBaseFact(BurnFactHint, token)
+	fact := BurnFact{
+		BaseFact: bf,
+		sender:   sender,
+		items:    items,
+	}
+
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact BurnFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := currency.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if l := len(fact.items); l < 1 {
+		return util.ErrInvalid.Errorf("empty items for BurnFact")
+	} else if l > int(MaxBurnItems) {
+		return util.ErrInvalid.Errorf("items over allowed, %d > %d", l, MaxBurnItems)
+	}
+
+	if err := fact.sender.IsValid(nil); err != nil {
+		return err
+	}
+
+	founds := map[string]struct{}{}
+	for _, item := range fact.items {
+		if err := item.IsValid(nil); err != nil {
+			return err
+		}
+
+		n := item.NFT()
+		if err := n.IsValid(nil); err != nil {
+			return err
+		}
+
+		if _, found := founds[n.String()]; found {
+			return util.ErrInvalid.Errorf("duplicate nft found, %q", n)
+		}
+
+		founds[n.String()] = struct{}{}
+	}
+
+	return nil
+}
+
+func (fact BurnFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact BurnFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact BurnFact) Bytes() []byte {
+	is := make([][]byte, len(fact.items))
+
+	for i, item := range fact.items {
+		is[i] = item.Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		util.ConcatBytesSlice(is...),
+	)
+}
+
+func (fact BurnFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact BurnFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact BurnFact) Items() []BurnItem {
+	return fact.items
+}
+
+func (fact BurnFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 1)
+	as[0] = fact.sender
+
+	return as, nil
+}
+
+type Burn struct {
+	currency.BaseOperation
+}
+
+func NewBurn(fact BurnFact) (Burn, error) {
+	return Burn{BaseOperation: currency.NewBaseOperation(BurnHint, fact)}, nil
+}
+
+func (op *Burn) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
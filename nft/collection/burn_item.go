@@ -0,0 +1,48 @@
+package collection
+
+import (
+	"github.com/ProtoconNet/mitum-nft/nft"
+
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+var BurnItemHint = hint.MustNewHint("mitum-nft-burn-item-v0.0.1")
+
+type BurnItem struct {
+	hint.BaseHinter
+	nft      nft.NFTID
+	currency currency.CurrencyID
+}
+
+func NewBurnItem(n nft.NFTID, currency currency.CurrencyID) BurnItem {
+	return BurnItem{
+		BaseHinter: hint.NewBaseHinter(BurnItemHint),
+		nft:        n,
+		currency:   currency,
+	}
+}
+
+func (it BurnItem) IsValid([]byte) error {
+	return util.CheckIsValiders(nil, false,
+		it.BaseHinter,
+		it.nft,
+		it.currency,
+	)
+}
+
+func (it BurnItem) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		it.nft.Bytes(),
+		it.currency.Bytes(),
+	)
+}
+
+func (it BurnItem) NFT() nft.NFTID {
+	return it.nft
+}
+
+func (it BurnItem) Currency() currency.CurrencyID {
+	return it.currency
+}
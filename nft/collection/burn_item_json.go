@@ -0,0 +1,43 @@
+package collection
+
+import (
+	"encoding/json"
+
+	"github.com/ProtoconNet/mitum-nft/nft"
+
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+type BurnItemJSONMarshaler struct {
+	hint.BaseHinter
+	NFT      nft.NFTID           `json:"nft"`
+	Currency currency.CurrencyID `json:"currency"`
+}
+
+func (it BurnItem) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(BurnItemJSONMarshaler{
+		BaseHinter: it.BaseHinter,
+		NFT:        it.nft,
+		Currency:   it.currency,
+	})
+}
+
+type BurnItemJSONUnmarshaler struct {
+	Hint     hint.Hint       `json:"_hint"`
+	NFT      json.RawMessage `json:"nft"`
+	Currency string          `json:"currency"`
+}
+
+func (it *BurnItem) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed decode json of BurnItem")
+
+	var u BurnItemJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	return it.unmarshal(enc, u.Hint, u.NFT, u.Currency)
+}
@@ -0,0 +1,66 @@
+package collection
+
+import (
+	"encoding/json"
+
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+)
+
+type BurnFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender base.Address `json:"sender"`
+	Items  []BurnItem   `json:"items"`
+}
+
+func (fact BurnFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(BurnFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Items:                 fact.items,
+	})
+}
+
+type BurnFactJSONUnmarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender string          `json:"sender"`
+	Items  json.RawMessage `json:"items"`
+}
+
+func (fact *BurnFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of BurnFact")
+
+	var uf BurnFactJSONUnmarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e(err, "")
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	return fact.unmarshal(enc, uf.Sender, uf.Items)
+}
+
+type burnMarshaler struct {
+	currency.BaseOperationJSONMarshaler
+}
+
+func (op Burn) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(burnMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *Burn) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of Burn")
+
+	var ubo currency.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e(err, "")
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
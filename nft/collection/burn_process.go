@@ -0,0 +1,346 @@
+package collection
+
+import (
+	"context"
+	"sync"
+
+	extensioncurrency "github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var burnItemProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(BurnItemProcessor)
+	},
+}
+
+var burnProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(BurnProcessor)
+	},
+}
+
+func (Burn) Process(
+	ctx context.Context, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type BurnItemProcessor struct {
+	h      util.Hash
+	sender base.Address
+	item   BurnItem
+	box    *NFTBox
+}
+
+func (ipp *BurnItemProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) error {
+	nid := ipp.item.NFT()
+
+	st, err := existsState(StateKeyCollectionDesign(nid.Collection()), "key of design", getStateFunc)
+	if err != nil {
+		return errors.Errorf("collection design not found, %q: %w", nid.Collection(), err)
+	}
+
+	design, err := StateCollectionDesignValue(st)
+	if err != nil {
+		return errors.Errorf("collection design value not found, %q: %w", nid.Collection(), err)
+	}
+
+	if !design.Active() {
+		return errors.Errorf("deactivated collection, %q", nid.Collection())
+	}
+
+	st, err = existsState(extensioncurrency.StateKeyContractAccount(design.Parent()), "contract account", getStateFunc)
+	if err != nil {
+		return errors.Errorf("parent not found, %q: %w", design.Parent(), err)
+	}
+
+	ca, err := extensioncurrency.StateContractAccountValue(st)
+	if err != nil {
+		return errors.Errorf("contract account value not found, %q: %w", design.Parent(), err)
+	}
+
+	if !ca.IsActive() {
+		return errors.Errorf("deactivated contract account, %q", design.Parent())
+	}
+
+	st, err = existsState(StateKeyNFT(nid), "key of nft", getStateFunc)
+	if err != nil {
+		return errors.Errorf("nft not found, %q: %w", nid, err)
+	}
+
+	nv, err := StateNFTValue(st)
+	if err != nil {
+		return errors.Errorf("nft value not found, %q: %w", nid, err)
+	}
+
+	if !nv.Active() {
+		return errors.Errorf("burned nft, %q", nid)
+	}
+
+	if err := checkNotFrozen(nid, getStateFunc); err != nil {
+		return errors.Errorf("nft not available, %q: %w", nid, err)
+	}
+
+	if err := checkNotInAuction(nid, getStateFunc); err != nil {
+		return errors.Errorf("nft not available, %q: %w", nid, err)
+	}
+
+	if err := checkNotAttached(nid, getStateFunc); err != nil {
+		return errors.Errorf("nft not available, %q: %w", nid, err)
+	}
+
+	if err := checkNoChildren(nid, getStateFunc); err != nil {
+		return errors.Errorf("nft not available, %q: %w", nid, err)
+	}
+
+	policy, ok := design.Policy().(CollectionPolicy)
+	if !ok {
+		return errors.Errorf("expected CollectionPolicy, not %T", design.Policy())
+	}
+
+	if !policy.Transferable() {
+		if !(nv.Owner().Equal(ipp.sender) || ca.Owner().Equal(ipp.sender)) {
+			return errors.Errorf("only owner or collection parent can burn soulbound nft, %q", ipp.sender)
+		}
+
+		return nil
+	}
+
+	if !(nv.Owner().Equal(ipp.sender) || nv.Approved().Equal(ipp.sender)) {
+		if st, err := existsState(StateKeyAgentBox(nv.Owner(), nv.ID().Collection()), "agents", getStateFunc); err != nil {
+			return errors.Errorf("unauthorized sender, %q: %w", ipp.sender, err)
+		} else if box, err := StateAgentBoxValue(st); err != nil {
+			return errors.Errorf("agent box value not found, %q: %w", ipp.sender, err)
+		} else if !box.Exists(ipp.sender) {
+			return errors.Errorf("unauthorized sender, %q", ipp.sender)
+		}
+	}
+
+	return nil
+}
+
+func (ipp *BurnItemProcessor) Process(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, error) {
+	nid := ipp.item.NFT()
+
+	st, err := existsState(StateKeyNFT(nid), "key of nft", getStateFunc)
+	if err != nil {
+		return nil, errors.Errorf("nft not found, %q: %w", nid, err)
+	}
+
+	nv, err := StateNFTValue(st)
+	if err != nil {
+		return nil, errors.Errorf("nft value not found, %q: %w", nid, err)
+	}
+
+	n := nft.NewNFT(nid, false, nv.Owner(), nv.NFTHash(), nv.URI(), nv.Owner(), nv.Creators(), nv.Copyrighters(), nv.Owner(), 0, nv.Royalty(), nv.RoyaltyReceiver())
+	if err := n.IsValid(nil); err != nil {
+		return nil, errors.Errorf("invalid nft, %q: %w", nid, err)
+	}
+
+	if err := ipp.box.Remove(nid); err != nil {
+		return nil, errors.Errorf("failed to remove nft id from nft box, %q: %w", nid, err)
+	}
+
+	sts := []base.StateMergeValue{NewNFTStateMergeValue(st.Key(), NewNFTStateValue(n))}
+
+	sv, err := cancelListing(nid, getStateFunc)
+	if err != nil {
+		return nil, errors.Errorf("failed to cancel listing, %q: %w", nid, err)
+	}
+	if sv != nil {
+		sts = append(sts, sv)
+	}
+
+	return sts, nil
+}
+
+func (ipp *BurnItemProcessor) Close() error {
+	ipp.h = nil
+	ipp.sender = nil
+	ipp.item = BurnItem{}
+	ipp.box = nil
+
+	burnItemProcessorPool.Put(ipp)
+
+	return nil
+}
+
+type BurnProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewBurnProcessor() extensioncurrency.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringErrorFunc("failed to create new BurnProcessor")
+
+		nopp := burnProcessorPool.Get()
+		opp, ok := nopp.(*BurnProcessor)
+		if !ok {
+			return nil, e(nil, "expected BurnProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e(err, "")
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *BurnProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	e := util.StringErrorFunc("failed to preprocess Burn")
+
+	fact, ok := op.Fact().(BurnFact)
+	if !ok {
+		return ctx, nil, e(nil, "expected BurnFact, not %T", op.Fact())
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, nil, e(err, "")
+	}
+
+	if err := checkExistsState(currency.StateKeyAccount(fact.Sender()), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("sender not found, %q: %w", fact.Sender(), err), nil
+	}
+
+	if err := checkNotExistsState(extensioncurrency.StateKeyContractAccount(fact.Sender()), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("contract account cannot burn nfts, %q", fact.Sender()), nil
+	}
+
+	if err := checkFactSignsByState(fact.sender, op.Signs(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("invalid signing: %w", err), nil
+	}
+
+	for _, item := range fact.Items() {
+		ip := burnItemProcessorPool.Get()
+		ipc, ok := ip.(*BurnItemProcessor)
+		if !ok {
+			return nil, nil, e(nil, "expected BurnItemProcessor, not %T", ip)
+		}
+
+		ipc.h = op.Hash()
+		ipc.sender = fact.Sender()
+		ipc.item = item
+		ipc.box = nil
+
+		if err := ipc.PreProcess(ctx, op, getStateFunc); err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("fail to preprocess BurnItem: %w", err), nil
+		}
+
+		ipc.Close()
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *BurnProcessor) Process( // nolint:dupl
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	e := util.StringErrorFunc("failed to process Burn")
+
+	fact, ok := op.Fact().(BurnFact)
+	if !ok {
+		return nil, nil, e(nil, "expected BurnFact, not %T", op.Fact())
+	}
+
+	boxes := map[extensioncurrency.ContractID]*NFTBox{}
+	for _, item := range fact.Items() {
+		collection := item.NFT().Collection()
+
+		if _, found := boxes[collection]; found {
+			continue
+		}
+
+		st, err := existsState(StateKeyNFTBox(collection), "key of nft box", getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("nft box not found, %q: %w", collection, err), nil
+		}
+
+		box, err := StateNFTBoxValue(st)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("nft box value not found, %q: %w", collection, err), nil
+		}
+
+		boxes[collection] = &box
+	}
+
+	var sts []base.StateMergeValue // nolint:prealloc
+	for _, item := range fact.Items() {
+		ip := burnItemProcessorPool.Get()
+		ipc, ok := ip.(*BurnItemProcessor)
+		if !ok {
+			return nil, nil, e(nil, "expected BurnItemProcessor, not %T", ip)
+		}
+
+		ipc.h = op.Hash()
+		ipc.sender = fact.Sender()
+		ipc.item = item
+		ipc.box = boxes[item.NFT().Collection()]
+
+		s, err := ipc.Process(ctx, op, getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("failed to process BurnItem: %w", err), nil
+		}
+		sts = append(sts, s...)
+
+		ipc.Close()
+	}
+
+	for c, box := range boxes {
+		bv := NewNFTBoxStateMergeValue(StateKeyNFTBox(c), NewNFTBoxStateValue(*box))
+		sts = append(sts, bv)
+	}
+
+	fitems := fact.Items()
+	items := make([]CollectionItem, len(fitems))
+	for i := range fact.Items() {
+		items[i] = fitems[i]
+	}
+
+	required, err := CalculateCollectionItemsFee(getStateFunc, items)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to calculate fee: %w", err), nil
+	}
+	sb, err := currency.CheckEnoughBalance(fact.sender, required, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to check enough balance: %w", err), nil
+	}
+
+	for i := range sb {
+		v, ok := sb[i].Value().(currency.BalanceStateValue)
+		if !ok {
+			return nil, nil, e(nil, "expected BalanceStateValue, not %T", sb[i].Value())
+		}
+		stv := currency.NewBalanceStateValue(v.Amount.WithBig(v.Amount.Big().Sub(required[i][0])))
+		sts = append(sts, currency.NewBalanceStateMergeValue(sb[i].Key(), stv))
+	}
+
+	return sts, nil, nil
+}
+
+func (opp *BurnProcessor) Close() error {
+	burnProcessorPool.Put(opp)
+
+	return nil
+}
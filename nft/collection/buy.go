@@ -0,0 +1,132 @@
+package collection
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var MaxBuyItems = 10
+
+var (
+	BuyFactHint = hint.MustNewHint("mitum-nft-buy-operation-fact-v0.0.1")
+	BuyHint     = hint.MustNewHint("mitum-nft-buy-operation-v0.0.1")
+)
+
+type BuyFact struct {
+	base.BaseFact
+	sender base.Address
+	items  []BuyItem
+}
+
+func NewBuyFact(token []byte, sender base.Address, items []BuyItem) BuyFact {
+	bf := base.NewBaseFact(BuyFactHint, token)
+	fact := BuyFact{
+		BaseFact: bf,
+		sender:   sender,
+		items:    items,
+	}
+
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact BuyFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := currency.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if l := len(fact.items); l < 1 {
+		return util.ErrInvalid.Errorf("empty items for BuyFact")
+	} else if l > int(MaxBuyItems) {
+		return util.ErrInvalid.Errorf("items over allowed, %d > %d", l, MaxBuyItems)
+	}
+
+	if err := fact.sender.IsValid(nil); err != nil {
+		return err
+	}
+
+	founds := map[string]struct{}{}
+	for _, item := range fact.items {
+		if err := item.IsValid(nil); err != nil {
+			return err
+		}
+
+		n := item.NFT()
+		if err := n.IsValid(nil); err != nil {
+			return err
+		}
+
+		if _, found := founds[n.String()]; found {
+			return util.ErrInvalid.Errorf("duplicate nft found, %q", n)
+		}
+
+		founds[n.String()] = struct{}{}
+	}
+
+	return nil
+}
+
+func (fact BuyFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact BuyFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact BuyFact) Bytes() []byte {
+	is := make([][]byte, len(fact.items))
+
+	for i, item := range fact.items {
+		is[i] = item.Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		util.ConcatBytesSlice(is...),
+	)
+}
+
+func (fact BuyFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact BuyFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact BuyFact) Items() []BuyItem {
+	return fact.items
+}
+
+func (fact BuyFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 1)
+	as[0] = fact.sender
+
+	return as, nil
+}
+
+type Buy struct {
+	currency.BaseOperation
+}
+
+func NewBuy(fact BuyFact) (Buy, error) {
+	return Buy{BaseOperation: currency.NewBaseOperation(BuyHint, fact)}, nil
+}
+
+func (op *Buy) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
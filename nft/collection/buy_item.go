@@ -0,0 +1,58 @@
+package collection
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+var BuyItemHint = hint.MustNewHint("mitum-nft-buy-item-v0.0.1")
+
+type BuyItem struct {
+	hint.BaseHinter
+	nft      nft.NFTID
+	price    currency.Amount
+	currency currency.CurrencyID
+}
+
+func NewBuyItem(n nft.NFTID, price currency.Amount, currency currency.CurrencyID) BuyItem {
+	return BuyItem{
+		BaseHinter: hint.NewBaseHinter(BuyItemHint),
+		nft:        n,
+		price:      price,
+		currency:   currency,
+	}
+}
+
+func (it BuyItem) IsValid([]byte) error {
+	if err := util.CheckIsValiders(nil, false, it.BaseHinter, it.nft, it.price, it.currency); err != nil {
+		return err
+	}
+
+	if !it.price.Big().OverZero() {
+		return util.ErrInvalid.Errorf("price must be over zero, %q", it.price.Big())
+	}
+
+	return nil
+}
+
+func (it BuyItem) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		it.nft.Bytes(),
+		it.price.Bytes(),
+		it.currency.Bytes(),
+	)
+}
+
+func (it BuyItem) NFT() nft.NFTID {
+	return it.nft
+}
+
+func (it BuyItem) Price() currency.Amount {
+	return it.price
+}
+
+func (it BuyItem) Currency() currency.CurrencyID {
+	return it.currency
+}
@@ -0,0 +1,40 @@
+package collection
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (it *BuyItem) unmarshal(
+	enc encoder.Encoder,
+	ht hint.Hint,
+	bn []byte,
+	bp []byte,
+	cid string,
+) error {
+	e := util.StringErrorFunc("failed to unmarshal BuyItem")
+
+	it.BaseHinter = hint.NewBaseHinter(ht)
+	it.currency = currency.CurrencyID(cid)
+
+	if hinter, err := enc.Decode(bn); err != nil {
+		return e(err, "")
+	} else if n, ok := hinter.(nft.NFTID); !ok {
+		return e(util.ErrWrongType.Errorf("expected NFTID, not %T", hinter), "")
+	} else {
+		it.nft = n
+	}
+
+	if hinter, err := enc.Decode(bp); err != nil {
+		return e(err, "")
+	} else if am, ok := hinter.(currency.Amount); !ok {
+		return e(util.ErrWrongType.Errorf("expected Amount, not %T", hinter), "")
+	} else {
+		it.price = am
+	}
+
+	return nil
+}
@@ -0,0 +1,328 @@
+package collection
+
+import (
+	"context"
+	"sync"
+
+	extensioncurrency "github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var buyItemProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(BuyItemProcessor)
+	},
+}
+
+var buyProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(BuyProcessor)
+	},
+}
+
+func (Buy) Process(
+	ctx context.Context, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type BuyItemProcessor struct {
+	h        util.Hash
+	sender   base.Address
+	item     BuyItem
+	balances *balanceChanges
+}
+
+func (ipp *BuyItemProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) error {
+	nid := ipp.item.NFT()
+
+	st, err := existsState(StateKeyCollectionDesign(nid.Collection()), "key of design", getStateFunc)
+	if err != nil {
+		return errors.Errorf("collection design not found, %q: %w", nid.Collection(), err)
+	}
+
+	design, err := StateCollectionDesignValue(st)
+	if err != nil {
+		return errors.Errorf("collection design value not found, %q: %w", nid.Collection(), err)
+	}
+
+	if !design.Active() {
+		return errors.Errorf("deactivated collection, %q", nid.Collection())
+	}
+
+	st, err = existsState(extensioncurrency.StateKeyContractAccount(design.Parent()), "contract account", getStateFunc)
+	if err != nil {
+		return errors.Errorf("parent not found, %q: %w", design.Parent(), err)
+	}
+
+	ca, err := extensioncurrency.StateContractAccountValue(st)
+	if err != nil {
+		return errors.Errorf("contract account value not found, %q: %w", design.Parent(), err)
+	}
+
+	if !ca.IsActive() {
+		return errors.Errorf("deactivated contract account, %q", design.Parent())
+	}
+
+	st, err = existsState(StateKeyNFT(nid), "key of nft", getStateFunc)
+	if err != nil {
+		return errors.Errorf("nft not found, %q: %w", nid, err)
+	}
+
+	nv, err := StateNFTValue(st)
+	if err != nil {
+		return errors.Errorf("nft value not found, %q: %w", nid, err)
+	}
+
+	if !nv.Active() {
+		return errors.Errorf("burned nft, %q", nid)
+	}
+
+	if err := checkNotFrozen(nid, getStateFunc); err != nil {
+		return errors.Errorf("nft not available, %q: %w", nid, err)
+	}
+
+	if err := checkNotInAuction(nid, getStateFunc); err != nil {
+		return errors.Errorf("nft not available, %q: %w", nid, err)
+	}
+
+	if err := checkSigned(nv, getStateFunc); err != nil {
+		return errors.Errorf("nft not transferable, %q: %w", nid, err)
+	}
+
+	if err := checkChildrenMovable(nid, getStateFunc); err != nil {
+		return errors.Errorf("nft not transferable, %q: %w", nid, err)
+	}
+
+	if nv.Owner().Equal(ipp.sender) {
+		return errors.Errorf("sender already owns nft, %q", nid)
+	}
+
+	st, err = existsState(StateKeyListing(nid), "key of listing", getStateFunc)
+	if err != nil {
+		return errors.Errorf("listing not found, %q: %w", nid, err)
+	}
+
+	l, err := StateListingValue(st)
+	if err != nil {
+		return errors.Errorf("listing value not found, %q: %w", nid, err)
+	}
+
+	if !l.Active() {
+		return errors.Errorf("nft not listed, %q", nid)
+	}
+
+	if !l.Seller().Equal(nv.Owner()) {
+		return errors.Errorf("listing seller is not nft owner, %q", nid)
+	}
+
+	if !l.Price().Equal(ipp.item.Price()) {
+		return errors.Errorf("price not matched with listing, %q; %v != %v", nid, ipp.item.Price(), l.Price())
+	}
+
+	return nil
+}
+
+func (ipp *BuyItemProcessor) Process(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, error) {
+	nid := ipp.item.NFT()
+
+	st, err := existsState(StateKeyNFT(nid), "key of nft", getStateFunc)
+	if err != nil {
+		return nil, errors.Errorf("nft not found, %q: %w", nid, err)
+	}
+
+	nv, err := StateNFTValue(st)
+	if err != nil {
+		return nil, errors.Errorf("nft value not found, %q: %w", nid, err)
+	}
+
+	if err := ipp.balances.sub(ipp.sender, ipp.item.Price()); err != nil {
+		return nil, errors.Errorf("failed to pay price, %q: %w", nid, err)
+	}
+
+	if err := payNFTPrice(ipp.balances, nv, nv.Owner(), ipp.item.Price(), getStateFunc); err != nil {
+		return nil, errors.Errorf("failed to settle price, %q: %w", nid, err)
+	}
+
+	n := nft.NewNFT(nid, nv.Active(), ipp.sender, nv.NFTHash(), nv.URI(), ipp.sender, nv.Creators(), nv.Copyrighters(), ipp.sender, 0, nv.Royalty(), nv.RoyaltyReceiver())
+	if err := n.IsValid(nil); err != nil {
+		return nil, errors.Errorf("invalid nft, %q: %w", nid, err)
+	}
+
+	sts := []base.StateMergeValue{NewNFTStateMergeValue(st.Key(), NewNFTStateValue(n))}
+
+	sv, err := cancelListing(nid, getStateFunc)
+	if err != nil {
+		return nil, errors.Errorf("failed to cancel listing, %q: %w", nid, err)
+	}
+	if sv != nil {
+		sts = append(sts, sv)
+	}
+
+	s, err := moveChildren(nid, ipp.sender, getStateFunc)
+	if err != nil {
+		return nil, errors.Errorf("failed to move children, %q: %w", nid, err)
+	}
+	sts = append(sts, s...)
+
+	return sts, nil
+}
+
+func (ipp *BuyItemProcessor) Close() error {
+	ipp.h = nil
+	ipp.sender = nil
+	ipp.item = BuyItem{}
+	ipp.balances = nil
+
+	buyItemProcessorPool.Put(ipp)
+
+	return nil
+}
+
+type BuyProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewBuyProcessor() extensioncurrency.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringErrorFunc("failed to create new BuyProcessor")
+
+		nopp := buyProcessorPool.Get()
+		opp, ok := nopp.(*BuyProcessor)
+		if !ok {
+			return nil, e(nil, "expected BuyProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e(err, "")
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *BuyProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	e := util.StringErrorFunc("failed to preprocess Buy")
+
+	fact, ok := op.Fact().(BuyFact)
+	if !ok {
+		return ctx, nil, e(nil, "expected BuyFact, not %T", op.Fact())
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, nil, e(err, "")
+	}
+
+	if err := checkExistsState(currency.StateKeyAccount(fact.Sender()), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("sender not found, %q: %w", fact.Sender(), err), nil
+	}
+
+	if err := checkNotExistsState(extensioncurrency.StateKeyContractAccount(fact.Sender()), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("contract account cannot buy nfts, %q", fact.Sender()), nil
+	}
+
+	if err := checkFactSignsByState(fact.sender, op.Signs(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("invalid signing: %w", err), nil
+	}
+
+	for _, item := range fact.Items() {
+		ip := buyItemProcessorPool.Get()
+		ipc, ok := ip.(*BuyItemProcessor)
+		if !ok {
+			return nil, nil, e(nil, "expected BuyItemProcessor, not %T", ip)
+		}
+
+		ipc.h = op.Hash()
+		ipc.sender = fact.Sender()
+		ipc.item = item
+		ipc.balances = nil
+
+		if err := ipc.PreProcess(ctx, op, getStateFunc); err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("fail to preprocess BuyItem: %w", err), nil
+		}
+
+		ipc.Close()
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *BuyProcessor) Process( // nolint:dupl
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	e := util.StringErrorFunc("failed to process Buy")
+
+	fact, ok := op.Fact().(BuyFact)
+	if !ok {
+		return nil, nil, e(nil, "expected BuyFact, not %T", op.Fact())
+	}
+
+	balances := newBalanceChanges(getStateFunc)
+
+	var sts []base.StateMergeValue // nolint:prealloc
+	for _, item := range fact.Items() {
+		ip := buyItemProcessorPool.Get()
+		ipc, ok := ip.(*BuyItemProcessor)
+		if !ok {
+			return nil, nil, e(nil, "expected BuyItemProcessor, not %T", ip)
+		}
+
+		ipc.h = op.Hash()
+		ipc.sender = fact.Sender()
+		ipc.item = item
+		ipc.balances = balances
+
+		s, err := ipc.Process(ctx, op, getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("failed to process BuyItem: %w", err), nil
+		}
+		sts = append(sts, s...)
+
+		ipc.Close()
+	}
+
+	fitems := fact.Items()
+	items := make([]CollectionItem, len(fitems))
+	for i := range fact.Items() {
+		items[i] = fitems[i]
+	}
+
+	required, err := CalculateCollectionItemsFee(getStateFunc, items)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to calculate fee: %w", err), nil
+	}
+
+	if err := balances.subFee(fact.Sender(), required); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to check enough balance: %w", err), nil
+	}
+
+	sts = append(sts, balances.stateMergeValues()...)
+
+	return sts, nil, nil
+}
+
+func (opp *BuyProcessor) Close() error {
+	buyProcessorPool.Put(opp)
+
+	return nil
+}
@@ -0,0 +1,132 @@
+package collection
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var MaxCancelOfferItems = 10
+
+var (
+	CancelOfferFactHint = hint.MustNewHint("mitum-nft-cancel-offer-operation-fact-v0.0.1")
+	CancelOfferHint     = hint.MustNewHint("mitum-nft-cancel-offer-operation-v0.0.1")
+)
+
+type CancelOfferFact struct {
+	base.BaseFact
+	sender base.Address
+	items  []CancelOfferItem
+}
+
+func NewCancelOfferFact(token []byte, sender base.Address, items []CancelOfferItem) CancelOfferFact {
+	bf := base.NewBaseFact(CancelOfferFactHint, token)
+	fact := CancelOfferFact{
+		BaseFact: bf,
+		sender:   sender,
+		items:    items,
+	}
+
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact CancelOfferFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := currency.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if l := len(fact.items); l < 1 {
+		return util.ErrInvalid.Errorf("empty items for CancelOfferFact")
+	} else if l > int(MaxCancelOfferItems) {
+		return util.ErrInvalid.Errorf("items over allowed, %d > %d", l, MaxCancelOfferItems)
+	}
+
+	if err := fact.sender.IsValid(nil); err != nil {
+		return err
+	}
+
+	founds := map[string]struct{}{}
+	for _, item := range fact.items {
+		if err := item.IsValid(nil); err != nil {
+			return err
+		}
+
+		n := item.NFT()
+		if err := n.IsValid(nil); err != nil {
+			return err
+		}
+
+		if _, found := founds[n.String()]; found {
+			return util.ErrInvalid.Errorf("duplicate nft found, %q", n)
+		}
+
+		founds[n.String()] = struct{}{}
+	}
+
+	return nil
+}
+
+func (fact CancelOfferFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact CancelOfferFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact CancelOfferFact) Bytes() []byte {
+	is := make([][]byte, len(fact.items))
+
+	for i, item := range fact.items {
+		is[i] = item.Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		util.ConcatBytesSlice(is...),
+	)
+}
+
+func (fact CancelOfferFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact CancelOfferFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact CancelOfferFact) Items() []CancelOfferItem {
+	return fact.items
+}
+
+func (fact CancelOfferFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 1)
+	as[0] = fact.sender
+
+	return as, nil
+}
+
+type CancelOffer struct {
+	currency.BaseOperation
+}
+
+func NewCancelOffer(fact CancelOfferFact) (CancelOffer, error) {
+	return CancelOffer{BaseOperation: currency.NewBaseOperation(CancelOfferHint, fact)}, nil
+}
+
+func (op *CancelOffer) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package collection
+
+import (
+	"github.com/ProtoconNet/mitum-nft/nft"
+
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+var CancelOfferItemHint = hint.MustNewHint("mitum-nft-cancel-offer-item-v0.0.1")
+
+type CancelOfferItem struct {
+	hint.BaseHinter
+	nft      nft.NFTID
+	currency currency.CurrencyID
+}
+
+func NewCancelOfferItem(n nft.NFTID, currency currency.CurrencyID) CancelOfferItem {
+	return CancelOfferItem{
+		BaseHinter: hint.NewBaseHinter(CancelOfferItemHint),
+		nft:        n,
+		currency:   currency,
+	}
+}
+
+func (it CancelOfferItem) IsValid([]byte) error {
+	return util.CheckIsValiders(nil, false,
+		it.BaseHinter,
+		it.nft,
+		it.currency,
+	)
+}
+
+func (it CancelOfferItem) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		it.nft.Bytes(),
+		it.currency.Bytes(),
+	)
+}
+
+func (it CancelOfferItem) NFT() nft.NFTID {
+	return it.nft
+}
+
+func (it CancelOfferItem) Currency() currency.CurrencyID {
+	return it.currency
+}
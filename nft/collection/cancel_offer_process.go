@@ -0,0 +1,230 @@
+package collection
+
+import (
+	"context"
+	"sync"
+
+	extensioncurrency "github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var cancelOfferItemProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(CancelOfferItemProcessor)
+	},
+}
+
+var cancelOfferProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(CancelOfferProcessor)
+	},
+}
+
+func (CancelOffer) Process(
+	ctx context.Context, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type CancelOfferItemProcessor struct {
+	h        util.Hash
+	sender   base.Address
+	item     CancelOfferItem
+	balances *balanceChanges
+}
+
+func (ipp *CancelOfferItemProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) error {
+	nid := ipp.item.NFT()
+
+	st, err := existsState(StateKeyOffer(nid, ipp.sender), "key of offer", getStateFunc)
+	if err != nil {
+		return errors.Errorf("offer not found, %q: %w", nid, err)
+	}
+
+	o, err := StateOfferValue(st)
+	if err != nil {
+		return errors.Errorf("offer value not found, %q: %w", nid, err)
+	}
+
+	if !o.Active() {
+		return errors.Errorf("offer already closed, %q", nid)
+	}
+
+	return nil
+}
+
+func (ipp *CancelOfferItemProcessor) Process(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, error) {
+	nid := ipp.item.NFT()
+
+	ost, err := existsState(StateKeyOffer(nid, ipp.sender), "key of offer", getStateFunc)
+	if err != nil {
+		return nil, errors.Errorf("offer not found, %q: %w", nid, err)
+	}
+
+	o, err := StateOfferValue(ost)
+	if err != nil {
+		return nil, errors.Errorf("offer value not found, %q: %w", nid, err)
+	}
+
+	if err := ipp.balances.add(o.Offerer(), o.Amount()); err != nil {
+		return nil, errors.Errorf("failed to unlock offer amount, %q: %w", nid, err)
+	}
+
+	no := NewOffer(nid, false, o.Offerer(), o.Amount())
+
+	return []base.StateMergeValue{NewOfferStateMergeValue(ost.Key(), NewOfferStateValue(no))}, nil
+}
+
+func (ipp *CancelOfferItemProcessor) Close() error {
+	ipp.h = nil
+	ipp.sender = nil
+	ipp.item = CancelOfferItem{}
+	ipp.balances = nil
+
+	cancelOfferItemProcessorPool.Put(ipp)
+
+	return nil
+}
+
+type CancelOfferProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewCancelOfferProcessor() extensioncurrency.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringErrorFunc("failed to create new CancelOfferProcessor")
+
+		nopp := cancelOfferProcessorPool.Get()
+		opp, ok := nopp.(*CancelOfferProcessor)
+		if !ok {
+			return nil, e(nil, "expected CancelOfferProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e(err, "")
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *CancelOfferProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	e := util.StringErrorFunc("failed to preprocess CancelOffer")
+
+	fact, ok := op.Fact().(CancelOfferFact)
+	if !ok {
+		return ctx, nil, e(nil, "expected CancelOfferFact, not %T", op.Fact())
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, nil, e(err, "")
+	}
+
+	if err := checkExistsState(currency.StateKeyAccount(fact.Sender()), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("sender not found, %q: %w", fact.Sender(), err), nil
+	}
+
+	if err := checkFactSignsByState(fact.sender, op.Signs(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("invalid signing: %w", err), nil
+	}
+
+	for _, item := range fact.Items() {
+		ip := cancelOfferItemProcessorPool.Get()
+		ipc, ok := ip.(*CancelOfferItemProcessor)
+		if !ok {
+			return nil, nil, e(nil, "expected CancelOfferItemProcessor, not %T", ip)
+		}
+
+		ipc.h = op.Hash()
+		ipc.sender = fact.Sender()
+		ipc.item = item
+		ipc.balances = nil
+
+		if err := ipc.PreProcess(ctx, op, getStateFunc); err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("fail to preprocess CancelOfferItem: %w", err), nil
+		}
+
+		ipc.Close()
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *CancelOfferProcessor) Process( // nolint:dupl
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	e := util.StringErrorFunc("failed to process CancelOffer")
+
+	fact, ok := op.Fact().(CancelOfferFact)
+	if !ok {
+		return nil, nil, e(nil, "expected CancelOfferFact, not %T", op.Fact())
+	}
+
+	balances := newBalanceChanges(getStateFunc)
+
+	var sts []base.StateMergeValue // nolint:prealloc
+	for _, item := range fact.Items() {
+		ip := cancelOfferItemProcessorPool.Get()
+		ipc, ok := ip.(*CancelOfferItemProcessor)
+		if !ok {
+			return nil, nil, e(nil, "expected CancelOfferItemProcessor, not %T", ip)
+		}
+
+		ipc.h = op.Hash()
+		ipc.sender = fact.Sender()
+		ipc.item = item
+		ipc.balances = balances
+
+		s, err := ipc.Process(ctx, op, getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("failed to process CancelOfferItem: %w", err), nil
+		}
+		sts = append(sts, s...)
+
+		ipc.Close()
+	}
+
+	fitems := fact.Items()
+	items := make([]CollectionItem, len(fitems))
+	for i := range fact.Items() {
+		items[i] = fitems[i]
+	}
+
+	required, err := CalculateCollectionItemsFee(getStateFunc, items)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to calculate fee: %w", err), nil
+	}
+
+	if err := balances.subFee(fact.Sender(), required); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to check enough balance: %w", err), nil
+	}
+
+	sts = append(sts, balances.stateMergeValues()...)
+
+	return sts, nil, nil
+}
+
+func (opp *CancelOfferProcessor) Close() error {
+	cancelOfferProcessorPool.Put(opp)
+
+	return nil
+}
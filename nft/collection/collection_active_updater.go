@@ -0,0 +1,132 @@
+package collection
+
+import (
+	extensioncurrency "github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	CollectionActiveUpdaterFactHint = hint.MustNewHint("mitum-nft-collection-active-updater-operation-fact-v0.0.1")
+	CollectionActiveUpdaterHint     = hint.MustNewHint("mitum-nft-collection-active-updater-operation-v0.0.1")
+)
+
+type CollectionActiveUpdaterFact struct {
+	base.BaseFact
+	sender     base.Address
+	collection extensioncurrency.ContractID
+	active     bool
+	currency   currency.CurrencyID
+}
+
+func NewCollectionActiveUpdaterFact(
+	token []byte, sender base.Address,
+	collection extensioncurrency.ContractID,
+	active bool,
+	currency currency.CurrencyID,
+) CollectionActiveUpdaterFact {
+	bf := base.NewBaseFact(CollectionActiveUpdaterFactHint, token)
+
+	fact := CollectionActiveUpdaterFact{
+		BaseFact:   bf,
+		sender:     sender,
+		collection: collection,
+		active:     active,
+		currency:   currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact CollectionActiveUpdaterFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := currency.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if err := util.CheckIsValiders(
+		nil, false,
+		fact.sender,
+		fact.collection,
+		fact.currency,
+	); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (fact CollectionActiveUpdaterFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact CollectionActiveUpdaterFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact CollectionActiveUpdaterFact) Bytes() []byte {
+	ba := make([]byte, 1)
+
+	if fact.active {
+		ba[0] = 1
+	} else {
+		ba[0] = 0
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.collection.Bytes(),
+		ba,
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact CollectionActiveUpdaterFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact CollectionActiveUpdaterFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact CollectionActiveUpdaterFact) Collection() extensioncurrency.ContractID {
+	return fact.collection
+}
+
+func (fact CollectionActiveUpdaterFact) Active() bool {
+	return fact.active
+}
+
+func (fact CollectionActiveUpdaterFact) Currency() currency.CurrencyID {
+	return fact.currency
+}
+
+func (fact CollectionActiveUpdaterFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 1)
+	as[0] = fact.sender
+	return as, nil
+}
+
+type CollectionActiveUpdater struct {
+	currency.BaseOperation
+}
+
+func NewCollectionActiveUpdater(fact CollectionActiveUpdaterFact) (CollectionActiveUpdater, error) {
+	return CollectionActiveUpdater{BaseOperation: currency.NewBaseOperation(CollectionActiveUpdaterHint, fact)}, nil
+}
+
+func (op *CollectionActiveUpdater) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,31 @@
+package collection
+
+import (
+	extensioncurrency "github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+func (fact *CollectionActiveUpdaterFact) unmarshal(
+	enc encoder.Encoder,
+	sd string,
+	col string,
+	ac bool,
+	cid string,
+) error {
+	e := util.StringErrorFunc("failed to unmarshal CollectionActiveUpdaterFact")
+
+	fact.collection = extensioncurrency.ContractID(col)
+	fact.active = ac
+	fact.currency = currency.CurrencyID(cid)
+
+	sender, err := base.DecodeAddress(sd, enc)
+	if err != nil {
+		return e(err, "")
+	}
+	fact.sender = sender
+
+	return nil
+}
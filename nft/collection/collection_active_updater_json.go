@@ -0,0 +1,71 @@
+package collection
+
+import (
+	extensioncurrency "github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+)
+
+type CollectionActiveUpdaterFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender     base.Address                 `json:"sender"`
+	Collection extensioncurrency.ContractID `json:"collection"`
+	Active     bool                         `json:"active"`
+	Currency   currency.CurrencyID          `json:"currency"`
+}
+
+func (fact CollectionActiveUpdaterFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(CollectionActiveUpdaterFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Collection:            fact.collection,
+		Active:                fact.active,
+		Currency:              fact.currency,
+	})
+}
+
+type CollectionActiveUpdaterFactJSONUnmarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender     string `json:"sender"`
+	Collection string `json:"collection"`
+	Active     bool   `json:"active"`
+	Currency   string `json:"currency"`
+}
+
+func (fact *CollectionActiveUpdaterFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of CollectionActiveUpdaterFact")
+
+	var u CollectionActiveUpdaterFactJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(u.BaseFactJSONUnmarshaler)
+
+	return fact.unmarshal(enc, u.Sender, u.Collection, u.Active, u.Currency)
+}
+
+type collectionActiveUpdaterMarshaler struct {
+	currency.BaseOperationJSONMarshaler
+}
+
+func (op CollectionActiveUpdater) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(collectionActiveUpdaterMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *CollectionActiveUpdater) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of CollectionActiveUpdater")
+
+	var ubo currency.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e(err, "")
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,204 @@
+package collection
+
+import (
+	"context"
+	"sync"
+
+	extensioncurrency "github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var collectionActiveUpdaterProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(CollectionActiveUpdaterProcessor)
+	},
+}
+
+func (CollectionActiveUpdater) Process(
+	ctx context.Context, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type CollectionActiveUpdaterProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewCollectionActiveUpdaterProcessor() extensioncurrency.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringErrorFunc("failed to create new CollectionActiveUpdaterProcessor")
+
+		nopp := collectionActiveUpdaterProcessorPool.Get()
+		opp, ok := nopp.(*CollectionActiveUpdaterProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected CollectionActiveUpdaterProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e(err, "")
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *CollectionActiveUpdaterProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	e := util.StringErrorFunc("failed to preprocess CollectionActiveUpdater")
+
+	fact, ok := op.Fact().(CollectionActiveUpdaterFact)
+	if !ok {
+		return ctx, nil, e(nil, "not CollectionActiveUpdaterFact, %T", op.Fact())
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, nil, e(err, "")
+	}
+
+	if err := checkExistsState(currency.StateKeyAccount(fact.Sender()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("sender not found, %q: %w", fact.Sender(), err), nil
+	}
+
+	if err := checkNotExistsState(extensioncurrency.StateKeyContractAccount(fact.Sender()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("contract account cannot update collection status, %q: %w", fact.Sender(), err), nil
+	}
+
+	if err := checkFactSignsByState(fact.Sender(), op.Signs(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("invalid signing: %w", err), nil
+	}
+
+	st, err := existsState(StateKeyCollectionDesign(fact.Collection()), "key of design", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("collection design not found, %q: %w", fact.Collection(), err), nil
+	}
+
+	design, err := StateCollectionDesignValue(st)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("collection design value not found, %q: %w", fact.Collection(), err), nil
+	}
+
+	if design.Active() == fact.Active() {
+		if design.Active() {
+			return nil, base.NewBaseOperationProcessReasonError("collection already activated, %q", fact.Collection()), nil
+		}
+		return nil, base.NewBaseOperationProcessReasonError("collection already deactivated, %q", fact.Collection()), nil
+	}
+
+	st, err = existsState(extensioncurrency.StateKeyContractAccount(design.Parent()), "key of contract account", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("parent not found, %q: %w", design.Parent(), err), nil
+	}
+
+	ca, err := extensioncurrency.StateContractAccountValue(st)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("contract account value not found, %q: %w", design.Parent(), err), nil
+	}
+
+	if !ca.Owner().Equal(fact.Sender()) {
+		return nil, base.NewBaseOperationProcessReasonError("sender is not owner of contract account, %q, %q", fact.Sender(), ca.Owner()), nil
+	}
+
+	if fact.Active() && !ca.IsActive() {
+		return nil, base.NewBaseOperationProcessReasonError("deactivated contract account, %q", design.Parent()), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *CollectionActiveUpdaterProcessor) Process(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	e := util.StringErrorFunc("failed to process CollectionActiveUpdater")
+
+	fact, ok := op.Fact().(CollectionActiveUpdaterFact)
+	if !ok {
+		return nil, nil, e(nil, "expected CollectionActiveUpdaterFact, not %T", op.Fact())
+	}
+
+	st, err := existsState(StateKeyCollectionDesign(fact.Collection()), "key of design", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("collection design not found, %q: %w", fact.Collection(), err), nil
+	}
+
+	design, err := StateCollectionDesignValue(st)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("collection design value not found, %q: %w", fact.Collection(), err), nil
+	}
+
+	policy, ok := design.Policy().(CollectionPolicy)
+	if !ok {
+		return nil, base.NewBaseOperationProcessReasonError("expected CollectionPolicy, not %T", design.Policy()), nil
+	}
+
+	sts := make([]base.StateMergeValue, 3)
+
+	de := NewCollectionDesign(design.Parent(), design.Creator(), design.Symbol(), fact.Active(), policy)
+	sts[0] = NewCollectionDesignStateMergeValue(StateKeyCollectionDesign(design.Symbol()), NewCollectionDesignStateValue(de))
+
+	status := NewCollectionStatus(design.Symbol(), fact.Active(), fact.Sender(), opp.Height())
+	if err := status.IsValid(nil); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("invalid collection status, %q: %w", fact.Collection(), err), nil
+	}
+	sts[1] = NewCollectionStatusStateMergeValue(StateKeyCollectionStatus(design.Symbol()), NewCollectionStatusStateValue(status))
+
+	switch sv, err := indexCollection(de, getStateFunc); {
+	case err != nil:
+		return nil, base.NewBaseOperationProcessReasonError("failed to index collection, %q: %w", design.Parent(), err), nil
+	case sv != nil:
+		sts = append(sts, sv)
+	}
+
+	currencyPolicy, err := existsCurrencyPolicy(fact.Currency(), getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("currency not found, %q: %w", fact.Currency(), err), nil
+	}
+
+	fee, err := currencyPolicy.Feeer().Fee(currency.ZeroBig)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to check fee of currency, %q: %w", fact.Currency(), err), nil
+	}
+
+	st, err = existsState(currency.StateKeyBalance(fact.Sender(), fact.Currency()), "key of sender balance", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("sender balance not found, %q: %w", fact.Sender(), err), nil
+	}
+	sb := currency.NewBalanceStateMergeValue(st.Key(), st.Value())
+
+	switch b, err := currency.StateBalanceValue(st); {
+	case err != nil:
+		return nil, base.NewBaseOperationProcessReasonError("failed to get balance value, %q: %w", currency.StateKeyBalance(fact.Sender(), fact.Currency()), err), nil
+	case b.Big().Compare(fee) < 0:
+		return nil, base.NewBaseOperationProcessReasonError("not enough balance of sender, %q", fact.Sender()), nil
+	}
+
+	v, ok := sb.Value().(currency.BalanceStateValue)
+	if !ok {
+		return nil, base.NewBaseOperationProcessReasonError("expected BalanceStateValue, not %T", sb.Value()), nil
+	}
+	sts[2] = currency.NewBalanceStateMergeValue(
+		sb.Key(),
+		currency.NewBalanceStateValue(v.Amount.WithBig(v.Amount.Big().Sub(fee))),
+	)
+
+	return sts, nil, nil
+}
+
+func (opp *CollectionActiveUpdaterProcessor) Close() error {
+	collectionActiveUpdaterProcessorPool.Put(opp)
+
+	return nil
+}
@@ -0,0 +1,122 @@
+package collection
+
+import (
+	extensioncurrency "github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+	"github.com/pkg/errors"
+)
+
+var CollectionBoxHint = hint.MustNewHint("mitum-nft-collection-box-v0.0.1")
+
+type CollectionBox struct {
+	hint.BaseHinter
+	parent      base.Address
+	collections []extensioncurrency.ContractID
+}
+
+func NewCollectionBox(parent base.Address, collections []extensioncurrency.ContractID) CollectionBox {
+	if collections == nil {
+		return CollectionBox{BaseHinter: hint.NewBaseHinter(CollectionBoxHint), parent: parent, collections: []extensioncurrency.ContractID{}}
+	}
+	return CollectionBox{BaseHinter: hint.NewBaseHinter(CollectionBoxHint), parent: parent, collections: collections}
+}
+
+func (cb CollectionBox) IsValid([]byte) error {
+	if err := util.CheckIsValiders(nil, false, cb.BaseHinter, cb.parent); err != nil {
+		return err
+	}
+
+	founds := map[extensioncurrency.ContractID]struct{}{}
+	for i := range cb.collections {
+		if err := cb.collections[i].IsValid(nil); err != nil {
+			return err
+		}
+
+		if _, found := founds[cb.collections[i]]; found {
+			return errors.Errorf("duplicate collection found, %q", cb.collections[i])
+		}
+		founds[cb.collections[i]] = struct{}{}
+	}
+
+	return nil
+}
+
+func (cb CollectionBox) Bytes() []byte {
+	bs := make([][]byte, len(cb.collections)+1)
+	bs[0] = cb.parent.Bytes()
+
+	for i, collection := range cb.collections {
+		bs[i+1] = collection.Bytes()
+	}
+
+	return util.ConcatBytesSlice(bs...)
+}
+
+func (cb CollectionBox) Hash() util.Hash {
+	return cb.GenerateHash()
+}
+
+func (cb CollectionBox) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(cb.Bytes())
+}
+
+func (cb CollectionBox) IsEmpty() bool {
+	return len(cb.collections) < 1
+}
+
+func (cb CollectionBox) Parent() base.Address {
+	return cb.parent
+}
+
+func (cb CollectionBox) Exists(id extensioncurrency.ContractID) bool {
+	for _, collection := range cb.collections {
+		if id == collection {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (cb *CollectionBox) Append(id extensioncurrency.ContractID) error {
+	if err := id.IsValid(nil); err != nil {
+		return err
+	}
+
+	if cb.Exists(id) {
+		return errors.Errorf("collection already in collection box, %q", id)
+	}
+
+	cb.collections = append(cb.collections, id)
+
+	return nil
+}
+
+func (cb *CollectionBox) Remove(id extensioncurrency.ContractID) error {
+	if err := id.IsValid(nil); err != nil {
+		return err
+	}
+
+	if !cb.Exists(id) {
+		return errors.Errorf("collection not in collection box, %q", id)
+	}
+
+	for i := range cb.collections {
+		if id == cb.collections[i] {
+			cb.collections[i] = cb.collections[len(cb.collections)-1]
+			cb.collections[len(cb.collections)-1] = extensioncurrency.ContractID("")
+			cb.collections = cb.collections[:len(cb.collections)-1]
+
+			return nil
+		}
+	}
+
+	return nil
+}
+
+func (cb CollectionBox) Collections() []extensioncurrency.ContractID {
+	return cb.collections
+}
@@ -0,0 +1,38 @@
+package collection
+
+import (
+	bsonenc "github.com/ProtoconNet/mitum-currency/v2/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (cb CollectionBox) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(bson.M{
+		"_hint":       cb.Hint().String(),
+		"parent":      cb.parent,
+		"collections": cb.collections,
+	})
+}
+
+type CollectionBoxBSONUnmarshaler struct {
+	Hint        string   `bson:"_hint"`
+	Parent      string   `bson:"parent"`
+	Collections []string `bson:"collections"`
+}
+
+func (cb *CollectionBox) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode bson of CollectionBox")
+
+	var u CollectionBoxBSONUnmarshaler
+	if err := bsonenc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e(err, "")
+	}
+
+	return cb.unmarshal(enc, ht, u.Parent, u.Collections)
+}
@@ -0,0 +1,34 @@
+package collection
+
+import (
+	extensioncurrency "github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (cb *CollectionBox) unmarshal(
+	enc encoder.Encoder,
+	ht hint.Hint,
+	pa string,
+	bcs []string,
+) error {
+	e := util.StringErrorFunc("failed to unmarshal CollectionBox")
+
+	cb.BaseHinter = hint.NewBaseHinter(ht)
+
+	parent, err := base.DecodeAddress(pa, enc)
+	if err != nil {
+		return e(err, "")
+	}
+	cb.parent = parent
+
+	collections := make([]extensioncurrency.ContractID, len(bcs))
+	for i, bc := range bcs {
+		collections[i] = extensioncurrency.ContractID(bc)
+	}
+	cb.collections = collections
+
+	return nil
+}
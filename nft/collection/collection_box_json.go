@@ -0,0 +1,40 @@
+package collection
+
+import (
+	extensioncurrency "github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+type CollectionBoxJSONMarshaler struct {
+	hint.BaseHinter
+	Parent      base.Address                   `json:"parent"`
+	Collections []extensioncurrency.ContractID `json:"collections"`
+}
+
+func (cb CollectionBox) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(CollectionBoxJSONMarshaler{
+		BaseHinter:  cb.BaseHinter,
+		Parent:      cb.parent,
+		Collections: cb.collections,
+	})
+}
+
+type CollectionBoxJSONUnmarshaler struct {
+	Hint        hint.Hint `json:"_hint"`
+	Parent      string    `json:"parent"`
+	Collections []string  `json:"collections"`
+}
+
+func (cb *CollectionBox) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of CollectionBox")
+
+	var u CollectionBoxJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	return cb.unmarshal(enc, u.Hint, u.Parent, u.Collections)
+}
@@ -0,0 +1,65 @@
+package collection
+
+import (
+	"testing"
+
+	extensioncurrency "github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+)
+
+func TestCollectionBoxIsValid(t *testing.T) {
+	parent := currency.NewAddress("contract")
+
+	cases := []struct {
+		name        string
+		collections []extensioncurrency.ContractID
+		err         bool
+	}{
+		{name: "empty", collections: nil},
+		{name: "collections", collections: []extensioncurrency.ContractID{"ABC", "DEF"}},
+		{name: "duplicate", collections: []extensioncurrency.ContractID{"ABC", "DEF", "ABC"}, err: true},
+		{name: "invalid id", collections: []extensioncurrency.ContractID{"abc"}, err: true},
+		{name: "too short id", collections: []extensioncurrency.ContractID{"A"}, err: true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := NewCollectionBox(parent, c.collections).IsValid(nil)
+			if c.err != (err != nil) {
+				t.Fatalf("error = %v, expected error %v", err, c.err)
+			}
+		})
+	}
+}
+
+func TestCollectionBoxAppendRemove(t *testing.T) {
+	box := NewCollectionBox(currency.NewAddress("contract"), nil)
+
+	if err := box.Append("ABC"); err != nil {
+		t.Fatalf("failed to append: %v", err)
+	}
+
+	if err := box.Append("DEF"); err != nil {
+		t.Fatalf("failed to append: %v", err)
+	}
+
+	if err := box.Append("ABC"); err == nil {
+		t.Fatal("expected error for appending existing collection")
+	}
+
+	if err := box.Remove("ABC"); err != nil {
+		t.Fatalf("failed to remove: %v", err)
+	}
+
+	if err := box.Remove("ABC"); err == nil {
+		t.Fatal("expected error for removing unknown collection")
+	}
+
+	if box.Exists("ABC") || !box.Exists("DEF") || len(box.Collections()) != 1 {
+		t.Fatalf("unexpected collections, %v", box.Collections())
+	}
+
+	if err := box.IsValid(nil); err != nil {
+		t.Fatalf("invalid collection box: %v", err)
+	}
+}
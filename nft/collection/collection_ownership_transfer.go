@@ -0,0 +1,136 @@
+package collection
+
+import (
+	extensioncurrency "github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	CollectionOwnershipTransferFactHint = hint.MustNewHint("mitum-nft-collection-ownership-transfer-operation-fact-v0.0.1")
+	CollectionOwnershipTransferHint     = hint.MustNewHint("mitum-nft-collection-ownership-transfer-operation-v0.0.1")
+)
+
+type CollectionOwnershipTransferFact struct {
+	base.BaseFact
+	sender     base.Address
+	collection extensioncurrency.ContractID
+	parent     base.Address
+	creator    base.Address
+	currency   currency.CurrencyID
+}
+
+func NewCollectionOwnershipTransferFact(
+	token []byte, sender base.Address,
+	collection extensioncurrency.ContractID,
+	parent base.Address,
+	creator base.Address,
+	currency currency.CurrencyID,
+) CollectionOwnershipTransferFact {
+	bf := base.NewBaseFact(CollectionOwnershipTransferFactHint, token)
+
+	fact := CollectionOwnershipTransferFact{
+		BaseFact:   bf,
+		sender:     sender,
+		collection: collection,
+		parent:     parent,
+		creator:    creator,
+		currency:   currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact CollectionOwnershipTransferFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := currency.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if err := util.CheckIsValiders(
+		nil, false,
+		fact.sender,
+		fact.collection,
+		fact.parent,
+		fact.creator,
+		fact.currency,
+	); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (fact CollectionOwnershipTransferFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact CollectionOwnershipTransferFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact CollectionOwnershipTransferFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.collection.Bytes(),
+		fact.parent.Bytes(),
+		fact.creator.Bytes(),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact CollectionOwnershipTransferFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact CollectionOwnershipTransferFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact CollectionOwnershipTransferFact) Collection() extensioncurrency.ContractID {
+	return fact.collection
+}
+
+func (fact CollectionOwnershipTransferFact) Parent() base.Address {
+	return fact.parent
+}
+
+func (fact CollectionOwnershipTransferFact) Creator() base.Address {
+	return fact.creator
+}
+
+func (fact CollectionOwnershipTransferFact) Currency() currency.CurrencyID {
+	return fact.currency
+}
+
+func (fact CollectionOwnershipTransferFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 3)
+	as[0] = fact.sender
+	as[1] = fact.parent
+	as[2] = fact.creator
+	return as, nil
+}
+
+type CollectionOwnershipTransfer struct {
+	currency.BaseOperation
+}
+
+func NewCollectionOwnershipTransfer(fact CollectionOwnershipTransferFact) (CollectionOwnershipTransfer, error) {
+	return CollectionOwnershipTransfer{BaseOperation: currency.NewBaseOperation(CollectionOwnershipTransferHint, fact)}, nil
+}
+
+func (op *CollectionOwnershipTransfer) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
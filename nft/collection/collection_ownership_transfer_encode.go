@@ -0,0 +1,43 @@
+package collection
+
+import (
+	extensioncurrency "github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+func (fact *CollectionOwnershipTransferFact) unmarshal(
+	enc encoder.Encoder,
+	sd string,
+	col string,
+	pr string,
+	cr string,
+	cid string,
+) error {
+	e := util.StringErrorFunc("failed to unmarshal CollectionOwnershipTransferFact")
+
+	fact.collection = extensioncurrency.ContractID(col)
+	fact.currency = currency.CurrencyID(cid)
+
+	sender, err := base.DecodeAddress(sd, enc)
+	if err != nil {
+		return e(err, "")
+	}
+	fact.sender = sender
+
+	parent, err := base.DecodeAddress(pr, enc)
+	if err != nil {
+		return e(err, "")
+	}
+	fact.parent = parent
+
+	creator, err := base.DecodeAddress(cr, enc)
+	if err != nil {
+		return e(err, "")
+	}
+	fact.creator = creator
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package collection
+
+import (
+	extensioncurrency "github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+)
+
+type CollectionOwnershipTransferFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender     base.Address                 `json:"sender"`
+	Collection extensioncurrency.ContractID `json:"collection"`
+	Parent     base.Address                 `json:"parent"`
+	Creator    base.Address                 `json:"creator"`
+	Currency   currency.CurrencyID          `json:"currency"`
+}
+
+func (fact CollectionOwnershipTransferFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(CollectionOwnershipTransferFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Collection:            fact.collection,
+		Parent:                fact.parent,
+		Creator:               fact.creator,
+		Currency:              fact.currency,
+	})
+}
+
+type CollectionOwnershipTransferFactJSONUnmarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender     string `json:"sender"`
+	Collection string `json:"collection"`
+	Parent     string `json:"parent"`
+	Creator    string `json:"creator"`
+	Currency   string `json:"currency"`
+}
+
+func (fact *CollectionOwnershipTransferFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of CollectionOwnershipTransferFact")
+
+	var u CollectionOwnershipTransferFactJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(u.BaseFactJSONUnmarshaler)
+
+	return fact.unmarshal(enc, u.Sender, u.Collection, u.Parent, u.Creator, u.Currency)
+}
+
+type collectionOwnershipTransferMarshaler struct {
+	currency.BaseOperationJSONMarshaler
+}
+
+func (op CollectionOwnershipTransfer) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(collectionOwnershipTransferMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *CollectionOwnershipTransfer) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of CollectionOwnershipTransfer")
+
+	var ubo currency.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e(err, "")
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
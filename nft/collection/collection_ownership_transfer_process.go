@@ -0,0 +1,240 @@
+package collection
+
+import (
+	"context"
+	"sync"
+
+	extensioncurrency "github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var collectionOwnershipTransferProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(CollectionOwnershipTransferProcessor)
+	},
+}
+
+func (CollectionOwnershipTransfer) Process(
+	ctx context.Context, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type CollectionOwnershipTransferProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewCollectionOwnershipTransferProcessor() extensioncurrency.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringErrorFunc("failed to create new CollectionOwnershipTransferProcessor")
+
+		nopp := collectionOwnershipTransferProcessorPool.Get()
+		opp, ok := nopp.(*CollectionOwnershipTransferProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected CollectionOwnershipTransferProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e(err, "")
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *CollectionOwnershipTransferProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	e := util.StringErrorFunc("failed to preprocess CollectionOwnershipTransfer")
+
+	fact, ok := op.Fact().(CollectionOwnershipTransferFact)
+	if !ok {
+		return ctx, nil, e(nil, "not CollectionOwnershipTransferFact, %T", op.Fact())
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, nil, e(err, "")
+	}
+
+	if err := checkExistsState(currency.StateKeyAccount(fact.Sender()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("sender not found, %q: %w", fact.Sender(), err), nil
+	}
+
+	if err := checkNotExistsState(extensioncurrency.StateKeyContractAccount(fact.Sender()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("contract account cannot transfer collection ownership, %q: %w", fact.Sender(), err), nil
+	}
+
+	if err := checkFactSignsByState(fact.Sender(), op.Signs(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("invalid signing: %w", err), nil
+	}
+
+	st, err := existsState(StateKeyCollectionDesign(fact.Collection()), "key of design", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("collection design not found, %q: %w", fact.Collection(), err), nil
+	}
+
+	design, err := StateCollectionDesignValue(st)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("collection design value not found, %q: %w", fact.Collection(), err), nil
+	}
+
+	if !design.Active() {
+		return nil, base.NewBaseOperationProcessReasonError("deactivated collection, %q", fact.Collection()), nil
+	}
+
+	if design.Parent().Equal(fact.Parent()) && design.Creator().Equal(fact.Creator()) {
+		return nil, base.NewBaseOperationProcessReasonError("parent and creator not changed, %q", fact.Collection()), nil
+	}
+
+	st, err = existsState(extensioncurrency.StateKeyContractAccount(design.Parent()), "key of contract account", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("parent not found, %q: %w", design.Parent(), err), nil
+	}
+
+	ca, err := extensioncurrency.StateContractAccountValue(st)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("contract account value not found, %q: %w", design.Parent(), err), nil
+	}
+
+	if !ca.Owner().Equal(fact.Sender()) {
+		return nil, base.NewBaseOperationProcessReasonError("sender is not owner of contract account, %q, %q", fact.Sender(), ca.Owner()), nil
+	}
+
+	st, err = existsState(extensioncurrency.StateKeyContractAccount(fact.Parent()), "key of contract account", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("new parent not found, %q: %w", fact.Parent(), err), nil
+	}
+
+	nca, err := extensioncurrency.StateContractAccountValue(st)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("contract account value not found, %q: %w", fact.Parent(), err), nil
+	}
+
+	if !nca.Owner().Equal(fact.Sender()) {
+		return nil, base.NewBaseOperationProcessReasonError("sender is not owner of new parent, %q, %q", fact.Sender(), nca.Owner()), nil
+	}
+
+	if !nca.IsActive() {
+		return nil, base.NewBaseOperationProcessReasonError("deactivated contract account, %q", fact.Parent()), nil
+	}
+
+	if err := checkExistsState(currency.StateKeyAccount(fact.Creator()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("creator not found, %q: %w", fact.Creator(), err), nil
+	}
+
+	if err := checkNotExistsState(extensioncurrency.StateKeyContractAccount(fact.Creator()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("creator is contract account, %q", fact.Creator()), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *CollectionOwnershipTransferProcessor) Process(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	e := util.StringErrorFunc("failed to process CollectionOwnershipTransfer")
+
+	fact, ok := op.Fact().(CollectionOwnershipTransferFact)
+	if !ok {
+		return nil, nil, e(nil, "expected CollectionOwnershipTransferFact, not %T", op.Fact())
+	}
+
+	st, err := existsState(StateKeyCollectionDesign(fact.Collection()), "key of design", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("collection design not found, %q: %w", fact.Collection(), err), nil
+	}
+
+	design, err := StateCollectionDesignValue(st)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("collection design value not found, %q: %w", fact.Collection(), err), nil
+	}
+
+	policy, ok := design.Policy().(CollectionPolicy)
+	if !ok {
+		return nil, base.NewBaseOperationProcessReasonError("expected CollectionPolicy, not %T", design.Policy()), nil
+	}
+
+	sts := make([]base.StateMergeValue, 2)
+
+	de := NewCollectionDesign(fact.Parent(), fact.Creator(), design.Symbol(), design.Active(), policy)
+	if err := de.IsValid(nil); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("invalid collection design, %q: %w", fact.Collection(), err), nil
+	}
+	sts[0] = NewCollectionDesignStateMergeValue(StateKeyCollectionDesign(design.Symbol()), NewCollectionDesignStateValue(de))
+
+	if !design.Parent().Equal(fact.Parent()) {
+		collections, err := CollectionsOf(design.Parent(), getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("failed to get collection box, %q: %w", design.Parent(), err), nil
+		}
+
+		box := NewCollectionBox(design.Parent(), append([]extensioncurrency.ContractID{}, collections...))
+		if box.Exists(design.Symbol()) {
+			if err := box.Remove(design.Symbol()); err != nil {
+				return nil, base.NewBaseOperationProcessReasonError("failed to remove collection from collection box, %q: %w", design.Parent(), err), nil
+			}
+
+			sts = append(sts, NewCollectionBoxStateMergeValue(StateKeyCollectionBox(design.Parent()), NewCollectionBoxStateValue(box)))
+		}
+	}
+
+	switch sv, err := indexCollection(de, getStateFunc); {
+	case err != nil:
+		return nil, base.NewBaseOperationProcessReasonError("failed to index collection, %q: %w", fact.Parent(), err), nil
+	case sv != nil:
+		sts = append(sts, sv)
+	}
+
+	currencyPolicy, err := existsCurrencyPolicy(fact.Currency(), getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("currency not found, %q: %w", fact.Currency(), err), nil
+	}
+
+	fee, err := currencyPolicy.Feeer().Fee(currency.ZeroBig)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to check fee of currency, %q: %w", fact.Currency(), err), nil
+	}
+
+	st, err = existsState(currency.StateKeyBalance(fact.Sender(), fact.Currency()), "key of sender balance", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("sender balance not found, %q: %w", fact.Sender(), err), nil
+	}
+	sb := currency.NewBalanceStateMergeValue(st.Key(), st.Value())
+
+	switch b, err := currency.StateBalanceValue(st); {
+	case err != nil:
+		return nil, base.NewBaseOperationProcessReasonError("failed to get balance value, %q: %w", currency.StateKeyBalance(fact.Sender(), fact.Currency()), err), nil
+	case b.Big().Compare(fee) < 0:
+		return nil, base.NewBaseOperationProcessReasonError("not enough balance of sender, %q", fact.Sender()), nil
+	}
+
+	v, ok := sb.Value().(currency.BalanceStateValue)
+	if !ok {
+		return nil, base.NewBaseOperationProcessReasonError("expected BalanceStateValue, not %T", sb.Value()), nil
+	}
+	sts[1] = currency.NewBalanceStateMergeValue(
+		sb.Key(),
+		currency.NewBalanceStateValue(v.Amount.WithBig(v.Amount.Big().Sub(fee))),
+	)
+
+	return sts, nil, nil
+}
+
+func (opp *CollectionOwnershipTransferProcessor) Close() error {
+	collectionOwnershipTransferProcessorPool.Put(opp)
+
+	return nil
+}
@@ -112,6 +112,38 @@ func (opp *CollectionPolicyUpdaterProcessor) PreProcess(
 		return nil, base.NewBaseOperationProcessReasonError("deactivated contract account, %q", design.Parent()), nil
 	}
 
+	policy, ok := design.Policy().(CollectionPolicy)
+	if !ok {
+		return nil, base.NewBaseOperationProcessReasonError("expected CollectionPolicy, not %T", design.Policy()), nil
+	}
+
+	if policy.Transferable() != fact.Policy().Transferable() {
+		return nil, base.NewBaseOperationProcessReasonError("transferable of collection cannot be changed, %q", fact.Collection()), nil
+	}
+
+	if ms := fact.Policy().MaxSupply(); ms > 0 {
+		st, err = existsState(StateKeyCollectionLastNFTIndex(fact.Collection()), "key of collection index", getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("collection last index not found, %q: %w", fact.Collection(), err), nil
+		}
+
+		idx, err := StateCollectionLastNFTIndexValue(st)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("collection last index value not found, %q: %w", fact.Collection(), err), nil
+		}
+
+		if ms < idx {
+			return nil, base.NewBaseOperationProcessReasonError("max supply under current supply, %q; %d < %d", fact.Collection(), ms, idx), nil
+		}
+	}
+
+	if fact.Policy().PublicMint() {
+		cid := fact.Policy().MintPrice().Currency()
+		if err := checkExistsState(extensioncurrency.StateKeyCurrencyDesign(cid), getStateFunc); err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("mint currency not found, %q: %w", cid, err), nil
+		}
+	}
+
 	return ctx, nil, nil
 }
 
@@ -141,6 +173,13 @@ func (opp *CollectionPolicyUpdaterProcessor) Process(
 	de := NewCollectionDesign(design.Parent(), design.Creator(), design.Symbol(), design.Active(), fact.Policy())
 	sts[0] = NewCollectionDesignStateMergeValue(StateKeyCollectionDesign(design.Symbol()), NewCollectionDesignStateValue(de))
 
+	switch sv, err := indexCollection(de, getStateFunc); {
+	case err != nil:
+		return nil, base.NewBaseOperationProcessReasonError("failed to index collection, %q: %w", design.Parent(), err), nil
+	case sv != nil:
+		sts = append(sts, sv)
+	}
+
 	currencyPolicy, err := existsCurrencyPolicy(fact.Currency(), getStateFunc)
 	if err != nil {
 		return nil, base.NewBaseOperationProcessReasonError("currency not found, %q: %w", fact.Currency(), err), nil
@@ -14,12 +14,20 @@ var CollectionRegisterFormHint = hint.MustNewHint("mitum-nft-collection-register
 
 type CollectionRegisterForm struct {
 	hint.BaseHinter
-	target  base.Address
-	symbol  extensioncurrency.ContractID
-	name    CollectionName
-	royalty nft.PaymentParameter
-	uri     nft.URI
-	whites  []base.Address
+	target       base.Address
+	symbol       extensioncurrency.ContractID
+	name         CollectionName
+	royalty      nft.PaymentParameter
+	uri          nft.URI
+	whites       []White
+	maxSupply    uint64
+	publicMint   bool
+	mintPrice    currency.Big
+	mintCurrency currency.CurrencyID
+	mintStart    base.Height
+	mintEnd      base.Height
+	transferable bool
+	requireSigns bool
 }
 
 func NewCollectionRegisterForm(
@@ -28,16 +36,32 @@ func NewCollectionRegisterForm(
 	name CollectionName,
 	royalty nft.PaymentParameter,
 	uri nft.URI,
-	whites []base.Address,
+	whites []White,
+	maxSupply uint64,
+	publicMint bool,
+	mintPrice currency.Big,
+	mintCurrency currency.CurrencyID,
+	mintStart base.Height,
+	mintEnd base.Height,
+	transferable bool,
+	requireSigns bool,
 ) CollectionRegisterForm {
 	return CollectionRegisterForm{
-		BaseHinter: hint.NewBaseHinter(CollectionRegisterFormHint),
-		target:     target,
-		symbol:     symbol,
-		name:       name,
-		royalty:    royalty,
-		uri:        uri,
-		whites:     whites,
+		BaseHinter:   hint.NewBaseHinter(CollectionRegisterFormHint),
+		target:       target,
+		symbol:       symbol,
+		name:         name,
+		royalty:      royalty,
+		uri:          uri,
+		whites:       whites,
+		maxSupply:    maxSupply,
+		publicMint:   publicMint,
+		mintPrice:    mintPrice,
+		mintCurrency: mintCurrency,
+		mintStart:    mintStart,
+		mintEnd:      mintEnd,
+		transferable: transferable,
+		requireSigns: requireSigns,
 	}
 }
 
@@ -62,16 +86,61 @@ func (form CollectionRegisterForm) IsValid([]byte) error {
 		if err := white.IsValid(nil); err != nil {
 			return err
 		}
-		if _, found := founds[white.String()]; found {
-			return util.ErrInvalid.Errorf("duplicate white found, %q", white)
+		if _, found := founds[white.Account().String()]; found {
+			return util.ErrInvalid.Errorf("duplicate white found, %q", white.Account())
 		}
-		founds[white.String()] = struct{}{}
+		founds[white.Account().String()] = struct{}{}
+	}
+
+	if form.maxSupply > nft.MaxNFTIndex {
+		return util.ErrInvalid.Errorf("max supply over max nft index, %d > %d", form.maxSupply, nft.MaxNFTIndex)
+	}
+
+	if form.publicMint {
+		if err := form.mintCurrency.IsValid(nil); err != nil {
+			return err
+		}
+
+		if !form.mintPrice.OverNil() {
+			return util.ErrInvalid.Errorf("mint price under zero, %q", form.mintPrice)
+		}
+	} else if form.mintPrice.OverZero() || len(form.mintCurrency) > 0 {
+		return util.ErrInvalid.Errorf("mint price set without public mint")
+	}
+
+	if form.mintStart < 0 || form.mintEnd < 0 {
+		return util.ErrInvalid.Errorf("mint height under zero, %d, %d", form.mintStart, form.mintEnd)
+	}
+
+	if form.mintStart > 0 && form.mintEnd > 0 && form.mintEnd < form.mintStart {
+		return util.ErrInvalid.Errorf("mint end height under start height, %d < %d", form.mintEnd, form.mintStart)
 	}
 
 	return nil
 }
 
 func (form CollectionRegisterForm) Bytes() []byte {
+	pm := make([]byte, 1)
+	if form.publicMint {
+		pm[0] = 1
+	} else {
+		pm[0] = 0
+	}
+
+	tf := make([]byte, 1)
+	if form.transferable {
+		tf[0] = 1
+	} else {
+		tf[0] = 0
+	}
+
+	rs := make([]byte, 1)
+	if form.requireSigns {
+		rs[0] = 1
+	} else {
+		rs[0] = 0
+	}
+
 	as := make([][]byte, len(form.whites))
 	for i, white := range form.whites {
 		as[i] = white.Bytes()
@@ -84,6 +153,14 @@ func (form CollectionRegisterForm) Bytes() []byte {
 		form.royalty.Bytes(),
 		form.uri.Bytes(),
 		util.ConcatBytesSlice(as...),
+		util.Uint64ToBytes(form.maxSupply),
+		pm,
+		form.mintPrice.Bytes(),
+		form.mintCurrency.Bytes(),
+		form.mintStart.Bytes(),
+		form.mintEnd.Bytes(),
+		tf,
+		rs,
 	)
 }
 
@@ -107,15 +184,47 @@ func (form CollectionRegisterForm) URI() nft.URI {
 	return form.uri
 }
 
-func (form CollectionRegisterForm) Whites() []base.Address {
+func (form CollectionRegisterForm) Whites() []White {
 	return form.whites
 }
 
+func (form CollectionRegisterForm) MaxSupply() uint64 {
+	return form.maxSupply
+}
+
+func (form CollectionRegisterForm) PublicMint() bool {
+	return form.publicMint
+}
+
+func (form CollectionRegisterForm) MintPrice() currency.Amount {
+	return currency.NewAmount(form.mintPrice, form.mintCurrency)
+}
+
+// MintStart is the first height mint is allowed; 0 means no limit.
+func (form CollectionRegisterForm) MintStart() base.Height {
+	return form.mintStart
+}
+
+// MintEnd is the last height mint is allowed; 0 means no limit.
+func (form CollectionRegisterForm) MintEnd() base.Height {
+	return form.mintEnd
+}
+
+func (form CollectionRegisterForm) Transferable() bool {
+	return form.transferable
+}
+
+func (form CollectionRegisterForm) RequireSignatures() bool {
+	return form.requireSigns
+}
+
 func (form CollectionRegisterForm) Addresses() ([]base.Address, error) {
 	l := 1 + len(form.whites)
 
 	as := make([]base.Address, l)
-	copy(as, form.whites)
+	for i := range form.whites {
+		as[i] = form.whites[i].Account()
+	}
 
 	as[l-1] = form.target
 
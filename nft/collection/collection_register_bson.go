@@ -5,6 +5,7 @@ import (
 
 	"github.com/ProtoconNet/mitum-currency/v2/currency"
 	bsonenc "github.com/ProtoconNet/mitum-currency/v2/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
 	"github.com/ProtoconNet/mitum2/util/hint"
 	"github.com/ProtoconNet/mitum2/util/valuehash"
@@ -13,24 +14,40 @@ import (
 func (form CollectionRegisterForm) MarshalBSON() ([]byte, error) {
 	return bsonenc.Marshal(
 		bson.M{
-			"_hint":   form.Hint().String(),
-			"target":  form.target,
-			"symbol":  form.symbol,
-			"name":    form.name,
-			"royalty": form.royalty,
-			"uri":     form.uri,
-			"whites":  form.whites,
+			"_hint":              form.Hint().String(),
+			"target":             form.target,
+			"symbol":             form.symbol,
+			"name":               form.name,
+			"royalty":            form.royalty,
+			"uri":                form.uri,
+			"whites":             form.whites,
+			"max_supply":         form.maxSupply,
+			"public_mint":        form.publicMint,
+			"mint_price":         form.mintPrice,
+			"mint_currency":      form.mintCurrency,
+			"mint_start_height":  form.mintStart,
+			"mint_end_height":    form.mintEnd,
+			"transferable":       form.transferable,
+			"require_signatures": form.requireSigns,
 		})
 }
 
 type CollectionRegisterFormBSONUnmarshaler struct {
-	Hint    string   `bson:"_hint"`
-	Target  string   `bson:"target"`
-	Symbol  string   `bson:"symbol"`
-	Name    string   `bson:"name"`
-	Royalty uint     `bson:"royalty"`
-	URI     string   `bson:"uri"`
-	Whites  []string `bson:"whites"`
+	Hint         string       `bson:"_hint"`
+	Target       string       `bson:"target"`
+	Symbol       string       `bson:"symbol"`
+	Name         string       `bson:"name"`
+	Royalty      uint         `bson:"royalty"`
+	URI          string       `bson:"uri"`
+	Whites       bson.Raw     `bson:"whites"`
+	MaxSupply    uint64       `bson:"max_supply"`
+	PublicMint   bool         `bson:"public_mint"`
+	MintPrice    currency.Big `bson:"mint_price"`
+	MintCurrency string       `bson:"mint_currency"`
+	MintStart    base.Height  `bson:"mint_start_height"`
+	MintEnd      base.Height  `bson:"mint_end_height"`
+	Transferable bool         `bson:"transferable"`
+	RequireSigns bool         `bson:"require_signatures"`
 }
 
 func (form *CollectionRegisterForm) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -46,7 +63,7 @@ func (form *CollectionRegisterForm) DecodeBSON(b []byte, enc *bsonenc.Encoder) e
 		return e(err, "")
 	}
 
-	return form.unmarshal(enc, ht, u.Target, u.Symbol, u.Name, u.Royalty, u.URI, u.Whites)
+	return form.unmarshal(enc, ht, u.Target, u.Symbol, u.Name, u.Royalty, u.URI, u.Whites, u.MaxSupply, u.PublicMint, u.MintPrice, u.MintCurrency, u.MintStart, u.MintEnd, u.Transferable, u.RequireSigns)
 }
 
 func (fact CollectionRegisterFact) MarshalBSON() ([]byte, error) {
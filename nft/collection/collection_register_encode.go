@@ -18,7 +18,15 @@ func (form *CollectionRegisterForm) unmarshal(
 	nm string,
 	ry uint,
 	uri string,
-	bws []string,
+	bws []byte,
+	ms uint64,
+	pm bool,
+	mp currency.Big,
+	mc string,
+	st base.Height,
+	ed base.Height,
+	tf bool,
+	rs bool,
 ) error {
 	e := util.StringErrorFunc("failed to unmarshal CollectionRegisterForm")
 
@@ -27,6 +35,18 @@ func (form *CollectionRegisterForm) unmarshal(
 	form.name = CollectionName(nm)
 	form.royalty = nft.PaymentParameter(ry)
 	form.uri = nft.URI(uri)
+	form.maxSupply = ms
+	form.publicMint = pm
+	form.mintCurrency = currency.CurrencyID(mc)
+	form.mintStart = st
+	form.mintEnd = ed
+	form.transferable = tf
+	form.requireSigns = rs
+
+	if mp.Int == nil {
+		mp = currency.ZeroBig
+	}
+	form.mintPrice = mp
 
 	target, err := base.DecodeAddress(tg, enc)
 	if err != nil {
@@ -34,14 +54,18 @@ func (form *CollectionRegisterForm) unmarshal(
 	}
 	form.target = target
 
-	whites := make([]base.Address, len(bws))
-	for i, bw := range bws {
-		white, err := base.DecodeAddress(bw, enc)
-		if err != nil {
-			return e(err, "")
+	hws, err := enc.DecodeSlice(bws)
+	if err != nil {
+		return e(err, "")
+	}
+
+	whites := make([]White, len(hws))
+	for i, hw := range hws {
+		white, ok := hw.(White)
+		if !ok {
+			return e(util.ErrWrongType.Errorf("expected White, not %T", hw), "")
 		}
 		whites[i] = white
-
 	}
 	form.whites = whites
 
@@ -14,34 +14,58 @@ import (
 
 type CollectionRegisterFormJSONMarshaler struct {
 	hint.BaseHinter
-	Target  base.Address                 `json:"target"`
-	Symbol  extensioncurrency.ContractID `json:"symbol"`
-	Name    CollectionName               `json:"name"`
-	Royalty nft.PaymentParameter         `json:"royalty"`
-	URI     nft.URI                      `json:"uri"`
-	Whites  []base.Address               `json:"whites"`
+	Target       base.Address                 `json:"target"`
+	Symbol       extensioncurrency.ContractID `json:"symbol"`
+	Name         CollectionName               `json:"name"`
+	Royalty      nft.PaymentParameter         `json:"royalty"`
+	URI          nft.URI                      `json:"uri"`
+	Whites       []White                      `json:"whites"`
+	MaxSupply    uint64                       `json:"max_supply"`
+	PublicMint   bool                         `json:"public_mint"`
+	MintPrice    currency.Big                 `json:"mint_price"`
+	MintCurrency currency.CurrencyID          `json:"mint_currency"`
+	MintStart    base.Height                  `json:"mint_start_height"`
+	MintEnd      base.Height                  `json:"mint_end_height"`
+	Transferable bool                         `json:"transferable"`
+	RequireSigns bool                         `json:"require_signatures"`
 }
 
 func (form CollectionRegisterForm) MarshalJSON() ([]byte, error) {
 	return util.MarshalJSON(CollectionRegisterFormJSONMarshaler{
-		BaseHinter: form.BaseHinter,
-		Target:     form.target,
-		Symbol:     form.symbol,
-		Name:       form.name,
-		Royalty:    form.royalty,
-		URI:        form.uri,
-		Whites:     form.whites,
+		BaseHinter:   form.BaseHinter,
+		Target:       form.target,
+		Symbol:       form.symbol,
+		Name:         form.name,
+		Royalty:      form.royalty,
+		URI:          form.uri,
+		Whites:       form.whites,
+		MaxSupply:    form.maxSupply,
+		PublicMint:   form.publicMint,
+		MintPrice:    form.mintPrice,
+		MintCurrency: form.mintCurrency,
+		MintStart:    form.mintStart,
+		MintEnd:      form.mintEnd,
+		Transferable: form.transferable,
+		RequireSigns: form.requireSigns,
 	})
 }
 
 type CollectionRegisterFormJSONUnmarshaler struct {
-	Hint    hint.Hint `json:"_hint"`
-	Target  string    `json:"target"`
-	Symbol  string    `json:"symbol"`
-	Name    string    `json:"name"`
-	Royalty uint      `json:"royalty"`
-	URI     string    `json:"uri"`
-	Whites  []string  `json:"whites"`
+	Hint         hint.Hint       `json:"_hint"`
+	Target       string          `json:"target"`
+	Symbol       string          `json:"symbol"`
+	Name         string          `json:"name"`
+	Royalty      uint            `json:"royalty"`
+	URI          string          `json:"uri"`
+	Whites       json.RawMessage `json:"whites"`
+	MaxSupply    uint64          `json:"max_supply"`
+	PublicMint   bool            `json:"public_mint"`
+	MintPrice    currency.Big    `json:"mint_price"`
+	MintCurrency string          `json:"mint_currency"`
+	MintStart    base.Height     `json:"mint_start_height"`
+	MintEnd      base.Height     `json:"mint_end_height"`
+	Transferable bool            `json:"transferable"`
+	RequireSigns bool            `json:"require_signatures"`
 }
 
 func (form *CollectionRegisterForm) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
@@ -52,7 +76,7 @@ func (form *CollectionRegisterForm) DecodeJSON(b []byte, enc *jsonenc.Encoder) e
 		return e(err, "")
 	}
 
-	return form.unmarshal(enc, u.Hint, u.Target, u.Symbol, u.Name, u.Royalty, u.URI, u.Whites)
+	return form.unmarshal(enc, u.Hint, u.Target, u.Symbol, u.Name, u.Royalty, u.URI, u.Whites, u.MaxSupply, u.PublicMint, u.MintPrice, u.MintCurrency, u.MintStart, u.MintEnd, u.Transferable, u.RequireSigns)
 }
 
 type CollectionRegisterFactJSONMarshaler struct {
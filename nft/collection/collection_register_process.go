@@ -106,12 +106,14 @@ func (opp *CollectionRegisterProcessor) PreProcess(
 		return ctx, base.NewBaseOperationProcessReasonError("last index of collection design already exists, %q: %w", fact.Form().Symbol(), err), nil
 	}
 
-	whites := fact.Form().Whites()
-	for _, white := range whites {
-		if err := checkExistsState(currency.StateKeyAccount(white), getStateFunc); err != nil {
-			return ctx, base.NewBaseOperationProcessReasonError("whitelist account not found, %q: %w", white, err), nil
-		} else if err = checkNotExistsState(extensioncurrency.StateKeyContractAccount(white), getStateFunc); err != nil {
-			return ctx, base.NewBaseOperationProcessReasonError("whitelist account is contract account, %q: %w", white, err), nil
+	if err := checkWhiteAccounts(fact.Form().Whites(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("invalid whites: %w", err), nil
+	}
+
+	if fact.Form().PublicMint() {
+		cid := fact.Form().MintPrice().Currency()
+		if err := checkExistsState(extensioncurrency.StateKeyCurrencyDesign(cid), getStateFunc); err != nil {
+			return ctx, base.NewBaseOperationProcessReasonError("mint currency not found, %q: %w", cid, err), nil
 		}
 	}
 
@@ -129,9 +131,9 @@ func (opp *CollectionRegisterProcessor) Process(
 		return nil, nil, e(nil, "expected CollectionRegisterFact, not %T", op.Fact())
 	}
 
-	sts := make([]base.StateMergeValue, 3)
+	sts := make([]base.StateMergeValue, 4)
 
-	policy := NewCollectionPolicy(fact.Form().Name(), fact.Form().Royalty(), fact.Form().URI(), fact.Form().Whites())
+	policy := NewCollectionPolicy(fact.Form().Name(), fact.Form().Royalty(), fact.Form().URI(), fact.Form().MaxSupply(), fact.Form().PublicMint(), fact.Form().MintPrice().Big(), fact.Form().MintPrice().Currency(), fact.Form().MintStart(), fact.Form().MintEnd(), fact.Form().Transferable(), fact.Form().RequireSignatures())
 	design := NewCollectionDesign(fact.Form().Target(), fact.Sender(), fact.Form().Symbol(), true, policy)
 	if err := design.IsValid(nil); err != nil {
 		return nil, base.NewBaseOperationProcessReasonError("invalid collection design, %q: %w", fact.Form().Symbol(), err), nil
@@ -146,6 +148,28 @@ func (opp *CollectionRegisterProcessor) Process(
 		NewCollectionLastNFTIndexStateValue(design.Symbol(), 0),
 	)
 
+	collections, err := CollectionsOf(design.Parent(), getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to get collection box, %q: %w", design.Parent(), err), nil
+	}
+
+	box := NewCollectionBox(design.Parent(), append(append([]extensioncurrency.ContractID{}, collections...), design.Symbol()))
+	if err := box.IsValid(nil); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("invalid collection box, %q: %w", design.Parent(), err), nil
+	}
+
+	sts[2] = NewCollectionBoxStateMergeValue(
+		StateKeyCollectionBox(design.Parent()),
+		NewCollectionBoxStateValue(box),
+	)
+
+	for _, white := range fact.Form().Whites() {
+		sts = append(sts, NewWhiteStateMergeValue(
+			StateKeyWhite(design.Symbol(), white.Account()),
+			NewWhiteStateValue(design.Symbol(), white, true),
+		))
+	}
+
 	currencyPolicy, err := existsCurrencyPolicy(fact.Currency(), getStateFunc)
 	if err != nil {
 		return nil, base.NewBaseOperationProcessReasonError("currency not found, %q: %w", fact.Currency(), err), nil
@@ -173,7 +197,7 @@ func (opp *CollectionRegisterProcessor) Process(
 	if !ok {
 		return nil, base.NewBaseOperationProcessReasonError("expected BalanceStateValue, not %T", sb.Value()), nil
 	}
-	sts[2] = currency.NewBalanceStateMergeValue(
+	sts[3] = currency.NewBalanceStateMergeValue(
 		sb.Key(),
 		currency.NewBalanceStateValue(v.Amount.WithBig(v.Amount.Big().Sub(fee))),
 	)
@@ -0,0 +1,70 @@
+package collection
+
+import (
+	extensioncurrency "github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+var CollectionStatusHint = hint.MustNewHint("mitum-nft-collection-status-v0.0.1")
+
+type CollectionStatus struct {
+	hint.BaseHinter
+	collection extensioncurrency.ContractID
+	active     bool
+	account    base.Address
+	height     base.Height
+}
+
+func NewCollectionStatus(collection extensioncurrency.ContractID, active bool, account base.Address, height base.Height) CollectionStatus {
+	return CollectionStatus{
+		BaseHinter: hint.NewBaseHinter(CollectionStatusHint),
+		collection: collection,
+		active:     active,
+		account:    account,
+		height:     height,
+	}
+}
+
+func (cs CollectionStatus) IsValid([]byte) error {
+	return util.CheckIsValiders(nil, false,
+		cs.BaseHinter,
+		cs.collection,
+		cs.account,
+		cs.height,
+	)
+}
+
+func (cs CollectionStatus) Bytes() []byte {
+	ba := make([]byte, 1)
+
+	if cs.active {
+		ba[0] = 1
+	} else {
+		ba[0] = 0
+	}
+
+	return util.ConcatBytesSlice(
+		cs.collection.Bytes(),
+		ba,
+		cs.account.Bytes(),
+		cs.height.Bytes(),
+	)
+}
+
+func (cs CollectionStatus) Collection() extensioncurrency.ContractID {
+	return cs.collection
+}
+
+func (cs CollectionStatus) Active() bool {
+	return cs.active
+}
+
+func (cs CollectionStatus) Account() base.Address {
+	return cs.account
+}
+
+func (cs CollectionStatus) Height() base.Height {
+	return cs.height
+}
@@ -0,0 +1,46 @@
+package collection
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	bsonenc "github.com/ProtoconNet/mitum-currency/v2/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (cs CollectionStatus) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":      cs.Hint().String(),
+			"collection": cs.collection,
+			"active":     cs.active,
+			"account":    cs.account,
+			"height":     cs.height,
+		},
+	)
+}
+
+type CollectionStatusBSONUnmarshaler struct {
+	Hint       string      `bson:"_hint"`
+	Collection string      `bson:"collection"`
+	Active     bool        `bson:"active"`
+	Account    string      `bson:"account"`
+	Height     base.Height `bson:"height"`
+}
+
+func (cs *CollectionStatus) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode bson of CollectionStatus")
+
+	var u CollectionStatusBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e(err, "")
+	}
+
+	return cs.unmarshal(enc, ht, u.Collection, u.Active, u.Account, u.Height)
+}
@@ -0,0 +1,33 @@
+package collection
+
+import (
+	extensioncurrency "github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (cs *CollectionStatus) unmarshal(
+	enc encoder.Encoder,
+	ht hint.Hint,
+	col string,
+	ac bool,
+	ad string,
+	height base.Height,
+) error {
+	e := util.StringErrorFunc("failed to unmarshal CollectionStatus")
+
+	cs.BaseHinter = hint.NewBaseHinter(ht)
+	cs.collection = extensioncurrency.ContractID(col)
+	cs.active = ac
+	cs.height = height
+
+	account, err := base.DecodeAddress(ad, enc)
+	if err != nil {
+		return e(err, "")
+	}
+	cs.account = account
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package collection
+
+import (
+	extensioncurrency "github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+type CollectionStatusJSONMarshaler struct {
+	hint.BaseHinter
+	Collection extensioncurrency.ContractID `json:"collection"`
+	Active     bool                         `json:"active"`
+	Account    base.Address                 `json:"account"`
+	Height     base.Height                  `json:"height"`
+}
+
+func (cs CollectionStatus) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(CollectionStatusJSONMarshaler{
+		BaseHinter: cs.BaseHinter,
+		Collection: cs.collection,
+		Active:     cs.active,
+		Account:    cs.account,
+		Height:     cs.height,
+	})
+}
+
+type CollectionStatusJSONUnmarshaler struct {
+	Hint       hint.Hint   `json:"_hint"`
+	Collection string      `json:"collection"`
+	Active     bool        `json:"active"`
+	Account    string      `json:"account"`
+	Height     base.Height `json:"height"`
+}
+
+func (cs *CollectionStatus) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of CollectionStatus")
+
+	var u CollectionStatusJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	return cs.unmarshal(enc, u.Hint, u.Collection, u.Active, u.Account, u.Height)
+}
@@ -0,0 +1,132 @@
+package collection
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var MaxDelistItems = 10
+
+var (
+	DelistFactHint = hint.MustNewHint("mitum-nft-delist-operation-fact-v0.0.1")
+	DelistHint     = hint.MustNewHint("mitum-nft-delist-operation-v0.0.1")
+)
+
+type DelistFact struct {
+	base.BaseFact
+	sender base.Address
+	items  []DelistItem
+}
+
+func NewDelistFact(token []byte, sender base.Address, items []DelistItem) DelistFact {
+	bf := base.NewBaseFact(DelistFactHint, token)
+	fact := DelistFact{
+		BaseFact: bf,
+		sender:   sender,
+		items:    items,
+	}
+
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact DelistFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := currency.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if l := len(fact.items); l < 1 {
+		return util.ErrInvalid.Errorf("empty items for DelistFact")
+	} else if l > int(MaxDelistItems) {
+		return util.ErrInvalid.Errorf("items over allowed, %d > %d", l, MaxDelistItems)
+	}
+
+	if err := fact.sender.IsValid(nil); err != nil {
+		return err
+	}
+
+	founds := map[string]struct{}{}
+	for _, item := range fact.items {
+		if err := item.IsValid(nil); err != nil {
+			return err
+		}
+
+		n := item.NFT()
+		if err := n.IsValid(nil); err != nil {
+			return err
+		}
+
+		if _, found := founds[n.String()]; found {
+			return util.ErrInvalid.Errorf("duplicate nft found, %q", n)
+		}
+
+		founds[n.String()] = struct{}{}
+	}
+
+	return nil
+}
+
+func (fact DelistFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact DelistFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact DelistFact) Bytes() []byte {
+	is := make([][]byte, len(fact.items))
+
+	for i, item := range fact.items {
+		is[i] = item.Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		util.ConcatBytesSlice(is...),
+	)
+}
+
+func (fact DelistFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact DelistFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact DelistFact) Items() []DelistItem {
+	return fact.items
+}
+
+func (fact DelistFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 1)
+	as[0] = fact.sender
+
+	return as, nil
+}
+
+type Delist struct {
+	currency.BaseOperation
+}
+
+func NewDelist(fact DelistFact) (Delist, error) {
+	return Delist{BaseOperation: currency.NewBaseOperation(DelistHint, fact)}, nil
+}
+
+func (op *Delist) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package collection
+
+import (
+	"github.com/ProtoconNet/mitum-nft/nft"
+
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+var DelistItemHint = hint.MustNewHint("mitum-nft-delist-item-v0.0.1")
+
+type DelistItem struct {
+	hint.BaseHinter
+	nft      nft.NFTID
+	currency currency.CurrencyID
+}
+
+func NewDelistItem(n nft.NFTID, currency currency.CurrencyID) DelistItem {
+	return DelistItem{
+		BaseHinter: hint.NewBaseHinter(DelistItemHint),
+		nft:        n,
+		currency:   currency,
+	}
+}
+
+func (it DelistItem) IsValid([]byte) error {
+	return util.CheckIsValiders(nil, false,
+		it.BaseHinter,
+		it.nft,
+		it.currency,
+	)
+}
+
+func (it DelistItem) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		it.nft.Bytes(),
+		it.currency.Bytes(),
+	)
+}
+
+func (it DelistItem) NFT() nft.NFTID {
+	return it.nft
+}
+
+func (it DelistItem) Currency() currency.CurrencyID {
+	return it.currency
+}
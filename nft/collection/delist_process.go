@@ -0,0 +1,256 @@
+package collection
+
+import (
+	"context"
+	"sync"
+
+	extensioncurrency "github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var delistItemProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(DelistItemProcessor)
+	},
+}
+
+var delistProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(DelistProcessor)
+	},
+}
+
+func (Delist) Process(
+	ctx context.Context, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type DelistItemProcessor struct {
+	h      util.Hash
+	sender base.Address
+	item   DelistItem
+}
+
+func (ipp *DelistItemProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) error {
+	nid := ipp.item.NFT()
+
+	st, err := existsState(StateKeyListing(nid), "key of listing", getStateFunc)
+	if err != nil {
+		return errors.Errorf("listing not found, %q: %w", nid, err)
+	}
+
+	l, err := StateListingValue(st)
+	if err != nil {
+		return errors.Errorf("listing value not found, %q: %w", nid, err)
+	}
+
+	if !l.Active() {
+		return errors.Errorf("nft not listed, %q", nid)
+	}
+
+	st, err = existsState(StateKeyNFT(nid), "key of nft", getStateFunc)
+	if err != nil {
+		return errors.Errorf("nft not found, %q: %w", nid, err)
+	}
+
+	nv, err := StateNFTValue(st)
+	if err != nil {
+		return errors.Errorf("nft value not found, %q: %w", nid, err)
+	}
+
+	if !nv.Active() {
+		return errors.Errorf("burned nft, %q", nid)
+	}
+
+	if err := checkNotFrozen(nid, getStateFunc); err != nil {
+		return errors.Errorf("nft not available, %q: %w", nid, err)
+	}
+
+	if !(nv.Owner().Equal(ipp.sender) || nv.Approved().Equal(ipp.sender)) {
+		if st, err := existsState(StateKeyAgentBox(nv.Owner(), nv.ID().Collection()), "agents", getStateFunc); err != nil {
+			return errors.Errorf("unauthorized sender, %q: %w", ipp.sender, err)
+		} else if box, err := StateAgentBoxValue(st); err != nil {
+			return errors.Errorf("agent box value not found, %q: %w", ipp.sender, err)
+		} else if !box.Exists(ipp.sender) {
+			return errors.Errorf("unauthorized sender, %q", ipp.sender)
+		}
+	}
+
+	return nil
+}
+
+func (ipp *DelistItemProcessor) Process(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, error) {
+	nid := ipp.item.NFT()
+
+	sv, err := cancelListing(nid, getStateFunc)
+	if err != nil {
+		return nil, errors.Errorf("failed to cancel listing, %q: %w", nid, err)
+	}
+
+	if sv == nil {
+		return nil, errors.Errorf("nft not listed, %q", nid)
+	}
+
+	return []base.StateMergeValue{sv}, nil
+}
+
+func (ipp *DelistItemProcessor) Close() error {
+	ipp.h = nil
+	ipp.sender = nil
+	ipp.item = DelistItem{}
+
+	delistItemProcessorPool.Put(ipp)
+
+	return nil
+}
+
+type DelistProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewDelistProcessor() extensioncurrency.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringErrorFunc("failed to create new DelistProcessor")
+
+		nopp := delistProcessorPool.Get()
+		opp, ok := nopp.(*DelistProcessor)
+		if !ok {
+			return nil, e(nil, "expected DelistProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e(err, "")
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *DelistProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	e := util.StringErrorFunc("failed to preprocess Delist")
+
+	fact, ok := op.Fact().(DelistFact)
+	if !ok {
+		return ctx, nil, e(nil, "expected DelistFact, not %T", op.Fact())
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, nil, e(err, "")
+	}
+
+	if err := checkExistsState(currency.StateKeyAccount(fact.Sender()), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("sender not found, %q: %w", fact.Sender(), err), nil
+	}
+
+	if err := checkNotExistsState(extensioncurrency.StateKeyContractAccount(fact.Sender()), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("contract account cannot delist nfts, %q", fact.Sender()), nil
+	}
+
+	if err := checkFactSignsByState(fact.sender, op.Signs(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("invalid signing: %w", err), nil
+	}
+
+	for _, item := range fact.Items() {
+		ip := delistItemProcessorPool.Get()
+		ipc, ok := ip.(*DelistItemProcessor)
+		if !ok {
+			return nil, nil, e(nil, "expected DelistItemProcessor, not %T", ip)
+		}
+
+		ipc.h = op.Hash()
+		ipc.sender = fact.Sender()
+		ipc.item = item
+
+		if err := ipc.PreProcess(ctx, op, getStateFunc); err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("fail to preprocess DelistItem: %w", err), nil
+		}
+
+		ipc.Close()
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *DelistProcessor) Process( // nolint:dupl
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	e := util.StringErrorFunc("failed to process Delist")
+
+	fact, ok := op.Fact().(DelistFact)
+	if !ok {
+		return nil, nil, e(nil, "expected DelistFact, not %T", op.Fact())
+	}
+
+	var sts []base.StateMergeValue // nolint:prealloc
+	for _, item := range fact.Items() {
+		ip := delistItemProcessorPool.Get()
+		ipc, ok := ip.(*DelistItemProcessor)
+		if !ok {
+			return nil, nil, e(nil, "expected DelistItemProcessor, not %T", ip)
+		}
+
+		ipc.h = op.Hash()
+		ipc.sender = fact.Sender()
+		ipc.item = item
+
+		s, err := ipc.Process(ctx, op, getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("failed to process DelistItem: %w", err), nil
+		}
+		sts = append(sts, s...)
+
+		ipc.Close()
+	}
+
+	fitems := fact.Items()
+	items := make([]CollectionItem, len(fitems))
+	for i := range fact.Items() {
+		items[i] = fitems[i]
+	}
+
+	required, err := CalculateCollectionItemsFee(getStateFunc, items)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to calculate fee: %w", err), nil
+	}
+	sb, err := currency.CheckEnoughBalance(fact.sender, required, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to check enough balance: %w", err), nil
+	}
+
+	for i := range sb {
+		v, ok := sb[i].Value().(currency.BalanceStateValue)
+		if !ok {
+			return nil, nil, e(nil, "expected BalanceStateValue, not %T", sb[i].Value())
+		}
+		stv := currency.NewBalanceStateValue(v.Amount.WithBig(v.Amount.Big().Sub(required[i][0])))
+		sts = append(sts, currency.NewBalanceStateMergeValue(sb[i].Key(), stv))
+	}
+
+	return sts, nil, nil
+}
+
+func (opp *DelistProcessor) Close() error {
+	delistProcessorPool.Put(opp)
+
+	return nil
+}
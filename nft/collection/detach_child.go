@@ -0,0 +1,127 @@
+package collection
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	DetachChildFactHint = hint.MustNewHint("mitum-nft-detach-child-operation-fact-v0.0.1")
+	DetachChildHint     = hint.MustNewHint("mitum-nft-detach-child-operation-v0.0.1")
+)
+
+var MaxDetachChildItems = 10
+
+type DetachChildFact struct {
+	base.BaseFact
+	sender base.Address
+	items  []DetachChildItem
+}
+
+func NewDetachChildFact(token []byte, sender base.Address, items []DetachChildItem) DetachChildFact {
+	bf := base.NewBaseFact(DetachChildFactHint, token)
+
+	fact := DetachChildFact{
+		BaseFact: bf,
+		sender:   sender,
+		items:    items,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact DetachChildFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := currency.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if l := len(fact.items); l < 1 {
+		return util.ErrInvalid.Errorf("empty items for DetachChildFact")
+	} else if l > int(MaxDetachChildItems) {
+		return util.ErrInvalid.Errorf("items over allowed, %d > %d", l, MaxDetachChildItems)
+	}
+
+	if err := fact.sender.IsValid(nil); err != nil {
+		return err
+	}
+
+	children := map[string]struct{}{}
+	for _, item := range fact.items {
+		if err := item.IsValid(nil); err != nil {
+			return err
+		}
+
+		c := item.Child()
+		if _, found := children[c.String()]; found {
+			return util.ErrInvalid.Errorf("duplicate child found, %q", c)
+		}
+
+		children[c.String()] = struct{}{}
+	}
+
+	return nil
+}
+
+func (fact DetachChildFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact DetachChildFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact DetachChildFact) Bytes() []byte {
+	is := make([][]byte, len(fact.items))
+	for i := range fact.items {
+		is[i] = fact.items[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		util.ConcatBytesSlice(is...),
+	)
+}
+
+func (fact DetachChildFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact DetachChildFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact DetachChildFact) Items() []DetachChildItem {
+	return fact.items
+}
+
+func (fact DetachChildFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 1)
+	as[0] = fact.sender
+
+	return as, nil
+}
+
+type DetachChild struct {
+	currency.BaseOperation
+}
+
+func NewDetachChild(fact DetachChildFact) (DetachChild, error) {
+	return DetachChild{BaseOperation: currency.NewBaseOperation(DetachChildHint, fact)}, nil
+}
+
+func (op *DetachChild) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+package collection
+
+import (
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+func (fact *DetachChildFact) unmarshal(
+	enc encoder.Encoder,
+	sd string,
+	bits []byte,
+) error {
+	e := util.StringErrorFunc("failed to unmarshal DetachChildFact")
+
+	sender, err := base.DecodeAddress(sd, enc)
+	if err != nil {
+		return e(err, "")
+	}
+	fact.sender = sender
+
+	hits, err := enc.DecodeSlice(bits)
+	if err != nil {
+		return err
+	}
+
+	items := make([]DetachChildItem, len(hits))
+	for i, hinter := range hits {
+		item, ok := hinter.(DetachChildItem)
+		if !ok {
+			return e(util.ErrWrongType.Errorf("expected DetachChildItem, not %T", hinter), "")
+		}
+
+		items[i] = item
+	}
+	fact.items = items
+
+	return nil
+}
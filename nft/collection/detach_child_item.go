@@ -0,0 +1,58 @@
+package collection
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+var DetachChildItemHint = hint.MustNewHint("mitum-nft-detach-child-item-v0.0.1")
+
+type DetachChildItem struct {
+	hint.BaseHinter
+	parent   nft.NFTID
+	child    nft.NFTID
+	currency currency.CurrencyID
+}
+
+func NewDetachChildItem(parent nft.NFTID, child nft.NFTID, currency currency.CurrencyID) DetachChildItem {
+	return DetachChildItem{
+		BaseHinter: hint.NewBaseHinter(DetachChildItemHint),
+		parent:     parent,
+		child:      child,
+		currency:   currency,
+	}
+}
+
+func (it DetachChildItem) IsValid([]byte) error {
+	if err := util.CheckIsValiders(nil, false, it.BaseHinter, it.parent, it.child, it.currency); err != nil {
+		return err
+	}
+
+	if it.parent.Equal(it.child) {
+		return util.ErrInvalid.Errorf("nft cannot be a child of itself, %q", it.child)
+	}
+
+	return nil
+}
+
+func (it DetachChildItem) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		it.parent.Bytes(),
+		it.child.Bytes(),
+		it.currency.Bytes(),
+	)
+}
+
+func (it DetachChildItem) Parent() nft.NFTID {
+	return it.parent
+}
+
+func (it DetachChildItem) Child() nft.NFTID {
+	return it.child
+}
+
+func (it DetachChildItem) Currency() currency.CurrencyID {
+	return it.currency
+}
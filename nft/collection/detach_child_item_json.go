@@ -0,0 +1,46 @@
+package collection
+
+import (
+	"encoding/json"
+
+	"github.com/ProtoconNet/mitum-nft/nft"
+
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+type DetachChildItemJSONMarshaler struct {
+	hint.BaseHinter
+	Parent   nft.NFTID           `json:"parent"`
+	Child    nft.NFTID           `json:"child"`
+	Currency currency.CurrencyID `json:"currency"`
+}
+
+func (it DetachChildItem) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(DetachChildItemJSONMarshaler{
+		BaseHinter: it.BaseHinter,
+		Parent:     it.parent,
+		Child:      it.child,
+		Currency:   it.currency,
+	})
+}
+
+type DetachChildItemJSONUnmarshaler struct {
+	Hint     hint.Hint       `json:"_hint"`
+	Parent   json.RawMessage `json:"parent"`
+	Child    json.RawMessage `json:"child"`
+	Currency string          `json:"currency"`
+}
+
+func (it *DetachChildItem) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of DetachChildItem")
+
+	var u DetachChildItemJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	return it.unmarshal(enc, u.Hint, u.Parent, u.Child, u.Currency)
+}
@@ -0,0 +1,299 @@
+package collection
+
+import (
+	"context"
+	"sync"
+
+	extensioncurrency "github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var detachChildItemProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(DetachChildItemProcessor)
+	},
+}
+
+var detachChildProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(DetachChildProcessor)
+	},
+}
+
+func (DetachChild) Process(
+	ctx context.Context, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type DetachChildItemProcessor struct {
+	h      util.Hash
+	sender base.Address
+	item   DetachChildItem
+	box    *NFTBox
+}
+
+func (ipp *DetachChildItemProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) error {
+	parent := ipp.item.Parent()
+	child := ipp.item.Child()
+
+	if err := checkActiveCollection(child.Collection(), getStateFunc); err != nil {
+		return err
+	}
+
+	st, err := existsState(StateKeyNFTParent(child), "key of nft parent", getStateFunc)
+	if err != nil {
+		return errors.Errorf("nft parent not found, %q: %w", child, err)
+	}
+
+	l, err := StateNFTParentValue(st)
+	if err != nil {
+		return errors.Errorf("nft parent value not found, %q: %w", child, err)
+	}
+
+	if !l.Attached() || !l.Parent().Equal(parent) {
+		return errors.Errorf("nft not attached to parent, %q, %q", child, parent)
+	}
+
+	if err := checkNotFrozen(child, getStateFunc); err != nil {
+		return errors.Errorf("nft not available, %q: %w", child, err)
+	}
+
+	root, err := rootOf(child, getStateFunc)
+	if err != nil {
+		return errors.Errorf("failed to find root, %q: %w", child, err)
+	}
+
+	st, err = existsState(StateKeyNFT(root), "key of nft", getStateFunc)
+	if err != nil {
+		return errors.Errorf("nft not found, %q: %w", root, err)
+	}
+
+	nv, err := StateNFTValue(st)
+	if err != nil {
+		return errors.Errorf("nft value not found, %q: %w", root, err)
+	}
+
+	if err := checkNFTAuthorized(nv, ipp.sender, getStateFunc); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (ipp *DetachChildItemProcessor) Process(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, error) {
+	parent := ipp.item.Parent()
+	child := ipp.item.Child()
+
+	l := NewNFTLink(child, parent, false)
+	if err := l.IsValid(nil); err != nil {
+		return nil, errors.Errorf("invalid nft link, %q: %w", child, err)
+	}
+
+	if err := ipp.box.Remove(child); err != nil {
+		return nil, errors.Errorf("failed to remove child, %q: %w", child, err)
+	}
+
+	sts := make([]base.StateMergeValue, 1)
+
+	sts[0] = NewNFTParentStateMergeValue(StateKeyNFTParent(child), NewNFTParentStateValue(l))
+
+	return sts, nil
+}
+
+func (ipp *DetachChildItemProcessor) Close() error {
+	ipp.h = nil
+	ipp.sender = nil
+	ipp.item = DetachChildItem{}
+	ipp.box = nil
+
+	detachChildItemProcessorPool.Put(ipp)
+
+	return nil
+}
+
+type DetachChildProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewDetachChildProcessor() extensioncurrency.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringErrorFunc("failed to create new DetachChildProcessor")
+
+		nopp := detachChildProcessorPool.Get()
+		opp, ok := nopp.(*DetachChildProcessor)
+		if !ok {
+			return nil, e(nil, "expected DetachChildProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e(err, "")
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *DetachChildProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	e := util.StringErrorFunc("failed to preprocess DetachChild")
+
+	fact, ok := op.Fact().(DetachChildFact)
+	if !ok {
+		return ctx, nil, e(nil, "expected DetachChildFact, not %T", op.Fact())
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, nil, e(err, "")
+	}
+
+	if err := checkExistsState(currency.StateKeyAccount(fact.Sender()), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("sender not found, %q: %w", fact.Sender(), err), nil
+	}
+
+	if err := checkNotExistsState(extensioncurrency.StateKeyContractAccount(fact.Sender()), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("contract account cannot detach nfts, %q", fact.Sender()), nil
+	}
+
+	if err := checkFactSignsByState(fact.sender, op.Signs(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("invalid signing: %w", err), nil
+	}
+
+	for _, item := range fact.Items() {
+		ip := detachChildItemProcessorPool.Get()
+		ipc, ok := ip.(*DetachChildItemProcessor)
+		if !ok {
+			return nil, nil, e(nil, "expected DetachChildItemProcessor, not %T", ip)
+		}
+
+		ipc.h = op.Hash()
+		ipc.sender = fact.Sender()
+		ipc.item = item
+		ipc.box = nil
+
+		if err := ipc.PreProcess(ctx, op, getStateFunc); err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("fail to preprocess DetachChildItem: %w", err), nil
+		}
+
+		ipc.Close()
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *DetachChildProcessor) Process( // nolint:dupl
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	e := util.StringErrorFunc("failed to process DetachChild")
+
+	fact, ok := op.Fact().(DetachChildFact)
+	if !ok {
+		return nil, nil, e(nil, "expected DetachChildFact, not %T", op.Fact())
+	}
+
+	boxes := map[string]*NFTBox{}
+	for _, item := range fact.Items() {
+		parent := item.Parent()
+		if _, found := boxes[parent.String()]; found {
+			continue
+		}
+
+		box, err := childrenOf(parent, getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("failed to find children, %q: %w", parent, err), nil
+		}
+		boxes[parent.String()] = &box
+	}
+
+	var sts []base.StateMergeValue // nolint:prealloc
+	for _, item := range fact.Items() {
+		ip := detachChildItemProcessorPool.Get()
+		ipc, ok := ip.(*DetachChildItemProcessor)
+		if !ok {
+			return nil, nil, e(nil, "expected DetachChildItemProcessor, not %T", ip)
+		}
+
+		ipc.h = op.Hash()
+		ipc.sender = fact.Sender()
+		ipc.item = item
+		ipc.box = boxes[item.Parent().String()]
+
+		s, err := ipc.Process(ctx, op, getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("failed to process DetachChildItem: %w", err), nil
+		}
+		sts = append(sts, s...)
+
+		ipc.Close()
+	}
+
+	for _, item := range fact.Items() {
+		parent := item.Parent()
+		box, found := boxes[parent.String()]
+		if !found {
+			continue
+		}
+
+		sts = append(sts, NewNFTChildrenStateMergeValue(StateKeyNFTChildren(parent), NewNFTChildrenStateValue(*box)))
+		delete(boxes, parent.String())
+	}
+
+	required, err := opp.calculateItemsFee(op, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to calculate fee: %w", err), nil
+	}
+	sb, err := currency.CheckEnoughBalance(fact.sender, required, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to check enough balance: %w", err), nil
+	}
+
+	for i := range sb {
+		v, ok := sb[i].Value().(currency.BalanceStateValue)
+		if !ok {
+			return nil, nil, e(nil, "expected BalanceStateValue, not %T", sb[i].Value())
+		}
+		stv := currency.NewBalanceStateValue(v.Amount.WithBig(v.Amount.Big().Sub(required[i][0])))
+		sts = append(sts, currency.NewBalanceStateMergeValue(sb[i].Key(), stv))
+	}
+
+	return sts, nil, nil
+}
+
+func (opp *DetachChildProcessor) Close() error {
+	detachChildProcessorPool.Put(opp)
+
+	return nil
+}
+
+func (opp *DetachChildProcessor) calculateItemsFee(op base.Operation, getStateFunc base.GetStateFunc) (map[currency.CurrencyID][2]currency.Big, error) {
+	fact, ok := op.Fact().(DetachChildFact)
+	if !ok {
+		return nil, errors.Errorf("expected DetachChildFact, not %T", op.Fact())
+	}
+
+	items := make([]CollectionItem, len(fact.items))
+	for i := range fact.items {
+		items[i] = fact.items[i]
+	}
+
+	return CalculateCollectionItemsFee(getStateFunc, items)
+}
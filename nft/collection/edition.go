@@ -0,0 +1,101 @@
+package collection
+
+import (
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+var EditionHint = hint.MustNewHint("mitum-nft-edition-v0.0.1")
+
+type Edition struct {
+	hint.BaseHinter
+	id       nft.NFTID
+	active   bool
+	hash     nft.NFTHash
+	uri      nft.URI
+	creators nft.Signers
+	supply   uint64
+}
+
+func NewEdition(id nft.NFTID, active bool, hash nft.NFTHash, uri nft.URI, creators nft.Signers, supply uint64) Edition {
+	return Edition{
+		BaseHinter: hint.NewBaseHinter(EditionHint),
+		id:         id,
+		active:     active,
+		hash:       hash,
+		uri:        uri,
+		creators:   creators,
+		supply:     supply,
+	}
+}
+
+func (ed Edition) IsValid([]byte) error {
+	if err := util.CheckIsValiders(nil, false,
+		ed.BaseHinter,
+		ed.id,
+		ed.hash,
+		ed.uri,
+		ed.creators,
+	); err != nil {
+		return err
+	}
+
+	if len(ed.uri.String()) < 1 {
+		return util.ErrInvalid.Errorf("empty uri")
+	}
+
+	if ed.active && ed.supply == 0 {
+		return util.ErrInvalid.Errorf("zero supply of active edition, %q", ed.id)
+	}
+
+	return nil
+}
+
+func (ed Edition) Bytes() []byte {
+	ac := make([]byte, 1)
+	if ed.active {
+		ac[0] = 1
+	} else {
+		ac[0] = 0
+	}
+
+	return util.ConcatBytesSlice(
+		ed.id.Bytes(),
+		ac,
+		ed.hash.Bytes(),
+		ed.uri.Bytes(),
+		ed.creators.Bytes(),
+		util.Uint64ToBytes(ed.supply),
+	)
+}
+
+func (ed Edition) ID() nft.NFTID {
+	return ed.id
+}
+
+func (ed Edition) Active() bool {
+	return ed.active
+}
+
+func (ed Edition) NFTHash() nft.NFTHash {
+	return ed.hash
+}
+
+func (ed Edition) URI() nft.URI {
+	return ed.uri
+}
+
+func (ed Edition) Creators() nft.Signers {
+	return ed.creators
+}
+
+// Supply is the number of copies in circulation.
+func (ed Edition) Supply() uint64 {
+	return ed.supply
+}
+
+func (ed Edition) Addresses() ([]base.Address, error) {
+	return ed.creators.Addresses(), nil
+}
@@ -0,0 +1,47 @@
+package collection
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	bsonenc "github.com/ProtoconNet/mitum-currency/v2/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (ed Edition) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(bson.M{
+		"_hint":    ed.Hint().String(),
+		"id":       ed.id,
+		"active":   ed.active,
+		"hash":     ed.hash,
+		"uri":      ed.uri,
+		"creators": ed.creators,
+		"supply":   ed.supply,
+	})
+}
+
+type EditionBSONUnmarshaler struct {
+	Hint     string   `bson:"_hint"`
+	ID       bson.Raw `bson:"id"`
+	Active   bool     `bson:"active"`
+	Hash     string   `bson:"hash"`
+	URI      string   `bson:"uri"`
+	Creators bson.Raw `bson:"creators"`
+	Supply   uint64   `bson:"supply"`
+}
+
+func (ed *Edition) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode bson of Edition")
+
+	var u EditionBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e(err, "")
+	}
+
+	return ed.unmarshal(enc, ht, u.ID, u.Active, u.Hash, u.URI, u.Creators, u.Supply)
+}
@@ -0,0 +1,132 @@
+package collection
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var MaxEditionBurnItems = 10
+
+var (
+	EditionBurnFactHint = hint.MustNewHint("mitum-nft-edition-burn-operation-fact-v0.0.1")
+	EditionBurnHint     = hint.MustNewHint("mitum-nft-edition-burn-operation-v0.0.1")
+)
+
+type EditionBurnFact struct {
+	base.BaseFact
+	sender base.Address
+	items  []EditionBurnItem
+}
+
+func NewEditionBurnFact(token []byte, sender base.Address, items []EditionBurnItem) EditionBurnFact {
+	bf := base.NewBaseFact(EditionBurnFactHint, token)
+	fact := EditionBurnFact{
+		BaseFact: bf,
+		sender:   sender,
+		items:    items,
+	}
+
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact EditionBurnFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := currency.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if l := len(fact.items); l < 1 {
+		return util.ErrInvalid.Errorf("empty items for EditionBurnFact")
+	} else if l > int(MaxEditionBurnItems) {
+		return util.ErrInvalid.Errorf("items over allowed, %d > %d", l, MaxEditionBurnItems)
+	}
+
+	if err := fact.sender.IsValid(nil); err != nil {
+		return err
+	}
+
+	founds := map[string]struct{}{}
+	for _, item := range fact.items {
+		if err := item.IsValid(nil); err != nil {
+			return err
+		}
+
+		n := item.NFT()
+		if err := n.IsValid(nil); err != nil {
+			return err
+		}
+
+		if _, found := founds[n.String()]; found {
+			return util.ErrInvalid.Errorf("duplicate nft found, %q", n)
+		}
+
+		founds[n.String()] = struct{}{}
+	}
+
+	return nil
+}
+
+func (fact EditionBurnFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact EditionBurnFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact EditionBurnFact) Bytes() []byte {
+	is := make([][]byte, len(fact.items))
+
+	for i, item := range fact.items {
+		is[i] = item.Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		util.ConcatBytesSlice(is...),
+	)
+}
+
+func (fact EditionBurnFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact EditionBurnFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact EditionBurnFact) Items() []EditionBurnItem {
+	return fact.items
+}
+
+func (fact EditionBurnFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 1)
+	as[0] = fact.sender
+
+	return as, nil
+}
+
+type EditionBurn struct {
+	currency.BaseOperation
+}
+
+func NewEditionBurn(fact EditionBurnFact) (EditionBurn, error) {
+	return EditionBurn{BaseOperation: currency.NewBaseOperation(EditionBurnHint, fact)}, nil
+}
+
+func (op *EditionBurn) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
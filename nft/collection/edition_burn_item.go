@@ -0,0 +1,63 @@
+package collection
+
+import (
+	"github.com/ProtoconNet/mitum-nft/nft"
+
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+var EditionBurnItemHint = hint.MustNewHint("mitum-nft-edition-burn-item-v0.0.1")
+
+type EditionBurnItem struct {
+	hint.BaseHinter
+	nft      nft.NFTID
+	amount   uint64
+	currency currency.CurrencyID
+}
+
+func NewEditionBurnItem(n nft.NFTID, amount uint64, currency currency.CurrencyID) EditionBurnItem {
+	return EditionBurnItem{
+		BaseHinter: hint.NewBaseHinter(EditionBurnItemHint),
+		nft:        n,
+		amount:     amount,
+		currency:   currency,
+	}
+}
+
+func (it EditionBurnItem) IsValid([]byte) error {
+	if err := util.CheckIsValiders(nil, false,
+		it.BaseHinter,
+		it.nft,
+		it.currency,
+	); err != nil {
+		return err
+	}
+
+	if it.amount == 0 {
+		return util.ErrInvalid.Errorf("zero amount of edition")
+	}
+
+	return nil
+}
+
+func (it EditionBurnItem) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		it.nft.Bytes(),
+		util.Uint64ToBytes(it.amount),
+		it.currency.Bytes(),
+	)
+}
+
+func (it EditionBurnItem) NFT() nft.NFTID {
+	return it.nft
+}
+
+func (it EditionBurnItem) Amount() uint64 {
+	return it.amount
+}
+
+func (it EditionBurnItem) Currency() currency.CurrencyID {
+	return it.currency
+}
@@ -0,0 +1,46 @@
+package collection
+
+import (
+	"encoding/json"
+
+	"github.com/ProtoconNet/mitum-nft/nft"
+
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+type EditionBurnItemJSONMarshaler struct {
+	hint.BaseHinter
+	NFT      nft.NFTID           `json:"nft"`
+	Amount   uint64              `json:"amount"`
+	Currency currency.CurrencyID `json:"currency"`
+}
+
+func (it EditionBurnItem) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(EditionBurnItemJSONMarshaler{
+		BaseHinter: it.BaseHinter,
+		NFT:        it.nft,
+		Amount:     it.amount,
+		Currency:   it.currency,
+	})
+}
+
+type EditionBurnItemJSONUnmarshaler struct {
+	Hint     hint.Hint       `json:"_hint"`
+	NFT      json.RawMessage `json:"nft"`
+	Amount   uint64          `json:"amount"`
+	Currency string          `json:"currency"`
+}
+
+func (it *EditionBurnItem) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed decode json of EditionBurnItem")
+
+	var u EditionBurnItemJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	return it.unmarshal(enc, u.Hint, u.NFT, u.Amount, u.Currency)
+}
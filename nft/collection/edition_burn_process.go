@@ -0,0 +1,294 @@
+package collection
+
+import (
+	"context"
+	"sync"
+
+	extensioncurrency "github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var editionBurnItemProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(EditionBurnItemProcessor)
+	},
+}
+
+var editionBurnProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(EditionBurnProcessor)
+	},
+}
+
+func (EditionBurn) Process(
+	ctx context.Context, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type EditionBurnItemProcessor struct {
+	h      util.Hash
+	sender base.Address
+	item   EditionBurnItem
+}
+
+func (ipp *EditionBurnItemProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) error {
+	nid := ipp.item.NFT()
+
+	st, err := existsState(StateKeyCollectionDesign(nid.Collection()), "key of design", getStateFunc)
+	if err != nil {
+		return errors.Errorf("collection design not found, %q: %w", nid.Collection(), err)
+	}
+
+	design, err := StateCollectionDesignValue(st)
+	if err != nil {
+		return errors.Errorf("collection design value not found, %q: %w", nid.Collection(), err)
+	}
+
+	if !design.Active() {
+		return errors.Errorf("deactivated collection, %q", nid.Collection())
+	}
+
+	st, err = existsState(extensioncurrency.StateKeyContractAccount(design.Parent()), "contract account", getStateFunc)
+	if err != nil {
+		return errors.Errorf("parent not found, %q: %w", design.Parent(), err)
+	}
+
+	ca, err := extensioncurrency.StateContractAccountValue(st)
+	if err != nil {
+		return errors.Errorf("contract account value not found, %q: %w", design.Parent(), err)
+	}
+
+	if !ca.IsActive() {
+		return errors.Errorf("deactivated contract account, %q", design.Parent())
+	}
+
+	st, err = existsState(StateKeyEdition(nid), "key of edition", getStateFunc)
+	if err != nil {
+		return errors.Errorf("edition not found, %q: %w", nid, err)
+	}
+
+	ed, err := StateEditionValue(st)
+	if err != nil {
+		return errors.Errorf("edition value not found, %q: %w", nid, err)
+	}
+
+	if !ed.Active() {
+		return errors.Errorf("burned edition, %q", nid)
+	}
+
+	if err := checkNotFrozen(nid, getStateFunc); err != nil {
+		return errors.Errorf("edition not available, %q: %w", nid, err)
+	}
+
+	amount, err := editionBalance(nid, ipp.sender, getStateFunc)
+	if err != nil {
+		return errors.Errorf("failed to get edition balance, %q: %w", nid, err)
+	}
+
+	if amount < ipp.item.Amount() {
+		return errors.Errorf("insufficient edition balance, %q, %q; %d < %d", nid, ipp.sender, amount, ipp.item.Amount())
+	}
+
+	return nil
+}
+
+func (ipp *EditionBurnItemProcessor) Process(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, error) {
+	nid := ipp.item.NFT()
+
+	st, err := existsState(StateKeyEdition(nid), "key of edition", getStateFunc)
+	if err != nil {
+		return nil, errors.Errorf("edition not found, %q: %w", nid, err)
+	}
+
+	ed, err := StateEditionValue(st)
+	if err != nil {
+		return nil, errors.Errorf("edition value not found, %q: %w", nid, err)
+	}
+
+	amount, err := editionBalance(nid, ipp.sender, getStateFunc)
+	if err != nil {
+		return nil, errors.Errorf("failed to get edition balance, %q: %w", nid, err)
+	}
+
+	if amount < ipp.item.Amount() || ed.Supply() < ipp.item.Amount() {
+		return nil, errors.Errorf("insufficient edition balance, %q, %q; %d < %d", nid, ipp.sender, amount, ipp.item.Amount())
+	}
+
+	supply := ed.Supply() - ipp.item.Amount()
+
+	n := NewEdition(nid, supply > 0, ed.NFTHash(), ed.URI(), ed.Creators(), supply)
+	if err := n.IsValid(nil); err != nil {
+		return nil, errors.Errorf("invalid edition, %q: %w", nid, err)
+	}
+
+	sts := make([]base.StateMergeValue, 2)
+
+	sts[0] = NewEditionStateMergeValue(st.Key(), NewEditionStateValue(n))
+	sts[1] = NewEditionBalanceStateMergeValue(
+		StateKeyEditionBalance(nid, ipp.sender),
+		NewEditionBalanceStateValue(nid, ipp.sender, amount-ipp.item.Amount()),
+	)
+
+	return sts, nil
+}
+
+func (ipp *EditionBurnItemProcessor) Close() error {
+	ipp.h = nil
+	ipp.sender = nil
+	ipp.item = EditionBurnItem{}
+
+	editionBurnItemProcessorPool.Put(ipp)
+
+	return nil
+}
+
+type EditionBurnProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewEditionBurnProcessor() extensioncurrency.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringErrorFunc("failed to create new EditionBurnProcessor")
+
+		nopp := editionBurnProcessorPool.Get()
+		opp, ok := nopp.(*EditionBurnProcessor)
+		if !ok {
+			return nil, e(nil, "expected EditionBurnProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e(err, "")
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *EditionBurnProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	e := util.StringErrorFunc("failed to preprocess EditionBurn")
+
+	fact, ok := op.Fact().(EditionBurnFact)
+	if !ok {
+		return ctx, nil, e(nil, "expected EditionBurnFact, not %T", op.Fact())
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, nil, e(err, "")
+	}
+
+	if err := checkExistsState(currency.StateKeyAccount(fact.Sender()), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("sender not found, %q: %w", fact.Sender(), err), nil
+	}
+
+	if err := checkNotExistsState(extensioncurrency.StateKeyContractAccount(fact.Sender()), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("contract account cannot burn editions, %q", fact.Sender()), nil
+	}
+
+	if err := checkFactSignsByState(fact.sender, op.Signs(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("invalid signing: %w", err), nil
+	}
+
+	for _, item := range fact.Items() {
+		ip := editionBurnItemProcessorPool.Get()
+		ipc, ok := ip.(*EditionBurnItemProcessor)
+		if !ok {
+			return nil, nil, e(nil, "expected EditionBurnItemProcessor, not %T", ip)
+		}
+
+		ipc.h = op.Hash()
+		ipc.sender = fact.Sender()
+		ipc.item = item
+
+		if err := ipc.PreProcess(ctx, op, getStateFunc); err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("fail to preprocess EditionBurnItem: %w", err), nil
+		}
+
+		ipc.Close()
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *EditionBurnProcessor) Process( // nolint:dupl
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	e := util.StringErrorFunc("failed to process EditionBurn")
+
+	fact, ok := op.Fact().(EditionBurnFact)
+	if !ok {
+		return nil, nil, e(nil, "expected EditionBurnFact, not %T", op.Fact())
+	}
+
+	var sts []base.StateMergeValue // nolint:prealloc
+	for _, item := range fact.Items() {
+		ip := editionBurnItemProcessorPool.Get()
+		ipc, ok := ip.(*EditionBurnItemProcessor)
+		if !ok {
+			return nil, nil, e(nil, "expected EditionBurnItemProcessor, not %T", ip)
+		}
+
+		ipc.h = op.Hash()
+		ipc.sender = fact.Sender()
+		ipc.item = item
+
+		s, err := ipc.Process(ctx, op, getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("failed to process EditionBurnItem: %w", err), nil
+		}
+		sts = append(sts, s...)
+
+		ipc.Close()
+	}
+
+	fitems := fact.Items()
+	items := make([]CollectionItem, len(fitems))
+	for i := range fact.Items() {
+		items[i] = fitems[i]
+	}
+
+	required, err := CalculateCollectionItemsFee(getStateFunc, items)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to calculate fee: %w", err), nil
+	}
+	sb, err := currency.CheckEnoughBalance(fact.sender, required, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to check enough balance: %w", err), nil
+	}
+
+	for i := range sb {
+		v, ok := sb[i].Value().(currency.BalanceStateValue)
+		if !ok {
+			return nil, nil, e(nil, "expected BalanceStateValue, not %T", sb[i].Value())
+		}
+		stv := currency.NewBalanceStateValue(v.Amount.WithBig(v.Amount.Big().Sub(required[i][0])))
+		sts = append(sts, currency.NewBalanceStateMergeValue(sb[i].Key(), stv))
+	}
+
+	return sts, nil, nil
+}
+
+func (opp *EditionBurnProcessor) Close() error {
+	editionBurnProcessorPool.Put(opp)
+
+	return nil
+}
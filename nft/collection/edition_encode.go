@@ -0,0 +1,45 @@
+package collection
+
+import (
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (ed *Edition) unmarshal(
+	enc encoder.Encoder,
+	ht hint.Hint,
+	bid []byte,
+	ac bool,
+	hs string,
+	uri string,
+	bcrs []byte,
+	sp uint64,
+) error {
+	e := util.StringErrorFunc("failed to unmarshal Edition")
+
+	ed.BaseHinter = hint.NewBaseHinter(ht)
+	ed.active = ac
+	ed.hash = nft.NFTHash(hs)
+	ed.uri = nft.URI(uri)
+	ed.supply = sp
+
+	if hinter, err := enc.Decode(bid); err != nil {
+		return e(err, "")
+	} else if id, ok := hinter.(nft.NFTID); !ok {
+		return e(util.ErrWrongType.Errorf("expected NFTID, not %T", hinter), "")
+	} else {
+		ed.id = id
+	}
+
+	if hinter, err := enc.Decode(bcrs); err != nil {
+		return e(err, "")
+	} else if sns, ok := hinter.(nft.Signers); !ok {
+		return e(util.ErrWrongType.Errorf("expected Signers, not %T", hinter), "")
+	} else {
+		ed.creators = sns
+	}
+
+	return nil
+}
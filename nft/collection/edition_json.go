@@ -0,0 +1,53 @@
+package collection
+
+import (
+	"encoding/json"
+
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+type EditionJSONMarshaler struct {
+	hint.BaseHinter
+	ID       nft.NFTID   `json:"id"`
+	Active   bool        `json:"active"`
+	Hash     nft.NFTHash `json:"hash"`
+	URI      nft.URI     `json:"uri"`
+	Creators nft.Signers `json:"creators"`
+	Supply   uint64      `json:"supply"`
+}
+
+func (ed Edition) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(EditionJSONMarshaler{
+		BaseHinter: ed.BaseHinter,
+		ID:         ed.id,
+		Active:     ed.active,
+		Hash:       ed.hash,
+		URI:        ed.uri,
+		Creators:   ed.creators,
+		Supply:     ed.supply,
+	})
+}
+
+type EditionJSONUnmarshaler struct {
+	Hint     hint.Hint       `json:"_hint"`
+	ID       json.RawMessage `json:"id"`
+	Active   bool            `json:"active"`
+	Hash     string          `json:"hash"`
+	URI      string          `json:"uri"`
+	Creators json.RawMessage `json:"creators"`
+	Supply   uint64          `json:"supply"`
+}
+
+func (ed *Edition) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of Edition")
+
+	var u EditionJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	return ed.unmarshal(enc, u.Hint, u.ID, u.Active, u.Hash, u.URI, u.Creators, u.Supply)
+}
@@ -0,0 +1,126 @@
+package collection
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var MaxEditionMintItems = 10
+
+var (
+	EditionMintFactHint = hint.MustNewHint("mitum-nft-edition-mint-operation-fact-v0.0.1")
+	EditionMintHint     = hint.MustNewHint("mitum-nft-edition-mint-operation-v0.0.1")
+)
+
+type EditionMintFact struct {
+	base.BaseFact
+	sender base.Address
+	items  []EditionMintItem
+}
+
+func NewEditionMintFact(token []byte, sender base.Address, items []EditionMintItem) EditionMintFact {
+	bf := base.NewBaseFact(EditionMintFactHint, token)
+	fact := EditionMintFact{
+		BaseFact: bf,
+		sender:   sender,
+		items:    items,
+	}
+	fact.SetHash(fact.GenerateHash())
+	return fact
+}
+
+func (fact EditionMintFact) IsValid(b []byte) error {
+	if err := util.CheckIsValiders(nil, false,
+		fact.BaseHinter,
+		fact.sender,
+	); err != nil {
+		return err
+	}
+
+	if err := currency.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if l := len(fact.items); l < 1 {
+		return util.ErrInvalid.Errorf("empty items for EditionMintFact")
+	} else if l > int(MaxEditionMintItems) {
+		return util.ErrInvalid.Errorf("items over allowed, %d > %d", l, MaxEditionMintItems)
+	}
+
+	for _, item := range fact.items {
+		if err := item.IsValid(nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (fact EditionMintFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact EditionMintFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact EditionMintFact) Bytes() []byte {
+	is := make([][]byte, len(fact.items))
+
+	for i := range fact.items {
+		is[i] = fact.items[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		util.ConcatBytesSlice(is...),
+	)
+}
+
+func (fact EditionMintFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact EditionMintFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact EditionMintFact) Addresses() ([]base.Address, error) {
+	as := []base.Address{}
+
+	for _, item := range fact.items {
+		if ads, err := item.Addresses(); err != nil {
+			return nil, err
+		} else {
+			as = append(as, ads...)
+		}
+	}
+
+	as = append(as, fact.sender)
+
+	return as, nil
+}
+
+func (fact EditionMintFact) Items() []EditionMintItem {
+	return fact.items
+}
+
+type EditionMint struct {
+	currency.BaseOperation
+}
+
+func NewEditionMint(fact EditionMintFact) (EditionMint, error) {
+	return EditionMint{BaseOperation: currency.NewBaseOperation(EditionMintHint, fact)}, nil
+}
+
+func (op *EditionMint) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package collection
+
+import (
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+func (fact *EditionMintFact) unmarshal(
+	enc encoder.Encoder,
+	sd string,
+	bits []byte,
+) error {
+	e := util.StringErrorFunc("failed to unmarshal EditionMintFact")
+
+	switch sender, err := base.DecodeAddress(sd, enc); {
+	case err != nil:
+		return e(err, "")
+	default:
+		fact.sender = sender
+	}
+
+	hits, err := enc.DecodeSlice(bits)
+	if err != nil {
+		return e(err, "")
+	}
+
+	items := make([]EditionMintItem, len(hits))
+	for i, hinter := range hits {
+		item, ok := hinter.(EditionMintItem)
+		if !ok {
+			return e(util.ErrWrongType.Errorf("expected EditionMintItem, not %T", hinter), "")
+		}
+
+		items[i] = item
+	}
+	fact.items = items
+
+	return nil
+}
@@ -0,0 +1,104 @@
+package collection
+
+import (
+	extensioncurrency "github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+	"github.com/ProtoconNet/mitum-nft/nft"
+
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+var EditionMintItemHint = hint.MustNewHint("mitum-nft-edition-mint-item-v0.0.1")
+
+type EditionMintItem struct {
+	hint.BaseHinter
+	collection extensioncurrency.ContractID
+	hash       nft.NFTHash
+	uri        nft.URI
+	creators   nft.Signers
+	amount     uint64
+	currency   currency.CurrencyID
+}
+
+func NewEditionMintItem(
+	collection extensioncurrency.ContractID,
+	hash nft.NFTHash,
+	uri nft.URI,
+	creators nft.Signers,
+	amount uint64,
+	currency currency.CurrencyID,
+) EditionMintItem {
+	return EditionMintItem{
+		BaseHinter: hint.NewBaseHinter(EditionMintItemHint),
+		collection: collection,
+		hash:       hash,
+		uri:        uri,
+		creators:   creators,
+		amount:     amount,
+		currency:   currency,
+	}
+}
+
+func (it EditionMintItem) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		it.collection.Bytes(),
+		it.hash.Bytes(),
+		it.uri.Bytes(),
+		it.creators.Bytes(),
+		util.Uint64ToBytes(it.amount),
+		it.currency.Bytes(),
+	)
+}
+
+func (it EditionMintItem) IsValid([]byte) error {
+	if err := util.CheckIsValiders(nil, false,
+		it.BaseHinter,
+		it.collection,
+		it.hash,
+		it.uri,
+		it.creators,
+		it.currency,
+	); err != nil {
+		return err
+	}
+
+	if len(it.uri.String()) < 1 {
+		return util.ErrInvalid.Errorf("empty uri")
+	}
+
+	if it.amount == 0 {
+		return util.ErrInvalid.Errorf("zero amount of edition")
+	}
+
+	return nil
+}
+
+func (it EditionMintItem) Collection() extensioncurrency.ContractID {
+	return it.collection
+}
+
+func (it EditionMintItem) NFTHash() nft.NFTHash {
+	return it.hash
+}
+
+func (it EditionMintItem) URI() nft.URI {
+	return it.uri
+}
+
+func (it EditionMintItem) Creators() nft.Signers {
+	return it.creators
+}
+
+func (it EditionMintItem) Amount() uint64 {
+	return it.amount
+}
+
+func (it EditionMintItem) Addresses() ([]base.Address, error) {
+	return it.creators.Addresses(), nil
+}
+
+func (it EditionMintItem) Currency() currency.CurrencyID {
+	return it.currency
+}
@@ -0,0 +1,49 @@
+package collection
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	bsonenc "github.com/ProtoconNet/mitum-currency/v2/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (it EditionMintItem) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":      it.Hint().String(),
+			"collection": it.collection,
+			"hash":       it.hash,
+			"uri":        it.uri,
+			"creators":   it.creators,
+			"amount":     it.amount,
+			"currency":   it.currency,
+		},
+	)
+}
+
+type EditionMintItemBSONUnmarshaler struct {
+	Hint       string   `bson:"_hint"`
+	Collection string   `bson:"collection"`
+	Hash       string   `bson:"hash"`
+	URI        string   `bson:"uri"`
+	Creators   bson.Raw `bson:"creators"`
+	Amount     uint64   `bson:"amount"`
+	Currency   string   `bson:"currency"`
+}
+
+func (it *EditionMintItem) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode bson of EditionMintItem")
+
+	var u EditionMintItemBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e(err, "")
+	}
+
+	return it.unmarshal(enc, ht, u.Collection, u.Hash, u.URI, u.Creators, u.Amount, u.Currency)
+}
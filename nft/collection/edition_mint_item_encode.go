@@ -0,0 +1,41 @@
+package collection
+
+import (
+	extensioncurrency "github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+	"github.com/ProtoconNet/mitum-nft/nft"
+
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (it *EditionMintItem) unmarshal(
+	enc encoder.Encoder,
+	ht hint.Hint,
+	col string,
+	hs string,
+	uri string,
+	bcrs []byte,
+	am uint64,
+	cid string,
+) error {
+	e := util.StringErrorFunc("failed to unmarshal EditionMintItem")
+
+	it.BaseHinter = hint.NewBaseHinter(ht)
+	it.collection = extensioncurrency.ContractID(col)
+	it.hash = nft.NFTHash(hs)
+	it.uri = nft.URI(uri)
+	it.amount = am
+	it.currency = currency.CurrencyID(cid)
+
+	if hinter, err := enc.Decode(bcrs); err != nil {
+		return e(err, "")
+	} else if creators, ok := hinter.(nft.Signers); !ok {
+		return e(util.ErrWrongType.Errorf("expected Signers, not %T", hinter), "")
+	} else {
+		it.creators = creators
+	}
+
+	return nil
+}
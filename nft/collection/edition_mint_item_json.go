@@ -0,0 +1,56 @@
+package collection
+
+import (
+	"encoding/json"
+
+	extensioncurrency "github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+	"github.com/ProtoconNet/mitum-nft/nft"
+
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+type EditionMintItemJSONMarshaler struct {
+	hint.BaseHinter
+	Collection extensioncurrency.ContractID `json:"collection"`
+	Hash       nft.NFTHash                  `json:"hash"`
+	URI        nft.URI                      `json:"uri"`
+	Creators   nft.Signers                  `json:"creators"`
+	Amount     uint64                       `json:"amount"`
+	Currency   currency.CurrencyID          `json:"currency"`
+}
+
+func (it EditionMintItem) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(EditionMintItemJSONMarshaler{
+		BaseHinter: it.BaseHinter,
+		Collection: it.collection,
+		Hash:       it.hash,
+		URI:        it.uri,
+		Creators:   it.creators,
+		Amount:     it.amount,
+		Currency:   it.currency,
+	})
+}
+
+type EditionMintItemJSONUnmarshaler struct {
+	Hint       hint.Hint       `json:"_hint"`
+	Collection string          `json:"collection"`
+	Hash       string          `json:"hash"`
+	URI        string          `json:"uri"`
+	Creators   json.RawMessage `json:"creators"`
+	Amount     uint64          `json:"amount"`
+	Currency   string          `json:"currency"`
+}
+
+func (it *EditionMintItem) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of EditionMintItem")
+
+	var u EditionMintItemJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	return it.unmarshal(enc, u.Hint, u.Collection, u.Hash, u.URI, u.Creators, u.Amount, u.Currency)
+}
@@ -0,0 +1,387 @@
+package collection
+
+import (
+	"context"
+	"sync"
+
+	extensioncurrency "github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var editionMintItemProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(EditionMintItemProcessor)
+	},
+}
+
+var editionMintProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(EditionMintProcessor)
+	},
+}
+
+func (EditionMint) Process(
+	ctx context.Context, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type EditionMintItemProcessor struct {
+	h      util.Hash
+	sender base.Address
+	item   EditionMintItem
+	idx    uint64
+}
+
+func (ipp *EditionMintItemProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) error {
+	id := nft.NewNFTID(ipp.item.Collection(), ipp.idx)
+	if err := id.IsValid(nil); err != nil {
+		return errors.Errorf("invalid edition id, %q: %w", id, err)
+	}
+
+	if err := checkNotExistsState(StateKeyNFT(id), getStateFunc); err != nil {
+		return errors.Errorf("nft already exists, %q: %w", id, err)
+	}
+
+	if err := checkNotExistsState(StateKeyEdition(id), getStateFunc); err != nil {
+		return errors.Errorf("edition already exists, %q: %w", id, err)
+	}
+
+	if ipp.item.Creators().Total() != 0 {
+		creators := ipp.item.Creators().Signers()
+		for _, creator := range creators {
+			acc := creator.Account()
+			if err := checkExistsState(currency.StateKeyAccount(acc), getStateFunc); err != nil {
+				return errors.Errorf("creator not found, %q: %w", acc, err)
+			}
+			if err := checkNotExistsState(extensioncurrency.StateKeyContractAccount(acc), getStateFunc); err != nil {
+				return errors.Errorf("contract account cannot be a creator, %q: %w", acc, err)
+			}
+			if creator.Signed() {
+				return errors.Errorf("cannot sign at the same time as minting, %q", acc)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (ipp *EditionMintItemProcessor) Process(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, error) {
+	sts := make([]base.StateMergeValue, 2)
+
+	id := nft.NewNFTID(ipp.item.Collection(), ipp.idx)
+	if err := id.IsValid(nil); err != nil {
+		return nil, errors.Errorf("invalid edition id, %q: %w", id, err)
+	}
+
+	ed := NewEdition(id, true, ipp.item.NFTHash(), ipp.item.URI(), ipp.item.Creators(), ipp.item.Amount())
+	if err := ed.IsValid(nil); err != nil {
+		return nil, errors.Errorf("invalid edition, %q: %w", id, err)
+	}
+
+	sts[0] = NewEditionStateMergeValue(StateKeyEdition(id), NewEditionStateValue(ed))
+	sts[1] = NewEditionBalanceStateMergeValue(
+		StateKeyEditionBalance(id, ipp.sender),
+		NewEditionBalanceStateValue(id, ipp.sender, ipp.item.Amount()),
+	)
+
+	return sts, nil
+}
+
+func (ipp *EditionMintItemProcessor) Close() error {
+	ipp.h = nil
+	ipp.sender = nil
+	ipp.item = EditionMintItem{}
+	ipp.idx = 0
+
+	editionMintItemProcessorPool.Put(ipp)
+
+	return nil
+}
+
+type EditionMintProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewEditionMintProcessor() extensioncurrency.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringErrorFunc("failed to create new EditionMintProcessor")
+
+		nopp := editionMintProcessorPool.Get()
+		opp, ok := nopp.(*EditionMintProcessor)
+		if !ok {
+			return nil, e(nil, "expected EditionMintProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e(err, "")
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *EditionMintProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	e := util.StringErrorFunc("failed to preprocess EditionMint")
+
+	fact, ok := op.Fact().(EditionMintFact)
+	if !ok {
+		return ctx, nil, e(nil, "expected EditionMintFact, not %T", op.Fact())
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, nil, e(err, "")
+	}
+
+	if err := checkExistsState(currency.StateKeyAccount(fact.Sender()), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("sender not found, %q: %w", fact.Sender(), err), nil
+	}
+
+	if err := checkNotExistsState(extensioncurrency.StateKeyContractAccount(fact.Sender()), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("contract account cannot mint editions, %q", fact.Sender()), nil
+	}
+
+	if err := checkFactSignsByState(fact.sender, op.Signs(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("invalid signing: %w", err), nil
+	}
+
+	idxes := map[extensioncurrency.ContractID]uint64{}
+	supplies := map[extensioncurrency.ContractID]uint64{}
+	mints := map[extensioncurrency.ContractID]uint64{}
+	quotas := map[extensioncurrency.ContractID]uint64{}
+	for _, item := range fact.Items() {
+		collection := item.Collection()
+
+		if _, found := idxes[collection]; !found {
+			st, err := existsState(StateKeyCollectionDesign(collection), "key of collection design", getStateFunc)
+			if err != nil {
+				return nil, base.NewBaseOperationProcessReasonError("collection design not found, %q: %w", collection, err), nil
+			}
+
+			design, err := StateCollectionDesignValue(st)
+			if err != nil {
+				return nil, base.NewBaseOperationProcessReasonError("collection design value not found, %q: %w", collection, err), nil
+			}
+
+			if !design.Active() {
+				return nil, base.NewBaseOperationProcessReasonError("deactivated collection, %q", collection), nil
+			}
+
+			policy, ok := design.Policy().(CollectionPolicy)
+			if !ok {
+				return nil, base.NewBaseOperationProcessReasonError("expected CollectionPolicy, not %T", design.Policy()), nil
+			}
+
+			if h := opp.Height(); (policy.MintStart() > 0 && h < policy.MintStart()) || (policy.MintEnd() > 0 && h > policy.MintEnd()) {
+				return nil, base.NewBaseOperationProcessReasonError("out of mint window, %q; %d not in [%d, %d]", collection, h, policy.MintStart(), policy.MintEnd()), nil
+			}
+
+			white, isWhite, err := whiteOf(collection, fact.Sender(), getStateFunc)
+			if err != nil {
+				return nil, base.NewBaseOperationProcessReasonError("failed to get white, %q: %w", collection, err), nil
+			}
+
+			if !isWhite {
+				return nil, base.NewBaseOperationProcessReasonError("sender not in whitelist, %q", fact.Sender()), nil
+			}
+
+			if white.Quota() > 0 {
+				minted, err := mintCount(collection, fact.Sender(), getStateFunc)
+				if err != nil {
+					return nil, base.NewBaseOperationProcessReasonError("failed to get mint count, %q: %w", collection, err), nil
+				}
+
+				mints[collection] = minted
+				quotas[collection] = white.Quota()
+			}
+
+			st, err = existsState(extensioncurrency.StateKeyContractAccount(design.Parent()), "key of contract account", getStateFunc)
+			if err != nil {
+				return nil, base.NewBaseOperationProcessReasonError("parent not found, %q: %w", design.Parent(), err), nil
+			}
+
+			parent, err := extensioncurrency.StateContractAccountValue(st)
+			if err != nil {
+				return nil, base.NewBaseOperationProcessReasonError("parent value not found, %q: %w", design.Parent(), err), nil
+			}
+
+			if !parent.IsActive() {
+				return nil, base.NewBaseOperationProcessReasonError("deactivated parent account, %q", design.Parent()), nil
+			}
+
+			st, err = existsState(StateKeyCollectionLastNFTIndex(collection), "key of collection index", getStateFunc)
+			if err != nil {
+				return nil, base.NewBaseOperationProcessReasonError("collection last index not found, %q: %w", collection, err), nil
+			}
+
+			idx, err := StateCollectionLastNFTIndexValue(st)
+			if err != nil {
+				return nil, base.NewBaseOperationProcessReasonError("collection last index value not found, %q: %w", collection, err), nil
+			}
+
+			idxes[collection] = idx
+			supplies[collection] = policy.MaxSupply()
+		}
+	}
+
+	for _, item := range fact.Items() {
+		ip := editionMintItemProcessorPool.Get()
+		ipc, ok := ip.(*EditionMintItemProcessor)
+		if !ok {
+			return nil, nil, e(nil, "expected EditionMintItemProcessor, not %T", ip)
+		}
+
+		idxes[item.Collection()] += 1
+
+		if ms := supplies[item.Collection()]; ms > 0 && idxes[item.Collection()] > ms {
+			return nil, base.NewBaseOperationProcessReasonError("max supply of collection reached, %q; %d", item.Collection(), ms), nil
+		}
+
+		if qt, found := quotas[item.Collection()]; found {
+			mints[item.Collection()] += 1
+			if mints[item.Collection()] > qt {
+				return nil, base.NewBaseOperationProcessReasonError("mint quota of sender exceeded, %q, %q; %d", item.Collection(), fact.Sender(), qt), nil
+			}
+		}
+
+		ipc.h = op.Hash()
+		ipc.sender = fact.Sender()
+		ipc.item = item
+		ipc.idx = idxes[item.Collection()]
+
+		if err := ipc.PreProcess(ctx, op, getStateFunc); err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("fail to preprocess EditionMintItem: %w", err), nil
+		}
+
+		ipc.Close()
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *EditionMintProcessor) Process( // nolint:dupl
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	e := util.StringErrorFunc("failed to process EditionMint")
+
+	fact, ok := op.Fact().(EditionMintFact)
+	if !ok {
+		return nil, nil, e(nil, "expected EditionMintFact, not %T", op.Fact())
+	}
+
+	idxes := map[extensioncurrency.ContractID]uint64{}
+	mints := map[extensioncurrency.ContractID]uint64{}
+	for _, item := range fact.items {
+		collection := item.Collection()
+
+		if _, found := idxes[collection]; !found {
+			st, err := existsState(StateKeyCollectionLastNFTIndex(collection), "key of collection index", getStateFunc)
+			if err != nil {
+				return nil, base.NewBaseOperationProcessReasonError("collection last index not found, %q: %w", collection, err), nil
+			}
+
+			idx, err := StateCollectionLastNFTIndexValue(st)
+			if err != nil {
+				return nil, base.NewBaseOperationProcessReasonError("collection last index value not found, %q: %w", collection, err), nil
+			}
+
+			minted, err := mintCount(collection, fact.Sender(), getStateFunc)
+			if err != nil {
+				return nil, base.NewBaseOperationProcessReasonError("failed to get mint count, %q: %w", collection, err), nil
+			}
+
+			idxes[collection] = idx
+			mints[collection] = minted
+		}
+	}
+
+	var sts []base.StateMergeValue // nolint:prealloc
+
+	ipcs := make([]*EditionMintItemProcessor, len(fact.Items()))
+	for i, item := range fact.Items() {
+		ip := editionMintItemProcessorPool.Get()
+		ipc, ok := ip.(*EditionMintItemProcessor)
+		if !ok {
+			return nil, nil, e(nil, "expected EditionMintItemProcessor, not %T", ip)
+		}
+
+		idxes[item.Collection()] += 1
+		mints[item.Collection()] += 1
+
+		ipc.h = op.Hash()
+		ipc.sender = fact.Sender()
+		ipc.item = item
+		ipc.idx = idxes[item.Collection()]
+
+		s, err := ipc.Process(ctx, op, getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("failed to process EditionMintItem: %w", err), nil
+		}
+		sts = append(sts, s...)
+
+		ipcs[i] = ipc
+	}
+
+	for c, idx := range idxes {
+		iv := NewCollectionLastNFTIndexStateMergeValue(StateKeyCollectionLastNFTIndex(c), NewCollectionLastNFTIndexStateValue(c, idx))
+		sts = append(sts, iv)
+	}
+
+	for c, count := range mints {
+		mv := NewMintCountStateMergeValue(StateKeyMintCount(c, fact.Sender()), NewMintCountStateValue(c, fact.Sender(), count))
+		sts = append(sts, mv)
+	}
+
+	for _, ipc := range ipcs {
+		ipc.Close()
+	}
+
+	idxes = nil
+	mints = nil
+
+	fitems := fact.Items()
+	items := make([]CollectionItem, len(fitems))
+	for i := range fact.Items() {
+		items[i] = fitems[i]
+	}
+
+	required, err := CalculateCollectionItemsFee(getStateFunc, items)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to calculate fee: %w", err), nil
+	}
+
+	balances := newBalanceChanges(getStateFunc)
+	if err := balances.subFee(fact.Sender(), required); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to check enough balance: %w", err), nil
+	}
+
+	sts = append(sts, balances.stateMergeValues()...)
+
+	return sts, nil, nil
+}
+
+func (opp *EditionMintProcessor) Close() error {
+	editionMintProcessorPool.Put(opp)
+
+	return nil
+}
@@ -0,0 +1,142 @@
+package collection
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	EditionTransferFactHint = hint.MustNewHint("mitum-nft-edition-transfer-operation-fact-v0.0.1")
+	EditionTransferHint     = hint.MustNewHint("mitum-nft-edition-transfer-operation-v0.0.1")
+)
+
+var MaxEditionTransferItems = 10
+
+// EditionTransferFact moves edition amounts without payment, so the collection
+// royalty is not applied to editions; priced edition trades are out of scope.
+type EditionTransferFact struct {
+	base.BaseFact
+	sender base.Address
+	items  []EditionTransferItem
+}
+
+func NewEditionTransferFact(token []byte, sender base.Address, items []EditionTransferItem) EditionTransferFact {
+	bf := base.NewBaseFact(EditionTransferFactHint, token)
+
+	fact := EditionTransferFact{
+		BaseFact: bf,
+		sender:   sender,
+		items:    items,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact EditionTransferFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := currency.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if l := len(fact.items); l < 1 {
+		return util.ErrInvalid.Errorf("empty items for EditionTransferFact")
+	} else if l > int(MaxEditionTransferItems) {
+		return util.ErrInvalid.Errorf("items over allowed, %d > %d", l, MaxEditionTransferItems)
+	}
+
+	if err := fact.sender.IsValid(nil); err != nil {
+		return err
+	}
+
+	founds := map[string]struct{}{}
+	for _, item := range fact.items {
+		if err := item.IsValid(nil); err != nil {
+			return err
+		}
+
+		n := item.NFT()
+		if err := n.IsValid(nil); err != nil {
+			return err
+		}
+
+		if _, found := founds[n.String()]; found {
+			return util.ErrInvalid.Errorf("duplicate nft found, %q", n)
+		}
+
+		founds[n.String()] = struct{}{}
+	}
+
+	return nil
+}
+
+func (fact EditionTransferFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact EditionTransferFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact EditionTransferFact) Bytes() []byte {
+	is := make([][]byte, len(fact.items))
+	for i := range fact.items {
+		is[i] = fact.items[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		util.ConcatBytesSlice(is...),
+	)
+}
+
+func (fact EditionTransferFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact EditionTransferFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact EditionTransferFact) Items() []EditionTransferItem {
+	return fact.items
+}
+
+func (fact EditionTransferFact) Addresses() ([]base.Address, error) {
+	as := []base.Address{}
+
+	for i := range fact.items {
+		if ads, err := fact.items[i].Addresses(); err != nil {
+			return nil, err
+		} else {
+			as = append(as, ads...)
+		}
+	}
+
+	as = append(as, fact.Sender())
+
+	return as, nil
+}
+
+type EditionTransfer struct {
+	currency.BaseOperation
+}
+
+func NewEditionTransfer(fact EditionTransferFact) (EditionTransfer, error) {
+	return EditionTransfer{BaseOperation: currency.NewBaseOperation(EditionTransferHint, fact)}, nil
+}
+
+func (op *EditionTransfer) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
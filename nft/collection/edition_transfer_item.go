@@ -0,0 +1,72 @@
+package collection
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+var EditionTransferItemHint = hint.MustNewHint("mitum-nft-edition-transfer-item-v0.0.1")
+
+type EditionTransferItem struct {
+	hint.BaseHinter
+	receiver base.Address
+	nft      nft.NFTID
+	amount   uint64
+	currency currency.CurrencyID
+}
+
+func NewEditionTransferItem(receiver base.Address, n nft.NFTID, amount uint64, currency currency.CurrencyID) EditionTransferItem {
+	return EditionTransferItem{
+		BaseHinter: hint.NewBaseHinter(EditionTransferItemHint),
+		receiver:   receiver,
+		nft:        n,
+		amount:     amount,
+		currency:   currency,
+	}
+}
+
+func (it EditionTransferItem) IsValid([]byte) error {
+	if err := util.CheckIsValiders(nil, false, it.BaseHinter, it.receiver, it.nft, it.currency); err != nil {
+		return err
+	}
+
+	if it.amount == 0 {
+		return util.ErrInvalid.Errorf("zero amount of edition")
+	}
+
+	return nil
+}
+
+func (it EditionTransferItem) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		it.receiver.Bytes(),
+		it.nft.Bytes(),
+		util.Uint64ToBytes(it.amount),
+		it.currency.Bytes(),
+	)
+}
+
+func (it EditionTransferItem) Receiver() base.Address {
+	return it.receiver
+}
+
+func (it EditionTransferItem) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 1)
+	as[0] = it.receiver
+	return as, nil
+}
+
+func (it EditionTransferItem) NFT() nft.NFTID {
+	return it.nft
+}
+
+func (it EditionTransferItem) Amount() uint64 {
+	return it.amount
+}
+
+func (it EditionTransferItem) Currency() currency.CurrencyID {
+	return it.currency
+}
@@ -0,0 +1,45 @@
+package collection
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	bsonenc "github.com/ProtoconNet/mitum-currency/v2/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (it EditionTransferItem) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":    it.Hint().String(),
+			"receiver": it.receiver,
+			"nft":      it.nft,
+			"amount":   it.amount,
+			"currency": it.currency,
+		},
+	)
+}
+
+type EditionTransferItemBSONUnmarshaler struct {
+	Hint     string   `bson:"_hint"`
+	Receiver string   `bson:"receiver"`
+	NFT      bson.Raw `bson:"nft"`
+	Amount   uint64   `bson:"amount"`
+	Currency string   `bson:"currency"`
+}
+
+func (it *EditionTransferItem) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode bson of EditionTransferItem")
+
+	var u EditionTransferItemBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e(err, "")
+	}
+
+	return it.unmarshal(enc, ht, u.Receiver, u.NFT, u.Amount, u.Currency)
+}
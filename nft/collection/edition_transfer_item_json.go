@@ -0,0 +1,50 @@
+package collection
+
+import (
+	"encoding/json"
+
+	"github.com/ProtoconNet/mitum-nft/nft"
+
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+type EditionTransferItemJSONMarshaler struct {
+	hint.BaseHinter
+	Receiver base.Address        `json:"receiver"`
+	NFT      nft.NFTID           `json:"nft"`
+	Amount   uint64              `json:"amount"`
+	Currency currency.CurrencyID `json:"currency"`
+}
+
+func (it EditionTransferItem) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(EditionTransferItemJSONMarshaler{
+		BaseHinter: it.BaseHinter,
+		Receiver:   it.receiver,
+		NFT:        it.nft,
+		Amount:     it.amount,
+		Currency:   it.currency,
+	})
+}
+
+type EditionTransferItemJSONUnmarshaler struct {
+	Hint     hint.Hint       `json:"_hint"`
+	Receiver string          `json:"receiver"`
+	NFT      json.RawMessage `json:"nft"`
+	Amount   uint64          `json:"amount"`
+	Currency string          `json:"currency"`
+}
+
+func (it *EditionTransferItem) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of EditionTransferItem")
+
+	var u EditionTransferItemJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	return it.unmarshal(enc, u.Hint, u.Receiver, u.NFT, u.Amount, u.Currency)
+}
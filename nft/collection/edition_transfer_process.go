@@ -0,0 +1,312 @@
+package collection
+
+import (
+	"context"
+	"sync"
+
+	extensioncurrency "github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var editionTransferItemProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(EditionTransferItemProcessor)
+	},
+}
+
+var editionTransferProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(EditionTransferProcessor)
+	},
+}
+
+func (EditionTransfer) Process(
+	ctx context.Context, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type EditionTransferItemProcessor struct {
+	h      util.Hash
+	sender base.Address
+	item   EditionTransferItem
+}
+
+func (ipp *EditionTransferItemProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) error {
+	receiver := ipp.item.Receiver()
+
+	if receiver.Equal(ipp.sender) {
+		return errors.Errorf("receiver is same with sender, %q", receiver)
+	}
+
+	if err := checkExistsState(currency.StateKeyAccount(receiver), getStateFunc); err != nil {
+		return errors.Errorf("receiver not found, %q: %w", receiver, err)
+	}
+
+	if err := checkNotExistsState(extensioncurrency.StateKeyContractAccount(receiver), getStateFunc); err != nil {
+		return errors.Errorf("contract account cannot receive editions, %q: %w", receiver, err)
+	}
+
+	nid := ipp.item.NFT()
+
+	st, err := existsState(StateKeyCollectionDesign(nid.Collection()), "design", getStateFunc)
+	if err != nil {
+		return errors.Errorf("collection design not found, %q: %w", nid.Collection(), err)
+	}
+
+	design, err := StateCollectionDesignValue(st)
+	if err != nil {
+		return errors.Errorf("collection design not found, %q: %w", nid.Collection(), err)
+	}
+	if !design.Active() {
+		return errors.Errorf("deactivated collection, %q", design.Symbol())
+	}
+
+	st, err = existsState(extensioncurrency.StateKeyContractAccount(design.Parent()), "key of contract account", getStateFunc)
+	if err != nil {
+		return errors.Errorf("parent not found, %q: %w", design.Parent(), err)
+	}
+
+	ca, err := extensioncurrency.StateContractAccountValue(st)
+	if err != nil {
+		return errors.Errorf("parent account value not found, %q: %w", design.Parent(), err)
+	}
+
+	if !ca.IsActive() {
+		return errors.Errorf("deactivated contract account, %q", design.Parent())
+	}
+
+	st, err = existsState(StateKeyEdition(nid), "key of edition", getStateFunc)
+	if err != nil {
+		return errors.Errorf("edition not found, %q: %w", nid, err)
+	}
+
+	ed, err := StateEditionValue(st)
+	if err != nil {
+		return errors.Errorf("edition value not found, %q: %w", nid, err)
+	}
+
+	if !ed.Active() {
+		return errors.Errorf("burned edition, %q", nid)
+	}
+
+	if err := checkNotFrozen(nid, getStateFunc); err != nil {
+		return errors.Errorf("edition not available, %q: %w", nid, err)
+	}
+
+	if err := checkTransferable(nid.Collection(), getStateFunc); err != nil {
+		return errors.Errorf("edition not transferable, %q: %w", nid, err)
+	}
+
+	amount, err := editionBalance(nid, ipp.sender, getStateFunc)
+	if err != nil {
+		return errors.Errorf("failed to get edition balance, %q: %w", nid, err)
+	}
+
+	if amount < ipp.item.Amount() {
+		return errors.Errorf("insufficient edition balance, %q, %q; %d < %d", nid, ipp.sender, amount, ipp.item.Amount())
+	}
+
+	return nil
+}
+
+func (ipp *EditionTransferItemProcessor) Process(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, error) {
+	receiver := ipp.item.Receiver()
+	nid := ipp.item.NFT()
+
+	sent, err := editionBalance(nid, ipp.sender, getStateFunc)
+	if err != nil {
+		return nil, errors.Errorf("failed to get edition balance, %q: %w", nid, err)
+	}
+
+	if sent < ipp.item.Amount() {
+		return nil, errors.Errorf("insufficient edition balance, %q, %q; %d < %d", nid, ipp.sender, sent, ipp.item.Amount())
+	}
+
+	received, err := editionBalance(nid, receiver, getStateFunc)
+	if err != nil {
+		return nil, errors.Errorf("failed to get edition balance, %q: %w", nid, err)
+	}
+
+	sts := make([]base.StateMergeValue, 2)
+
+	sts[0] = NewEditionBalanceStateMergeValue(
+		StateKeyEditionBalance(nid, ipp.sender),
+		NewEditionBalanceStateValue(nid, ipp.sender, sent-ipp.item.Amount()),
+	)
+	sts[1] = NewEditionBalanceStateMergeValue(
+		StateKeyEditionBalance(nid, receiver),
+		NewEditionBalanceStateValue(nid, receiver, received+ipp.item.Amount()),
+	)
+
+	return sts, nil
+}
+
+func (ipp *EditionTransferItemProcessor) Close() error {
+	ipp.h = nil
+	ipp.sender = nil
+	ipp.item = EditionTransferItem{}
+
+	editionTransferItemProcessorPool.Put(ipp)
+
+	return nil
+}
+
+type EditionTransferProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewEditionTransferProcessor() extensioncurrency.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringErrorFunc("failed to create new EditionTransferProcessor")
+
+		nopp := editionTransferProcessorPool.Get()
+		opp, ok := nopp.(*EditionTransferProcessor)
+		if !ok {
+			return nil, e(nil, "expected EditionTransferProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e(err, "")
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *EditionTransferProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	e := util.StringErrorFunc("failed to preprocess EditionTransfer")
+
+	fact, ok := op.Fact().(EditionTransferFact)
+	if !ok {
+		return ctx, nil, e(nil, "expected EditionTransferFact, not %T", op.Fact())
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, nil, e(err, "")
+	}
+
+	if err := checkExistsState(currency.StateKeyAccount(fact.Sender()), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("sender not found, %q: %w", fact.Sender(), err), nil
+	}
+
+	if err := checkNotExistsState(extensioncurrency.StateKeyContractAccount(fact.Sender()), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("contract account cannot transfer editions, %q", fact.Sender()), nil
+	}
+
+	if err := checkFactSignsByState(fact.sender, op.Signs(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("invalid signing: %w", err), nil
+	}
+
+	for _, item := range fact.Items() {
+		ip := editionTransferItemProcessorPool.Get()
+		ipc, ok := ip.(*EditionTransferItemProcessor)
+		if !ok {
+			return nil, nil, e(nil, "expected EditionTransferItemProcessor, not %T", ip)
+		}
+
+		ipc.h = op.Hash()
+		ipc.sender = fact.Sender()
+		ipc.item = item
+
+		if err := ipc.PreProcess(ctx, op, getStateFunc); err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("fail to preprocess EditionTransferItem: %w", err), nil
+		}
+
+		ipc.Close()
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *EditionTransferProcessor) Process( // nolint:dupl
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	e := util.StringErrorFunc("failed to process EditionTransfer")
+
+	fact, ok := op.Fact().(EditionTransferFact)
+	if !ok {
+		return nil, nil, e(nil, "expected EditionTransferFact, not %T", op.Fact())
+	}
+
+	var sts []base.StateMergeValue // nolint:prealloc
+	for _, item := range fact.Items() {
+		ip := editionTransferItemProcessorPool.Get()
+		ipc, ok := ip.(*EditionTransferItemProcessor)
+		if !ok {
+			return nil, nil, e(nil, "expected EditionTransferItemProcessor, not %T", ip)
+		}
+
+		ipc.h = op.Hash()
+		ipc.sender = fact.Sender()
+		ipc.item = item
+
+		s, err := ipc.Process(ctx, op, getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("failed to process EditionTransferItem: %w", err), nil
+		}
+		sts = append(sts, s...)
+
+		ipc.Close()
+	}
+
+	required, err := opp.calculateItemsFee(op, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to calculate fee: %w", err), nil
+	}
+	sb, err := currency.CheckEnoughBalance(fact.sender, required, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to check enough balance: %w", err), nil
+	}
+
+	for i := range sb {
+		v, ok := sb[i].Value().(currency.BalanceStateValue)
+		if !ok {
+			return nil, nil, e(nil, "expected BalanceStateValue, not %T", sb[i].Value())
+		}
+		stv := currency.NewBalanceStateValue(v.Amount.WithBig(v.Amount.Big().Sub(required[i][0])))
+		sts = append(sts, currency.NewBalanceStateMergeValue(sb[i].Key(), stv))
+	}
+
+	return sts, nil, nil
+}
+
+func (opp *EditionTransferProcessor) Close() error {
+	editionTransferProcessorPool.Put(opp)
+
+	return nil
+}
+
+func (opp *EditionTransferProcessor) calculateItemsFee(op base.Operation, getStateFunc base.GetStateFunc) (map[currency.CurrencyID][2]currency.Big, error) {
+	fact, ok := op.Fact().(EditionTransferFact)
+	if !ok {
+		return nil, errors.Errorf("expected EditionTransferFact, not %T", op.Fact())
+	}
+
+	items := make([]CollectionItem, len(fact.items))
+	for i := range fact.items {
+		items[i] = fact.items[i]
+	}
+
+	return CalculateCollectionItemsFee(getStateFunc, items)
+}
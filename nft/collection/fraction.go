@@ -0,0 +1,85 @@
+package collection
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+var FractionHint = hint.MustNewHint("mitum-nft-fraction-v0.0.1")
+
+type Fraction struct {
+	hint.BaseHinter
+	nft    nft.NFTID
+	vault  base.Address
+	share  currency.CurrencyID
+	supply currency.Big
+	active bool
+}
+
+func NewFraction(n nft.NFTID, vault base.Address, share currency.CurrencyID, supply currency.Big, active bool) Fraction {
+	return Fraction{
+		BaseHinter: hint.NewBaseHinter(FractionHint),
+		nft:        n,
+		vault:      vault,
+		share:      share,
+		supply:     supply,
+		active:     active,
+	}
+}
+
+func (f Fraction) IsValid([]byte) error {
+	if err := util.CheckIsValiders(nil, false,
+		f.BaseHinter,
+		f.nft,
+		f.vault,
+		f.share,
+	); err != nil {
+		return err
+	}
+
+	if !f.supply.OverZero() {
+		return util.ErrInvalid.Errorf("supply of shares should be over zero")
+	}
+
+	return nil
+}
+
+func (f Fraction) Bytes() []byte {
+	ac := make([]byte, 1)
+	if f.active {
+		ac[0] = 1
+	} else {
+		ac[0] = 0
+	}
+
+	return util.ConcatBytesSlice(
+		f.nft.Bytes(),
+		f.vault.Bytes(),
+		f.share.Bytes(),
+		f.supply.Bytes(),
+		ac,
+	)
+}
+
+func (f Fraction) NFT() nft.NFTID {
+	return f.nft
+}
+
+func (f Fraction) Vault() base.Address {
+	return f.vault
+}
+
+func (f Fraction) Share() currency.CurrencyID {
+	return f.share
+}
+
+func (f Fraction) Supply() currency.Big {
+	return f.supply
+}
+
+func (f Fraction) Active() bool {
+	return f.active
+}
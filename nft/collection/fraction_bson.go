@@ -0,0 +1,45 @@
+package collection
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	bsonenc "github.com/ProtoconNet/mitum-currency/v2/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (f Fraction) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(bson.M{
+		"_hint":  f.Hint().String(),
+		"nft":    f.nft,
+		"vault":  f.vault,
+		"share":  f.share,
+		"supply": f.supply,
+		"active": f.active,
+	})
+}
+
+type FractionBSONUnmarshaler struct {
+	Hint   string       `bson:"_hint"`
+	NFT    bson.Raw     `bson:"nft"`
+	Vault  string       `bson:"vault"`
+	Share  string       `bson:"share"`
+	Supply currency.Big `bson:"supply"`
+	Active bool         `bson:"active"`
+}
+
+func (f *Fraction) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode bson of Fraction")
+
+	var u FractionBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e(err, "")
+	}
+
+	return f.unmarshal(enc, ht, u.NFT, u.Vault, u.Share, u.Supply, u.Active)
+}
@@ -0,0 +1,47 @@
+package collection
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (f *Fraction) unmarshal(
+	enc encoder.Encoder,
+	ht hint.Hint,
+	bn []byte,
+	vt string,
+	cid string,
+	sp currency.Big,
+	ac bool,
+) error {
+	e := util.StringErrorFunc("failed to unmarshal Fraction")
+
+	f.BaseHinter = hint.NewBaseHinter(ht)
+	f.share = currency.CurrencyID(cid)
+	f.active = ac
+
+	if sp.Int == nil {
+		sp = currency.ZeroBig
+	}
+	f.supply = sp
+
+	if hinter, err := enc.Decode(bn); err != nil {
+		return e(err, "")
+	} else if n, ok := hinter.(nft.NFTID); !ok {
+		return e(util.ErrWrongType.Errorf("expected NFTID, not %T", hinter), "")
+	} else {
+		f.nft = n
+	}
+
+	vault, err := base.DecodeAddress(vt, enc)
+	if err != nil {
+		return e(err, "")
+	}
+	f.vault = vault
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package collection
+
+import (
+	"encoding/json"
+
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+type FractionJSONMarshaler struct {
+	hint.BaseHinter
+	NFT    nft.NFTID           `json:"nft"`
+	Vault  base.Address        `json:"vault"`
+	Share  currency.CurrencyID `json:"share"`
+	Supply currency.Big        `json:"supply"`
+	Active bool                `json:"active"`
+}
+
+func (f Fraction) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(FractionJSONMarshaler{
+		BaseHinter: f.BaseHinter,
+		NFT:        f.nft,
+		Vault:      f.vault,
+		Share:      f.share,
+		Supply:     f.supply,
+		Active:     f.active,
+	})
+}
+
+type FractionJSONUnmarshaler struct {
+	Hint   hint.Hint       `json:"_hint"`
+	NFT    json.RawMessage `json:"nft"`
+	Vault  string          `json:"vault"`
+	Share  string          `json:"share"`
+	Supply currency.Big    `json:"supply"`
+	Active bool            `json:"active"`
+}
+
+func (f *Fraction) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of Fraction")
+
+	var u FractionJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	return f.unmarshal(enc, u.Hint, u.NFT, u.Vault, u.Share, u.Supply, u.Active)
+}
@@ -0,0 +1,155 @@
+package collection
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	FractionalizeFactHint = hint.MustNewHint("mitum-nft-fractionalize-operation-fact-v0.0.1")
+	FractionalizeHint     = hint.MustNewHint("mitum-nft-fractionalize-operation-v0.0.1")
+)
+
+type FractionalizeFact struct {
+	base.BaseFact
+	sender   base.Address
+	nft      nft.NFTID
+	vault    base.Address
+	share    currency.CurrencyID
+	supply   currency.Big
+	currency currency.CurrencyID
+}
+
+func NewFractionalizeFact(
+	token []byte, sender base.Address,
+	n nft.NFTID,
+	vault base.Address,
+	share currency.CurrencyID,
+	supply currency.Big,
+	currency currency.CurrencyID,
+) FractionalizeFact {
+	bf := base.NewBaseFact(FractionalizeFactHint, token)
+
+	fact := FractionalizeFact{
+		BaseFact: bf,
+		sender:   sender,
+		nft:      n,
+		vault:    vault,
+		share:    share,
+		supply:   supply,
+		currency: currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact FractionalizeFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := currency.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if err := util.CheckIsValiders(
+		nil, false,
+		fact.sender,
+		fact.nft,
+		fact.vault,
+		fact.share,
+		fact.currency,
+	); err != nil {
+		return err
+	}
+
+	if !fact.supply.OverZero() {
+		return util.ErrInvalid.Errorf("supply of shares should be over zero")
+	}
+
+	if fact.share == fact.currency {
+		return util.ErrInvalid.Errorf("share currency is same with fee currency, %q", fact.share)
+	}
+
+	if fact.sender.Equal(fact.vault) {
+		return util.ErrInvalid.Errorf("vault is same with sender, %q", fact.vault)
+	}
+
+	return nil
+}
+
+func (fact FractionalizeFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact FractionalizeFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact FractionalizeFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.nft.Bytes(),
+		fact.vault.Bytes(),
+		fact.share.Bytes(),
+		fact.supply.Bytes(),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact FractionalizeFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact FractionalizeFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact FractionalizeFact) NFT() nft.NFTID {
+	return fact.nft
+}
+
+func (fact FractionalizeFact) Vault() base.Address {
+	return fact.vault
+}
+
+func (fact FractionalizeFact) Share() currency.CurrencyID {
+	return fact.share
+}
+
+func (fact FractionalizeFact) Supply() currency.Big {
+	return fact.supply
+}
+
+func (fact FractionalizeFact) Currency() currency.CurrencyID {
+	return fact.currency
+}
+
+func (fact FractionalizeFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 2)
+	as[0] = fact.sender
+	as[1] = fact.vault
+	return as, nil
+}
+
+type Fractionalize struct {
+	currency.BaseOperation
+}
+
+func NewFractionalize(fact FractionalizeFact) (Fractionalize, error) {
+	return Fractionalize{BaseOperation: currency.NewBaseOperation(FractionalizeHint, fact)}, nil
+}
+
+func (op *Fractionalize) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package collection
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+func (fact *FractionalizeFact) unmarshal(
+	enc encoder.Encoder,
+	sd string,
+	bn []byte,
+	vt string,
+	sh string,
+	sp currency.Big,
+	cid string,
+) error {
+	e := util.StringErrorFunc("failed to unmarshal FractionalizeFact")
+
+	fact.share = currency.CurrencyID(sh)
+	fact.currency = currency.CurrencyID(cid)
+
+	if sp.Int == nil {
+		sp = currency.ZeroBig
+	}
+	fact.supply = sp
+
+	sender, err := base.DecodeAddress(sd, enc)
+	if err != nil {
+		return e(err, "")
+	}
+	fact.sender = sender
+
+	vault, err := base.DecodeAddress(vt, enc)
+	if err != nil {
+		return e(err, "")
+	}
+	fact.vault = vault
+
+	if hinter, err := enc.Decode(bn); err != nil {
+		return e(err, "")
+	} else if n, ok := hinter.(nft.NFTID); !ok {
+		return e(util.ErrWrongType.Errorf("expected NFTID, not %T", hinter), "")
+	} else {
+		fact.nft = n
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package collection
+
+import (
+	"encoding/json"
+
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+)
+
+type FractionalizeFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender   base.Address        `json:"sender"`
+	NFT      nft.NFTID           `json:"nft"`
+	Vault    base.Address        `json:"vault"`
+	Share    currency.CurrencyID `json:"share"`
+	Supply   currency.Big        `json:"supply"`
+	Currency currency.CurrencyID `json:"currency"`
+}
+
+func (fact FractionalizeFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(FractionalizeFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		NFT:                   fact.nft,
+		Vault:                 fact.vault,
+		Share:                 fact.share,
+		Supply:                fact.supply,
+		Currency:              fact.currency,
+	})
+}
+
+type FractionalizeFactJSONUnmarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender   string          `json:"sender"`
+	NFT      json.RawMessage `json:"nft"`
+	Vault    string          `json:"vault"`
+	Share    string          `json:"share"`
+	Supply   currency.Big    `json:"supply"`
+	Currency string          `json:"currency"`
+}
+
+func (fact *FractionalizeFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of FractionalizeFact")
+
+	var u FractionalizeFactJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(u.BaseFactJSONUnmarshaler)
+
+	return fact.unmarshal(enc, u.Sender, u.NFT, u.Vault, u.Share, u.Supply, u.Currency)
+}
+
+type fractionalizeMarshaler struct {
+	currency.BaseOperationJSONMarshaler
+}
+
+func (op Fractionalize) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(fractionalizeMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *Fractionalize) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of Fractionalize")
+
+	var ubo currency.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e(err, "")
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,292 @@
+package collection
+
+import (
+	"context"
+	"sync"
+
+	extensioncurrency "github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var fractionalizeProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(FractionalizeProcessor)
+	},
+}
+
+func (Fractionalize) Process(
+	ctx context.Context, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type FractionalizeProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewFractionalizeProcessor() extensioncurrency.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringErrorFunc("failed to create new FractionalizeProcessor")
+
+		nopp := fractionalizeProcessorPool.Get()
+		opp, ok := nopp.(*FractionalizeProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected FractionalizeProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e(err, "")
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *FractionalizeProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	e := util.StringErrorFunc("failed to preprocess Fractionalize")
+
+	fact, ok := op.Fact().(FractionalizeFact)
+	if !ok {
+		return ctx, nil, e(nil, "expected FractionalizeFact, not %T", op.Fact())
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, nil, e(err, "")
+	}
+
+	if err := checkExistsState(currency.StateKeyAccount(fact.Sender()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("sender not found, %q: %w", fact.Sender(), err), nil
+	}
+
+	if err := checkNotExistsState(extensioncurrency.StateKeyContractAccount(fact.Sender()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("contract account cannot fractionalize nfts, %q: %w", fact.Sender(), err), nil
+	}
+
+	if err := checkFactSignsByState(fact.Sender(), op.Signs(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("invalid signing: %w", err), nil
+	}
+
+	nid := fact.NFT()
+
+	st, err := existsState(StateKeyCollectionDesign(nid.Collection()), "key of design", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("collection design not found, %q: %w", nid.Collection(), err), nil
+	}
+
+	design, err := StateCollectionDesignValue(st)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("collection design value not found, %q: %w", nid.Collection(), err), nil
+	}
+
+	if !design.Active() {
+		return nil, base.NewBaseOperationProcessReasonError("deactivated collection, %q", nid.Collection()), nil
+	}
+
+	st, err = existsState(StateKeyNFT(nid), "key of nft", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("nft not found, %q: %w", nid, err), nil
+	}
+
+	nv, err := StateNFTValue(st)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("nft value not found, %q: %w", nid, err), nil
+	}
+
+	if !nv.Active() {
+		return nil, base.NewBaseOperationProcessReasonError("burned nft, %q", nid), nil
+	}
+
+	if !nv.Owner().Equal(fact.Sender()) {
+		return nil, base.NewBaseOperationProcessReasonError("not owner of nft, %q, %q", nid, fact.Sender()), nil
+	}
+
+	if err := checkNotFrozen(nid, getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("nft not available, %q: %w", nid, err), nil
+	}
+
+	if err := checkNotInAuction(nid, getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("nft not available, %q: %w", nid, err), nil
+	}
+
+	if err := checkNotAttached(nid, getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("nft not available, %q: %w", nid, err), nil
+	}
+
+	if err := checkTransferable(nid.Collection(), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("nft not transferable, %q: %w", nid, err), nil
+	}
+
+	if err := checkSigned(nv, getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("nft not transferable, %q: %w", nid, err), nil
+	}
+
+	if err := checkChildrenMovable(nid, getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("nft not transferable, %q: %w", nid, err), nil
+	}
+
+	st, err = existsState(extensioncurrency.StateKeyContractAccount(fact.Vault()), "key of contract account", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("vault not found, %q: %w", fact.Vault(), err), nil
+	}
+
+	ca, err := extensioncurrency.StateContractAccountValue(st)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("vault value not found, %q: %w", fact.Vault(), err), nil
+	}
+
+	if !ca.IsActive() {
+		return nil, base.NewBaseOperationProcessReasonError("deactivated vault, %q", fact.Vault()), nil
+	}
+
+	if !ca.Owner().Equal(fact.Sender()) {
+		return nil, base.NewBaseOperationProcessReasonError("not owner of vault, %q", fact.Vault()), nil
+	}
+
+	st, err = existsState(extensioncurrency.StateKeyCurrencyDesign(fact.Share()), "key of currency design", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("share currency not registered, %q: %w", fact.Share(), err), nil
+	}
+
+	cd, err := extensioncurrency.StateCurrencyDesignValue(st)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("share currency design value not found, %q: %w", fact.Share(), err), nil
+	}
+
+	if cd.GenesisAccount() == nil || !cd.GenesisAccount().Equal(fact.Sender()) {
+		return nil, base.NewBaseOperationProcessReasonError("sender is not genesis account of share currency, %q, %q", fact.Sender(), fact.Share()), nil
+	}
+
+	if cd.Aggregate().Compare(fact.Supply()) != 0 {
+		return nil, base.NewBaseOperationProcessReasonError("supply not matched with aggregate of share currency, %q; %s != %s", fact.Share(), fact.Supply(), cd.Aggregate()), nil
+	}
+
+	st, err = existsState(currency.StateKeyBalance(fact.Sender(), fact.Share()), "key of share balance", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("share balance not found, %q: %w", fact.Sender(), err), nil
+	}
+
+	switch b, err := currency.StateBalanceValue(st); {
+	case err != nil:
+		return nil, base.NewBaseOperationProcessReasonError("failed to get share balance value, %q: %w", fact.Sender(), err), nil
+	case b.Big().Compare(fact.Supply()) < 0:
+		return nil, base.NewBaseOperationProcessReasonError("sender does not hold full supply of shares, %q; %s < %s", fact.Share(), b.Big(), fact.Supply()), nil
+	}
+
+	switch st, found, err := getStateFunc(StateKeyShareFraction(fact.Share())); {
+	case err != nil:
+		return nil, base.NewBaseOperationProcessReasonError("failed to get share fraction state, %q: %w", fact.Share(), err), nil
+	case found:
+		if fr, err := StateFractionValue(st); err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("share fraction value not found, %q: %w", fact.Share(), err), nil
+		} else if fr.Active() {
+			return nil, base.NewBaseOperationProcessReasonError("share currency already backs nft, %q, %q", fact.Share(), fr.NFT()), nil
+		}
+	}
+
+	switch st, found, err := getStateFunc(StateKeyFraction(nid)); {
+	case err != nil:
+		return nil, base.NewBaseOperationProcessReasonError("failed to get fraction state, %q: %w", nid, err), nil
+	case found:
+		if fr, err := StateFractionValue(st); err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("fraction value not found, %q: %w", nid, err), nil
+		} else if fr.Active() {
+			return nil, base.NewBaseOperationProcessReasonError("nft already fractionalized, %q", nid), nil
+		}
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *FractionalizeProcessor) Process(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	e := util.StringErrorFunc("failed to process Fractionalize")
+
+	fact, ok := op.Fact().(FractionalizeFact)
+	if !ok {
+		return nil, nil, e(nil, "expected FractionalizeFact, not %T", op.Fact())
+	}
+
+	nid := fact.NFT()
+
+	st, err := existsState(StateKeyNFT(nid), "key of nft", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("nft not found, %q: %w", nid, err), nil
+	}
+
+	nv, err := StateNFTValue(st)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("nft value not found, %q: %w", nid, err), nil
+	}
+
+	n := nft.NewNFT(nid, nv.Active(), fact.Vault(), nv.NFTHash(), nv.URI(), fact.Vault(), nv.Creators(), nv.Copyrighters(), fact.Vault(), 0, nv.Royalty(), nv.RoyaltyReceiver())
+	if err := n.IsValid(nil); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("invalid nft, %q: %w", nid, err), nil
+	}
+
+	fr := NewFraction(nid, fact.Vault(), fact.Share(), fact.Supply(), true)
+	if err := fr.IsValid(nil); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("invalid fraction, %q: %w", nid, err), nil
+	}
+
+	sts := []base.StateMergeValue{
+		NewNFTStateMergeValue(st.Key(), NewNFTStateValue(n)),
+		NewFractionStateMergeValue(StateKeyFraction(nid), NewFractionStateValue(fr)),
+		NewFractionStateMergeValue(StateKeyShareFraction(fact.Share()), NewFractionStateValue(fr)),
+	}
+
+	sv, err := cancelListing(nid, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to cancel listing, %q: %w", nid, err), nil
+	}
+	if sv != nil {
+		sts = append(sts, sv)
+	}
+
+	s, err := moveChildren(nid, fact.Vault(), getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to move children, %q: %w", nid, err), nil
+	}
+	sts = append(sts, s...)
+
+	currencyPolicy, err := existsCurrencyPolicy(fact.Currency(), getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("currency not found, %q: %w", fact.Currency(), err), nil
+	}
+
+	fee, err := currencyPolicy.Feeer().Fee(currency.ZeroBig)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to check fee of currency, %q: %w", fact.Currency(), err), nil
+	}
+
+	balances := newBalanceChanges(getStateFunc)
+	if err := balances.sub(fact.Sender(), currency.NewAmount(fee, fact.Currency())); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to check enough balance: %w", err), nil
+	}
+
+	sts = append(sts, balances.stateMergeValues()...)
+
+	return sts, nil, nil
+}
+
+func (opp *FractionalizeProcessor) Close() error {
+	fractionalizeProcessorPool.Put(opp)
+
+	return nil
+}
@@ -0,0 +1,132 @@
+package collection
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var MaxFreezeItems = 10
+
+var (
+	FreezeFactHint = hint.MustNewHint("mitum-nft-freeze-operation-fact-v0.0.1")
+	FreezeHint     = hint.MustNewHint("mitum-nft-freeze-operation-v0.0.1")
+)
+
+type FreezeFact struct {
+	base.BaseFact
+	sender base.Address
+	items  []FreezeItem
+}
+
+func NewFreezeFact(token []byte, sender base.Address, items []FreezeItem) FreezeFact {
+	bf := base.NewBaseFact(FreezeFactHint, token)
+	fact := FreezeFact{
+		BaseFact: bf,
+		sender:   sender,
+		items:    items,
+	}
+
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact FreezeFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := currency.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if l := len(fact.items); l < 1 {
+		return util.ErrInvalid.Errorf("empty items for FreezeFact")
+	} else if l > int(MaxFreezeItems) {
+		return util.ErrInvalid.Errorf("items over allowed, %d > %d", l, MaxFreezeItems)
+	}
+
+	if err := fact.sender.IsValid(nil); err != nil {
+		return err
+	}
+
+	founds := map[string]struct{}{}
+	for _, item := range fact.items {
+		if err := item.IsValid(nil); err != nil {
+			return err
+		}
+
+		n := item.NFT()
+		if err := n.IsValid(nil); err != nil {
+			return err
+		}
+
+		if _, found := founds[n.String()]; found {
+			return util.ErrInvalid.Errorf("duplicate nft found, %q", n)
+		}
+
+		founds[n.String()] = struct{}{}
+	}
+
+	return nil
+}
+
+func (fact FreezeFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact FreezeFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact FreezeFact) Bytes() []byte {
+	is := make([][]byte, len(fact.items))
+
+	for i, item := range fact.items {
+		is[i] = item.Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		util.ConcatBytesSlice(is...),
+	)
+}
+
+func (fact FreezeFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact FreezeFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact FreezeFact) Items() []FreezeItem {
+	return fact.items
+}
+
+func (fact FreezeFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 1)
+	as[0] = fact.sender
+
+	return as, nil
+}
+
+type Freeze struct {
+	currency.BaseOperation
+}
+
+func NewFreeze(fact FreezeFact) (Freeze, error) {
+	return Freeze{BaseOperation: currency.NewBaseOperation(FreezeHint, fact)}, nil
+}
+
+func (op *Freeze) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package collection
+
+import (
+	"github.com/ProtoconNet/mitum-nft/nft"
+
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+var FreezeItemHint = hint.MustNewHint("mitum-nft-freeze-item-v0.0.1")
+
+type FreezeItem struct {
+	hint.BaseHinter
+	nft      nft.NFTID
+	currency currency.CurrencyID
+}
+
+func NewFreezeItem(n nft.NFTID, currency currency.CurrencyID) FreezeItem {
+	return FreezeItem{
+		BaseHinter: hint.NewBaseHinter(FreezeItemHint),
+		nft:        n,
+		currency:   currency,
+	}
+}
+
+func (it FreezeItem) IsValid([]byte) error {
+	return util.CheckIsValiders(nil, false,
+		it.BaseHinter,
+		it.nft,
+		it.currency,
+	)
+}
+
+func (it FreezeItem) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		it.nft.Bytes(),
+		it.currency.Bytes(),
+	)
+}
+
+func (it FreezeItem) NFT() nft.NFTID {
+	return it.nft
+}
+
+func (it FreezeItem) Currency() currency.CurrencyID {
+	return it.currency
+}
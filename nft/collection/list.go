@@ -0,0 +1,132 @@
+package collection
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var MaxListItems = 10
+
+var (
+	ListFactHint = hint.MustNewHint("mitum-nft-list-operation-fact-v0.0.1")
+	ListHint     = hint.MustNewHint("mitum-nft-list-operation-v0.0.1")
+)
+
+type ListFact struct {
+	base.BaseFact
+	sender base.Address
+	items  []ListItem
+}
+
+func NewListFact(token []byte, sender base.Address, items []ListItem) ListFact {
+	bf := base.NewBaseFact(ListFactHint, token)
+	fact := ListFact{
+		BaseFact: bf,
+		sender:   sender,
+		items:    items,
+	}
+
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact ListFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := currency.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if l := len(fact.items); l < 1 {
+		return util.ErrInvalid.Errorf("empty items for ListFact")
+	} else if l > int(MaxListItems) {
+		return util.ErrInvalid.Errorf("items over allowed, %d > %d", l, MaxListItems)
+	}
+
+	if err := fact.sender.IsValid(nil); err != nil {
+		return err
+	}
+
+	founds := map[string]struct{}{}
+	for _, item := range fact.items {
+		if err := item.IsValid(nil); err != nil {
+			return err
+		}
+
+		n := item.NFT()
+		if err := n.IsValid(nil); err != nil {
+			return err
+		}
+
+		if _, found := founds[n.String()]; found {
+			return util.ErrInvalid.Errorf("duplicate nft found, %q", n)
+		}
+
+		founds[n.String()] = struct{}{}
+	}
+
+	return nil
+}
+
+func (fact ListFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact ListFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact ListFact) Bytes() []byte {
+	is := make([][]byte, len(fact.items))
+
+	for i, item := range fact.items {
+		is[i] = item.Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		util.ConcatBytesSlice(is...),
+	)
+}
+
+func (fact ListFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact ListFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact ListFact) Items() []ListItem {
+	return fact.items
+}
+
+func (fact ListFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 1)
+	as[0] = fact.sender
+
+	return as, nil
+}
+
+type List struct {
+	currency.BaseOperation
+}
+
+func NewList(fact ListFact) (List, error) {
+	return List{BaseOperation: currency.NewBaseOperation(ListHint, fact)}, nil
+}
+
+func (op *List) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
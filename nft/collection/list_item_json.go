@@ -0,0 +1,45 @@
+package collection
+
+import (
+	"encoding/json"
+
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+type ListItemJSONMarshaler struct {
+	hint.BaseHinter
+	NFT      nft.NFTID           `json:"nft"`
+	Price    currency.Amount     `json:"price"`
+	Currency currency.CurrencyID `json:"currency"`
+}
+
+func (it ListItem) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(ListItemJSONMarshaler{
+		BaseHinter: it.BaseHinter,
+		NFT:        it.nft,
+		Price:      it.price,
+		Currency:   it.currency,
+	})
+}
+
+type ListItemJSONUnmarshaler struct {
+	Hint     hint.Hint       `json:"_hint"`
+	NFT      json.RawMessage `json:"nft"`
+	Price    json.RawMessage `json:"price"`
+	Currency string          `json:"currency"`
+}
+
+func (it *ListItem) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of ListItem")
+
+	var u ListItemJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	return it.unmarshal(enc, u.Hint, u.NFT, u.Price, u.Currency)
+}
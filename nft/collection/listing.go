@@ -0,0 +1,79 @@
+package collection
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+var ListingHint = hint.MustNewHint("mitum-nft-listing-v0.0.1")
+
+type Listing struct {
+	hint.BaseHinter
+	nft    nft.NFTID
+	active bool
+	seller base.Address
+	price  currency.Amount
+}
+
+func NewListing(n nft.NFTID, active bool, seller base.Address, price currency.Amount) Listing {
+	return Listing{
+		BaseHinter: hint.NewBaseHinter(ListingHint),
+		nft:        n,
+		active:     active,
+		seller:     seller,
+		price:      price,
+	}
+}
+
+func (l Listing) IsValid([]byte) error {
+	if err := util.CheckIsValiders(nil, false,
+		l.BaseHinter,
+		l.nft,
+		l.seller,
+		l.price,
+	); err != nil {
+		return err
+	}
+
+	if !l.price.Big().OverZero() {
+		return util.ErrInvalid.Errorf("price must be over zero, %q", l.price.Big())
+	}
+
+	return nil
+}
+
+func (l Listing) Bytes() []byte {
+	ba := make([]byte, 1)
+
+	if l.active {
+		ba[0] = 1
+	} else {
+		ba[0] = 0
+	}
+
+	return util.ConcatBytesSlice(
+		l.nft.Bytes(),
+		ba,
+		l.seller.Bytes(),
+		l.price.Bytes(),
+	)
+}
+
+func (l Listing) NFT() nft.NFTID {
+	return l.nft
+}
+
+func (l Listing) Active() bool {
+	return l.active
+}
+
+func (l Listing) Seller() base.Address {
+	return l.seller
+}
+
+func (l Listing) Price() currency.Amount {
+	return l.price
+}
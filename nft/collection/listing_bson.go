@@ -0,0 +1,45 @@
+package collection
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	bsonenc "github.com/ProtoconNet/mitum-currency/v2/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (l Listing) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":  l.Hint().String(),
+			"nft":    l.nft,
+			"active": l.active,
+			"seller": l.seller,
+			"price":  l.price,
+		},
+	)
+}
+
+type ListingBSONUnmarshaler struct {
+	Hint   string   `bson:"_hint"`
+	NFT    bson.Raw `bson:"nft"`
+	Active bool     `bson:"active"`
+	Seller string   `bson:"seller"`
+	Price  bson.Raw `bson:"price"`
+}
+
+func (l *Listing) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode bson of Listing")
+
+	var u ListingBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e(err, "")
+	}
+
+	return l.unmarshal(enc, ht, u.NFT, u.Active, u.Seller, u.Price)
+}
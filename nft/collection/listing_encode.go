@@ -0,0 +1,48 @@
+package collection
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (l *Listing) unmarshal(
+	enc encoder.Encoder,
+	ht hint.Hint,
+	bn []byte,
+	ac bool,
+	sl string,
+	bp []byte,
+) error {
+	e := util.StringErrorFunc("failed to unmarshal Listing")
+
+	l.BaseHinter = hint.NewBaseHinter(ht)
+	l.active = ac
+
+	if hinter, err := enc.Decode(bn); err != nil {
+		return e(err, "")
+	} else if n, ok := hinter.(nft.NFTID); !ok {
+		return e(util.ErrWrongType.Errorf("expected NFTID, not %T", hinter), "")
+	} else {
+		l.nft = n
+	}
+
+	seller, err := base.DecodeAddress(sl, enc)
+	if err != nil {
+		return e(err, "")
+	}
+	l.seller = seller
+
+	if hinter, err := enc.Decode(bp); err != nil {
+		return e(err, "")
+	} else if am, ok := hinter.(currency.Amount); !ok {
+		return e(util.ErrWrongType.Errorf("expected Amount, not %T", hinter), "")
+	} else {
+		l.price = am
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package collection
+
+import (
+	"encoding/json"
+
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+type ListingJSONMarshaler struct {
+	hint.BaseHinter
+	NFT    nft.NFTID       `json:"nft"`
+	Active bool            `json:"active"`
+	Seller base.Address    `json:"seller"`
+	Price  currency.Amount `json:"price"`
+}
+
+func (l Listing) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(ListingJSONMarshaler{
+		BaseHinter: l.BaseHinter,
+		NFT:        l.nft,
+		Active:     l.active,
+		Seller:     l.seller,
+		Price:      l.price,
+	})
+}
+
+type ListingJSONUnmarshaler struct {
+	Hint   hint.Hint       `json:"_hint"`
+	NFT    json.RawMessage `json:"nft"`
+	Active bool            `json:"active"`
+	Seller string          `json:"seller"`
+	Price  json.RawMessage `json:"price"`
+}
+
+func (l *Listing) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of Listing")
+
+	var u ListingJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	return l.unmarshal(enc, u.Hint, u.NFT, u.Active, u.Seller, u.Price)
+}
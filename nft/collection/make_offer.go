@@ -0,0 +1,132 @@
+package collection
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var MaxMakeOfferItems = 10
+
+var (
+	MakeOfferFactHint = hint.MustNewHint("mitum-nft-make-offer-operation-fact-v0.0.1")
+	MakeOfferHint     = hint.MustNewHint("mitum-nft-make-offer-operation-v0.0.1")
+)
+
+type MakeOfferFact struct {
+	base.BaseFact
+	sender base.Address
+	items  []MakeOfferItem
+}
+
+func NewMakeOfferFact(token []byte, sender base.Address, items []MakeOfferItem) MakeOfferFact {
+	bf := base.NewBaseFact(MakeOfferFactHint, token)
+	fact := MakeOfferFact{
+		BaseFact: bf,
+		sender:   sender,
+		items:    items,
+	}
+
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact MakeOfferFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := currency.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if l := len(fact.items); l < 1 {
+		return util.ErrInvalid.Errorf("empty items for MakeOfferFact")
+	} else if l > int(MaxMakeOfferItems) {
+		return util.ErrInvalid.Errorf("items over allowed, %d > %d", l, MaxMakeOfferItems)
+	}
+
+	if err := fact.sender.IsValid(nil); err != nil {
+		return err
+	}
+
+	founds := map[string]struct{}{}
+	for _, item := range fact.items {
+		if err := item.IsValid(nil); err != nil {
+			return err
+		}
+
+		n := item.NFT()
+		if err := n.IsValid(nil); err != nil {
+			return err
+		}
+
+		if _, found := founds[n.String()]; found {
+			return util.ErrInvalid.Errorf("duplicate nft found, %q", n)
+		}
+
+		founds[n.String()] = struct{}{}
+	}
+
+	return nil
+}
+
+func (fact MakeOfferFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact MakeOfferFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact MakeOfferFact) Bytes() []byte {
+	is := make([][]byte, len(fact.items))
+
+	for i, item := range fact.items {
+		is[i] = item.Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		util.ConcatBytesSlice(is...),
+	)
+}
+
+func (fact MakeOfferFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact MakeOfferFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact MakeOfferFact) Items() []MakeOfferItem {
+	return fact.items
+}
+
+func (fact MakeOfferFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 1)
+	as[0] = fact.sender
+
+	return as, nil
+}
+
+type MakeOffer struct {
+	currency.BaseOperation
+}
+
+func NewMakeOffer(fact MakeOfferFact) (MakeOffer, error) {
+	return MakeOffer{BaseOperation: currency.NewBaseOperation(MakeOfferHint, fact)}, nil
+}
+
+func (op *MakeOffer) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package collection
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+var MakeOfferItemHint = hint.MustNewHint("mitum-nft-make-offer-item-v0.0.1")
+
+type MakeOfferItem struct {
+	hint.BaseHinter
+	nft      nft.NFTID
+	amount   currency.Amount
+	currency currency.CurrencyID
+}
+
+func NewMakeOfferItem(n nft.NFTID, amount currency.Amount, currency currency.CurrencyID) MakeOfferItem {
+	return MakeOfferItem{
+		BaseHinter: hint.NewBaseHinter(MakeOfferItemHint),
+		nft:        n,
+		amount:     amount,
+		currency:   currency,
+	}
+}
+
+func (it MakeOfferItem) IsValid([]byte) error {
+	if err := util.CheckIsValiders(nil, false, it.BaseHinter, it.nft, it.amount, it.currency); err != nil {
+		return err
+	}
+
+	if !it.amount.Big().OverZero() {
+		return util.ErrInvalid.Errorf("amount must be over zero, %q", it.amount.Big())
+	}
+
+	return nil
+}
+
+func (it MakeOfferItem) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		it.nft.Bytes(),
+		it.amount.Bytes(),
+		it.currency.Bytes(),
+	)
+}
+
+func (it MakeOfferItem) NFT() nft.NFTID {
+	return it.nft
+}
+
+func (it MakeOfferItem) Amount() currency.Amount {
+	return it.amount
+}
+
+func (it MakeOfferItem) Currency() currency.CurrencyID {
+	return it.currency
+}
@@ -0,0 +1,290 @@
+package collection
+
+import (
+	"context"
+	"sync"
+
+	extensioncurrency "github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var makeOfferItemProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(MakeOfferItemProcessor)
+	},
+}
+
+var makeOfferProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(MakeOfferProcessor)
+	},
+}
+
+func (MakeOffer) Process(
+	ctx context.Context, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type MakeOfferItemProcessor struct {
+	h        util.Hash
+	sender   base.Address
+	item     MakeOfferItem
+	balances *balanceChanges
+}
+
+func (ipp *MakeOfferItemProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) error {
+	nid := ipp.item.NFT()
+
+	st, err := existsState(StateKeyCollectionDesign(nid.Collection()), "key of design", getStateFunc)
+	if err != nil {
+		return errors.Errorf("collection design not found, %q: %w", nid.Collection(), err)
+	}
+
+	design, err := StateCollectionDesignValue(st)
+	if err != nil {
+		return errors.Errorf("collection design value not found, %q: %w", nid.Collection(), err)
+	}
+
+	if !design.Active() {
+		return errors.Errorf("deactivated collection, %q", nid.Collection())
+	}
+
+	st, err = existsState(extensioncurrency.StateKeyContractAccount(design.Parent()), "contract account", getStateFunc)
+	if err != nil {
+		return errors.Errorf("parent not found, %q: %w", design.Parent(), err)
+	}
+
+	ca, err := extensioncurrency.StateContractAccountValue(st)
+	if err != nil {
+		return errors.Errorf("contract account value not found, %q: %w", design.Parent(), err)
+	}
+
+	if !ca.IsActive() {
+		return errors.Errorf("deactivated contract account, %q", design.Parent())
+	}
+
+	st, err = existsState(StateKeyNFT(nid), "key of nft", getStateFunc)
+	if err != nil {
+		return errors.Errorf("nft not found, %q: %w", nid, err)
+	}
+
+	nv, err := StateNFTValue(st)
+	if err != nil {
+		return errors.Errorf("nft value not found, %q: %w", nid, err)
+	}
+
+	if !nv.Active() {
+		return errors.Errorf("burned nft, %q", nid)
+	}
+
+	if err := checkNotFrozen(nid, getStateFunc); err != nil {
+		return errors.Errorf("nft not available, %q: %w", nid, err)
+	}
+
+	if nv.Owner().Equal(ipp.sender) {
+		return errors.Errorf("sender already owns nft, %q", nid)
+	}
+
+	if err := checkTransferable(nid.Collection(), getStateFunc); err != nil {
+		return errors.Errorf("nft not transferable, %q: %w", nid, err)
+	}
+
+	if err := checkSigned(nv, getStateFunc); err != nil {
+		return errors.Errorf("nft not transferable, %q: %w", nid, err)
+	}
+
+	am := ipp.item.Amount()
+	if _, err := existsCurrencyPolicy(am.Currency(), getStateFunc); err != nil {
+		return errors.Errorf("currency of offer not found, %q: %w", am.Currency(), err)
+	}
+
+	switch st, found, err := getStateFunc(StateKeyOffer(nid, ipp.sender)); {
+	case err != nil:
+		return errors.Errorf("failed to get offer, %q: %w", nid, err)
+	case found:
+		o, err := StateOfferValue(st)
+		if err != nil {
+			return errors.Errorf("offer value not found, %q: %w", nid, err)
+		}
+
+		if o.Active() {
+			return errors.Errorf("offer already exists, %q", nid)
+		}
+	}
+
+	return nil
+}
+
+func (ipp *MakeOfferItemProcessor) Process(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, error) {
+	nid := ipp.item.NFT()
+
+	if err := ipp.balances.sub(ipp.sender, ipp.item.Amount()); err != nil {
+		return nil, errors.Errorf("failed to lock offer amount, %q: %w", nid, err)
+	}
+
+	o := NewOffer(nid, true, ipp.sender, ipp.item.Amount())
+	if err := o.IsValid(nil); err != nil {
+		return nil, errors.Errorf("invalid offer, %q: %w", nid, err)
+	}
+
+	return []base.StateMergeValue{NewOfferStateMergeValue(StateKeyOffer(nid, ipp.sender), NewOfferStateValue(o))}, nil
+}
+
+func (ipp *MakeOfferItemProcessor) Close() error {
+	ipp.h = nil
+	ipp.sender = nil
+	ipp.item = MakeOfferItem{}
+	ipp.balances = nil
+
+	makeOfferItemProcessorPool.Put(ipp)
+
+	return nil
+}
+
+type MakeOfferProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewMakeOfferProcessor() extensioncurrency.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringErrorFunc("failed to create new MakeOfferProcessor")
+
+		nopp := makeOfferProcessorPool.Get()
+		opp, ok := nopp.(*MakeOfferProcessor)
+		if !ok {
+			return nil, e(nil, "expected MakeOfferProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e(err, "")
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *MakeOfferProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	e := util.StringErrorFunc("failed to preprocess MakeOffer")
+
+	fact, ok := op.Fact().(MakeOfferFact)
+	if !ok {
+		return ctx, nil, e(nil, "expected MakeOfferFact, not %T", op.Fact())
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, nil, e(err, "")
+	}
+
+	if err := checkExistsState(currency.StateKeyAccount(fact.Sender()), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("sender not found, %q: %w", fact.Sender(), err), nil
+	}
+
+	if err := checkNotExistsState(extensioncurrency.StateKeyContractAccount(fact.Sender()), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("contract account cannot make offers, %q", fact.Sender()), nil
+	}
+
+	if err := checkFactSignsByState(fact.sender, op.Signs(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("invalid signing: %w", err), nil
+	}
+
+	for _, item := range fact.Items() {
+		ip := makeOfferItemProcessorPool.Get()
+		ipc, ok := ip.(*MakeOfferItemProcessor)
+		if !ok {
+			return nil, nil, e(nil, "expected MakeOfferItemProcessor, not %T", ip)
+		}
+
+		ipc.h = op.Hash()
+		ipc.sender = fact.Sender()
+		ipc.item = item
+		ipc.balances = nil
+
+		if err := ipc.PreProcess(ctx, op, getStateFunc); err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("fail to preprocess MakeOfferItem: %w", err), nil
+		}
+
+		ipc.Close()
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *MakeOfferProcessor) Process( // nolint:dupl
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	e := util.StringErrorFunc("failed to process MakeOffer")
+
+	fact, ok := op.Fact().(MakeOfferFact)
+	if !ok {
+		return nil, nil, e(nil, "expected MakeOfferFact, not %T", op.Fact())
+	}
+
+	balances := newBalanceChanges(getStateFunc)
+
+	var sts []base.StateMergeValue // nolint:prealloc
+	for _, item := range fact.Items() {
+		ip := makeOfferItemProcessorPool.Get()
+		ipc, ok := ip.(*MakeOfferItemProcessor)
+		if !ok {
+			return nil, nil, e(nil, "expected MakeOfferItemProcessor, not %T", ip)
+		}
+
+		ipc.h = op.Hash()
+		ipc.sender = fact.Sender()
+		ipc.item = item
+		ipc.balances = balances
+
+		s, err := ipc.Process(ctx, op, getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("failed to process MakeOfferItem: %w", err), nil
+		}
+		sts = append(sts, s...)
+
+		ipc.Close()
+	}
+
+	fitems := fact.Items()
+	items := make([]CollectionItem, len(fitems))
+	for i := range fact.Items() {
+		items[i] = fitems[i]
+	}
+
+	required, err := CalculateCollectionItemsFee(getStateFunc, items)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to calculate fee: %w", err), nil
+	}
+
+	if err := balances.subFee(fact.Sender(), required); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to check enough balance: %w", err), nil
+	}
+
+	sts = append(sts, balances.stateMergeValues()...)
+
+	return sts, nil, nil
+}
+
+func (opp *MakeOfferProcessor) Close() error {
+	makeOfferProcessorPool.Put(opp)
+
+	return nil
+}
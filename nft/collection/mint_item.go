@@ -18,15 +18,19 @@ type MintForm struct {
 	uri          nft.URI
 	creators     nft.Signers
 	copyrighters nft.Signers
+	royalty      nft.PaymentParameter
+	receiver     base.Address
 }
 
-func NewMintForm(hash nft.NFTHash, uri nft.URI, creators nft.Signers, copyrighters nft.Signers) MintForm {
+func NewMintForm(hash nft.NFTHash, uri nft.URI, creators nft.Signers, copyrighters nft.Signers, royalty nft.PaymentParameter, receiver base.Address) MintForm {
 	return MintForm{
 		BaseHinter:   hint.NewBaseHinter(MintFormHint),
 		hash:         hash,
 		uri:          uri,
 		creators:     creators,
 		copyrighters: copyrighters,
+		royalty:      royalty,
+		receiver:     receiver,
 	}
 }
 
@@ -37,6 +41,7 @@ func (form MintForm) IsValid([]byte) error {
 		form.uri,
 		form.creators,
 		form.copyrighters,
+		form.royalty,
 	); err != nil {
 		return err
 	}
@@ -45,15 +50,30 @@ func (form MintForm) IsValid([]byte) error {
 		return util.ErrInvalid.Errorf("empty uri")
 	}
 
+	if form.receiver != nil {
+		if err := form.receiver.IsValid(nil); err != nil {
+			return err
+		}
+	} else if form.royalty != 0 {
+		return util.ErrInvalid.Errorf("royalty set without receiver")
+	}
+
 	return nil
 }
 
 func (form MintForm) Bytes() []byte {
+	var rc []byte
+	if form.receiver != nil {
+		rc = form.receiver.Bytes()
+	}
+
 	return util.ConcatBytesSlice(
 		form.hash.Bytes(),
 		form.uri.Bytes(),
 		form.creators.Bytes(),
 		form.copyrighters.Bytes(),
+		form.royalty.Bytes(),
+		rc,
 	)
 }
 
@@ -73,10 +93,22 @@ func (form MintForm) Copyrighters() nft.Signers {
 	return form.copyrighters
 }
 
+func (form MintForm) Royalty() nft.PaymentParameter {
+	return form.royalty
+}
+
+// RoyaltyReceiver is nil when the nft follows the royalty of its collection.
+func (form MintForm) RoyaltyReceiver() base.Address {
+	return form.receiver
+}
+
 func (form MintForm) Addresses() ([]base.Address, error) {
 	as := []base.Address{}
 	as = append(as, form.creators.Addresses()...)
 	as = append(as, form.copyrighters.Addresses()...)
+	if form.receiver != nil {
+		as = append(as, form.receiver)
+	}
 
 	return as, nil
 }
@@ -11,11 +11,13 @@ import (
 func (form MintForm) MarshalBSON() ([]byte, error) {
 	return bsonenc.Marshal(
 		bson.M{
-			"_hint":        form.Hint().String(),
-			"hash":         form.hash,
-			"uri":          form.uri,
-			"creators":     form.creators,
-			"copyrighters": form.copyrighters,
+			"_hint":            form.Hint().String(),
+			"hash":             form.hash,
+			"uri":              form.uri,
+			"creators":         form.creators,
+			"copyrighters":     form.copyrighters,
+			"royalty":          form.royalty,
+			"royalty_receiver": form.receiver,
 		},
 	)
 }
@@ -26,6 +28,8 @@ type MintFormBSONUnmarshaler struct {
 	URI          string   `bson:"uri"`
 	Creators     bson.Raw `bson:"creators"`
 	Copyrighters bson.Raw `bson:"copyrighters"`
+	Royalty      uint     `bson:"royalty"`
+	Receiver     string   `bson:"royalty_receiver"`
 }
 
 func (form *MintForm) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -41,7 +45,7 @@ func (form *MintForm) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
 		return e(err, "")
 	}
 
-	return form.unmarshal(enc, ht, u.Hash, u.URI, u.Creators, u.Copyrighters)
+	return form.unmarshal(enc, ht, u.Hash, u.URI, u.Creators, u.Copyrighters, u.Royalty, u.Receiver)
 }
 
 func (it MintItem) MarshalBSON() ([]byte, error) {
@@ -5,6 +5,7 @@ import (
 	"github.com/ProtoconNet/mitum-nft/nft"
 
 	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
 	"github.com/ProtoconNet/mitum2/util/encoder"
 	"github.com/ProtoconNet/mitum2/util/hint"
@@ -17,12 +18,23 @@ func (form *MintForm) unmarshal(
 	uri string,
 	bcrs []byte,
 	bcps []byte,
+	ry uint,
+	rc string,
 ) error {
 	e := util.StringErrorFunc("failed to unmarshal MintForm")
 
 	form.BaseHinter = hint.NewBaseHinter(ht)
 	form.hash = nft.NFTHash(hs)
 	form.uri = nft.URI(uri)
+	form.royalty = nft.PaymentParameter(ry)
+
+	if len(rc) > 0 {
+		receiver, err := base.DecodeAddress(rc, enc)
+		if err != nil {
+			return e(err, "")
+		}
+		form.receiver = receiver
+	}
 
 	if hinter, err := enc.Decode(bcrs); err != nil {
 		return e(err, "")
@@ -7,6 +7,7 @@ import (
 	"github.com/ProtoconNet/mitum-nft/nft"
 
 	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
 	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
 	"github.com/ProtoconNet/mitum2/util/hint"
@@ -14,10 +15,12 @@ import (
 
 type MintFormJSONMarshaler struct {
 	hint.BaseHinter
-	Hash         nft.NFTHash `json:"hash"`
-	URI          nft.URI     `json:"uri"`
-	Creators     nft.Signers `json:"creators"`
-	Copyrighters nft.Signers `json:"copyrighters"`
+	Hash         nft.NFTHash          `json:"hash"`
+	URI          nft.URI              `json:"uri"`
+	Creators     nft.Signers          `json:"creators"`
+	Copyrighters nft.Signers          `json:"copyrighters"`
+	Royalty      nft.PaymentParameter `json:"royalty"`
+	Receiver     base.Address         `json:"royalty_receiver"`
 }
 
 func (form MintForm) MarshalJSON() ([]byte, error) {
@@ -27,6 +30,8 @@ func (form MintForm) MarshalJSON() ([]byte, error) {
 		URI:          form.uri,
 		Creators:     form.creators,
 		Copyrighters: form.copyrighters,
+		Royalty:      form.royalty,
+		Receiver:     form.receiver,
 	})
 }
 
@@ -36,6 +41,8 @@ type MintFormJSONUnmarshaler struct {
 	URI          string          `json:"uri"`
 	Creators     json.RawMessage `json:"creators"`
 	Copyrighters json.RawMessage `json:"copyrighters"`
+	Royalty      uint            `json:"royalty"`
+	Receiver     string          `json:"royalty_receiver"`
 }
 
 func (form *MintForm) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
@@ -46,7 +53,7 @@ func (form *MintForm) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
 		return e(err, "")
 	}
 
-	return form.unmarshal(enc, u.Hint, u.Hash, u.URI, u.Creators, u.Copyrighters)
+	return form.unmarshal(enc, u.Hint, u.Hash, u.URI, u.Creators, u.Copyrighters, u.Royalty, u.Receiver)
 }
 
 type MintItemJSONMarshaler struct {
@@ -82,6 +82,12 @@ func (ipp *MintItemProcessor) PreProcess(
 		}
 	}
 
+	if rc := form.RoyaltyReceiver(); rc != nil {
+		if err := checkExistsState(currency.StateKeyAccount(rc), getStateFunc); err != nil {
+			return errors.Errorf("royalty receiver not found, %q: %w", rc, err)
+		}
+	}
+
 	return nil
 }
 
@@ -97,7 +103,7 @@ func (ipp *MintItemProcessor) Process(
 		return nil, errors.Errorf("invalid nft id, %q: %w", id, err)
 	}
 
-	n := nft.NewNFT(id, true, ipp.sender, form.NFTHash(), form.URI(), ipp.sender, form.Creators(), form.Copyrighters())
+	n := nft.NewNFT(id, true, ipp.sender, form.NFTHash(), form.URI(), ipp.sender, form.Creators(), form.Copyrighters(), ipp.sender, 0, form.Royalty(), form.RoyaltyReceiver())
 	if err := n.IsValid(nil); err != nil {
 		return nil, errors.Errorf("invalid nft, %q: %w", id, err)
 	}
@@ -181,6 +187,9 @@ func (opp *MintProcessor) PreProcess(
 	}
 
 	idxes := map[extensioncurrency.ContractID]uint64{}
+	supplies := map[extensioncurrency.ContractID]uint64{}
+	mints := map[extensioncurrency.ContractID]uint64{}
+	quotas := map[extensioncurrency.ContractID]uint64{}
 	for _, item := range fact.Items() {
 		collection := item.Collection()
 
@@ -204,9 +213,8 @@ func (opp *MintProcessor) PreProcess(
 				return nil, base.NewBaseOperationProcessReasonError("expected CollectionPolicy, not %T", design.Policy()), nil
 			}
 
-			whites := policy.Whites()
-			if len(whites) == 0 {
-				return nil, base.NewBaseOperationProcessReasonError("empty whitelist, %q", collection), nil
+			if h := opp.Height(); (policy.MintStart() > 0 && h < policy.MintStart()) || (policy.MintEnd() > 0 && h > policy.MintEnd()) {
+				return nil, base.NewBaseOperationProcessReasonError("out of mint window, %q; %d not in [%d, %d]", collection, h, policy.MintStart(), policy.MintEnd()), nil
 			}
 
 			st, err = existsState(extensioncurrency.StateKeyContractAccount(design.Parent()), "key of contract account", getStateFunc)
@@ -223,13 +231,23 @@ func (opp *MintProcessor) PreProcess(
 				return nil, base.NewBaseOperationProcessReasonError("deactivated parent account, %q", design.Parent()), nil
 			}
 
-			for i := range whites {
-				if whites[i].Equal(fact.Sender()) {
-					break
-				}
-				if i == len(whites)-1 {
-					return nil, base.NewBaseOperationProcessReasonError("sender not in whitelist, %q", fact.Sender()), nil
+			white, isWhite, err := whiteOf(collection, fact.Sender(), getStateFunc)
+			if err != nil {
+				return nil, base.NewBaseOperationProcessReasonError("failed to get white, %q: %w", collection, err), nil
+			}
+
+			if !isWhite && !policy.PublicMint() {
+				return nil, base.NewBaseOperationProcessReasonError("sender not in whitelist, %q", fact.Sender()), nil
+			}
+
+			if isWhite && white.Quota() > 0 {
+				minted, err := mintCount(collection, fact.Sender(), getStateFunc)
+				if err != nil {
+					return nil, base.NewBaseOperationProcessReasonError("failed to get mint count, %q: %w", collection, err), nil
 				}
+
+				mints[collection] = minted
+				quotas[collection] = white.Quota()
 			}
 
 			st, err = existsState(StateKeyCollectionLastNFTIndex(collection), "key of collection index", getStateFunc)
@@ -243,6 +261,7 @@ func (opp *MintProcessor) PreProcess(
 			}
 
 			idxes[collection] = idx
+			supplies[collection] = policy.MaxSupply()
 		}
 	}
 
@@ -255,6 +274,17 @@ func (opp *MintProcessor) PreProcess(
 
 		idxes[item.Collection()] += 1
 
+		if ms := supplies[item.Collection()]; ms > 0 && idxes[item.Collection()] > ms {
+			return nil, base.NewBaseOperationProcessReasonError("max supply of collection reached, %q; %d", item.Collection(), ms), nil
+		}
+
+		if qt, found := quotas[item.Collection()]; found {
+			mints[item.Collection()] += 1
+			if mints[item.Collection()] > qt {
+				return nil, base.NewBaseOperationProcessReasonError("mint quota of sender exceeded, %q, %q; %d", item.Collection(), fact.Sender(), qt), nil
+			}
+		}
+
 		ipc.h = op.Hash()
 		ipc.sender = fact.Sender()
 		ipc.item = item
@@ -284,12 +314,46 @@ func (opp *MintProcessor) Process( // nolint:dupl
 
 	idxes := map[extensioncurrency.ContractID]uint64{}
 	boxes := map[extensioncurrency.ContractID]*NFTBox{}
+	payees := map[extensioncurrency.ContractID]base.Address{}
+	prices := map[extensioncurrency.ContractID]currency.Amount{}
+	mints := map[extensioncurrency.ContractID]uint64{}
 
 	for _, item := range fact.items {
 		collection := item.Collection()
 
 		if _, found := idxes[collection]; !found {
-			st, err := existsState(StateKeyCollectionLastNFTIndex(collection), "key of collection index", getStateFunc)
+			st, err := existsState(StateKeyCollectionDesign(collection), "key of collection design", getStateFunc)
+			if err != nil {
+				return nil, base.NewBaseOperationProcessReasonError("collection design not found, %q: %w", collection, err), nil
+			}
+
+			design, err := StateCollectionDesignValue(st)
+			if err != nil {
+				return nil, base.NewBaseOperationProcessReasonError("collection design value not found, %q: %w", collection, err), nil
+			}
+
+			policy, ok := design.Policy().(CollectionPolicy)
+			if !ok {
+				return nil, base.NewBaseOperationProcessReasonError("expected CollectionPolicy, not %T", design.Policy()), nil
+			}
+
+			_, isWhite, err := whiteOf(collection, fact.Sender(), getStateFunc)
+			if err != nil {
+				return nil, base.NewBaseOperationProcessReasonError("failed to get white, %q: %w", collection, err), nil
+			}
+
+			if policy.PublicMint() && !isWhite {
+				payees[collection] = design.Parent()
+				prices[collection] = policy.MintPrice()
+			}
+
+			minted, err := mintCount(collection, fact.Sender(), getStateFunc)
+			if err != nil {
+				return nil, base.NewBaseOperationProcessReasonError("failed to get mint count, %q: %w", collection, err), nil
+			}
+			mints[collection] = minted
+
+			st, err = existsState(StateKeyCollectionLastNFTIndex(collection), "key of collection index", getStateFunc)
 			if err != nil {
 				return nil, base.NewBaseOperationProcessReasonError("collection last index not found, %q: %w", collection, err), nil
 			}
@@ -324,6 +388,8 @@ func (opp *MintProcessor) Process( // nolint:dupl
 
 	var sts []base.StateMergeValue // nolint:prealloc
 
+	balances := newBalanceChanges(getStateFunc)
+
 	ipcs := make([]*MintItemProcessor, len(fact.Items()))
 	for i, item := range fact.Items() {
 		ip := mintItemProcessorPool.Get()
@@ -333,6 +399,7 @@ func (opp *MintProcessor) Process( // nolint:dupl
 		}
 
 		idxes[item.Collection()] += 1
+		mints[item.Collection()] += 1
 
 		ipc.h = op.Hash()
 		ipc.sender = fact.Sender()
@@ -347,6 +414,15 @@ func (opp *MintProcessor) Process( // nolint:dupl
 		sts = append(sts, s...)
 
 		ipcs[i] = ipc
+
+		if price, found := prices[item.Collection()]; found {
+			if err := balances.sub(fact.Sender(), price); err != nil {
+				return nil, base.NewBaseOperationProcessReasonError("failed to pay mint price, %q: %w", item.Collection(), err), nil
+			}
+			if err := balances.add(payees[item.Collection()], price); err != nil {
+				return nil, base.NewBaseOperationProcessReasonError("failed to pay mint price, %q: %w", item.Collection(), err), nil
+			}
+		}
 	}
 
 	for c, idx := range idxes {
@@ -359,12 +435,20 @@ func (opp *MintProcessor) Process( // nolint:dupl
 		sts = append(sts, bv)
 	}
 
+	for c, count := range mints {
+		mv := NewMintCountStateMergeValue(StateKeyMintCount(c, fact.Sender()), NewMintCountStateValue(c, fact.Sender(), count))
+		sts = append(sts, mv)
+	}
+
 	for _, ipc := range ipcs {
 		ipc.Close()
 	}
 
 	idxes = nil
 	boxes = nil
+	payees = nil
+	prices = nil
+	mints = nil
 
 	fitems := fact.Items()
 	items := make([]CollectionItem, len(fitems))
@@ -376,19 +460,12 @@ func (opp *MintProcessor) Process( // nolint:dupl
 	if err != nil {
 		return nil, base.NewBaseOperationProcessReasonError("failed to calculate fee: %w", err), nil
 	}
-	sb, err := currency.CheckEnoughBalance(fact.sender, required, getStateFunc)
-	if err != nil {
+
+	if err := balances.subFee(fact.Sender(), required); err != nil {
 		return nil, base.NewBaseOperationProcessReasonError("failed to check enough balance: %w", err), nil
 	}
 
-	for i := range sb {
-		v, ok := sb[i].Value().(currency.BalanceStateValue)
-		if !ok {
-			return nil, nil, e(nil, "expected BalanceStateValue, not %T", sb[i].Value())
-		}
-		stv := currency.NewBalanceStateValue(v.Amount.WithBig(v.Amount.Big().Sub(required[i][0])))
-		sts = append(sts, currency.NewBalanceStateMergeValue(sb[i].Key(), stv))
-	}
+	sts = append(sts, balances.stateMergeValues()...)
 
 	return sts, nil, nil
 }
@@ -0,0 +1,73 @@
+package collection
+
+import (
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+var (
+	MaxNFTDepth    = 5
+	MaxNFTChildren = 10
+)
+
+var NFTLinkHint = hint.MustNewHint("mitum-nft-nft-link-v0.0.1")
+
+type NFTLink struct {
+	hint.BaseHinter
+	child    nft.NFTID
+	parent   nft.NFTID
+	attached bool
+}
+
+func NewNFTLink(child nft.NFTID, parent nft.NFTID, attached bool) NFTLink {
+	return NFTLink{
+		BaseHinter: hint.NewBaseHinter(NFTLinkHint),
+		child:      child,
+		parent:     parent,
+		attached:   attached,
+	}
+}
+
+func (l NFTLink) IsValid([]byte) error {
+	if err := util.CheckIsValiders(nil, false,
+		l.BaseHinter,
+		l.child,
+		l.parent,
+	); err != nil {
+		return err
+	}
+
+	if l.child.Equal(l.parent) {
+		return util.ErrInvalid.Errorf("nft cannot be a child of itself, %q", l.child)
+	}
+
+	return nil
+}
+
+func (l NFTLink) Bytes() []byte {
+	ac := make([]byte, 1)
+	if l.attached {
+		ac[0] = 1
+	} else {
+		ac[0] = 0
+	}
+
+	return util.ConcatBytesSlice(
+		l.child.Bytes(),
+		l.parent.Bytes(),
+		ac,
+	)
+}
+
+func (l NFTLink) Child() nft.NFTID {
+	return l.child
+}
+
+func (l NFTLink) Parent() nft.NFTID {
+	return l.parent
+}
+
+func (l NFTLink) Attached() bool {
+	return l.attached
+}
@@ -0,0 +1,40 @@
+package collection
+
+import (
+	bsonenc "github.com/ProtoconNet/mitum-currency/v2/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (l NFTLink) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(bson.M{
+		"_hint":    l.Hint().String(),
+		"child":    l.child,
+		"parent":   l.parent,
+		"attached": l.attached,
+	})
+}
+
+type NFTLinkBSONUnmarshaler struct {
+	Hint     string   `bson:"_hint"`
+	Child    bson.Raw `bson:"child"`
+	Parent   bson.Raw `bson:"parent"`
+	Attached bool     `bson:"attached"`
+}
+
+func (l *NFTLink) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode bson of NFTLink")
+
+	var u NFTLinkBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e(err, "")
+	}
+
+	return l.unmarshal(enc, ht, u.Child, u.Parent, u.Attached)
+}
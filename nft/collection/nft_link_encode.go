@@ -0,0 +1,39 @@
+package collection
+
+import (
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (l *NFTLink) unmarshal(
+	enc encoder.Encoder,
+	ht hint.Hint,
+	bc []byte,
+	bp []byte,
+	ac bool,
+) error {
+	e := util.StringErrorFunc("failed to unmarshal NFTLink")
+
+	l.BaseHinter = hint.NewBaseHinter(ht)
+	l.attached = ac
+
+	if hinter, err := enc.Decode(bc); err != nil {
+		return e(err, "")
+	} else if n, ok := hinter.(nft.NFTID); !ok {
+		return e(util.ErrWrongType.Errorf("expected NFTID, not %T", hinter), "")
+	} else {
+		l.child = n
+	}
+
+	if hinter, err := enc.Decode(bp); err != nil {
+		return e(err, "")
+	} else if n, ok := hinter.(nft.NFTID); !ok {
+		return e(util.ErrWrongType.Errorf("expected NFTID, not %T", hinter), "")
+	} else {
+		l.parent = n
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package collection
+
+import (
+	"encoding/json"
+
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+type NFTLinkJSONMarshaler struct {
+	hint.BaseHinter
+	Child    nft.NFTID `json:"child"`
+	Parent   nft.NFTID `json:"parent"`
+	Attached bool      `json:"attached"`
+}
+
+func (l NFTLink) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(NFTLinkJSONMarshaler{
+		BaseHinter: l.BaseHinter,
+		Child:      l.child,
+		Parent:     l.parent,
+		Attached:   l.attached,
+	})
+}
+
+type NFTLinkJSONUnmarshaler struct {
+	Hint     hint.Hint       `json:"_hint"`
+	Child    json.RawMessage `json:"child"`
+	Parent   json.RawMessage `json:"parent"`
+	Attached bool            `json:"attached"`
+}
+
+func (l *NFTLink) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of NFTLink")
+
+	var u NFTLinkJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	return l.unmarshal(enc, u.Hint, u.Child, u.Parent, u.Attached)
+}
@@ -0,0 +1,139 @@
+package collection
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	NFTSaleFactHint = hint.MustNewHint("mitum-nft-sale-operation-fact-v0.0.1")
+	NFTSaleHint     = hint.MustNewHint("mitum-nft-sale-operation-v0.0.1")
+)
+
+var MaxNFTSaleItems = 10
+
+type NFTSaleFact struct {
+	base.BaseFact
+	sender base.Address
+	buyer  base.Address
+	items  []NFTSaleItem
+}
+
+func NewNFTSaleFact(token []byte, sender, buyer base.Address, items []NFTSaleItem) NFTSaleFact {
+	bf := base.NewBaseFact(NFTSaleFactHint, token)
+
+	fact := NFTSaleFact{
+		BaseFact: bf,
+		sender:   sender,
+		buyer:    buyer,
+		items:    items,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact NFTSaleFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := currency.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if l := len(fact.items); l < 1 {
+		return util.ErrInvalid.Errorf("empty items for NFTSaleFact")
+	} else if l > int(MaxNFTSaleItems) {
+		return util.ErrInvalid.Errorf("items over allowed, %d > %d", l, MaxNFTSaleItems)
+	}
+
+	if err := util.CheckIsValiders(nil, false, fact.sender, fact.buyer); err != nil {
+		return err
+	}
+
+	if fact.sender.Equal(fact.buyer) {
+		return util.ErrInvalid.Errorf("sender and buyer are the same, %q", fact.sender)
+	}
+
+	founds := map[string]struct{}{}
+	for _, item := range fact.items {
+		if err := item.IsValid(nil); err != nil {
+			return err
+		}
+
+		n := item.NFT()
+		if _, found := founds[n.String()]; found {
+			return util.ErrInvalid.Errorf("duplicate nft found, %q", n)
+		}
+
+		founds[n.String()] = struct{}{}
+	}
+
+	return nil
+}
+
+func (fact NFTSaleFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact NFTSaleFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact NFTSaleFact) Bytes() []byte {
+	is := make([][]byte, len(fact.items))
+	for i, item := range fact.items {
+		is[i] = item.Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.buyer.Bytes(),
+		util.ConcatBytesSlice(is...),
+	)
+}
+
+func (fact NFTSaleFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact NFTSaleFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact NFTSaleFact) Buyer() base.Address {
+	return fact.buyer
+}
+
+func (fact NFTSaleFact) Items() []NFTSaleItem {
+	return fact.items
+}
+
+func (fact NFTSaleFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 2)
+	as[0] = fact.sender
+	as[1] = fact.buyer
+
+	return as, nil
+}
+
+type NFTSale struct {
+	currency.BaseOperation
+}
+
+func NewNFTSale(fact NFTSaleFact) (NFTSale, error) {
+	return NFTSale{BaseOperation: currency.NewBaseOperation(NFTSaleHint, fact)}, nil
+}
+
+func (op *NFTSale) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
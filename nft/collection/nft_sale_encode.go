@@ -0,0 +1,41 @@
+package collection
+
+import (
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+func (fact *NFTSaleFact) unmarshal(enc encoder.Encoder, sd, by string, bit []byte) error {
+	e := util.StringErrorFunc("failed to unmarshal NFTSaleFact")
+
+	sender, err := base.DecodeAddress(sd, enc)
+	if err != nil {
+		return e(err, "")
+	}
+	fact.sender = sender
+
+	buyer, err := base.DecodeAddress(by, enc)
+	if err != nil {
+		return e(err, "")
+	}
+	fact.buyer = buyer
+
+	hit, err := enc.DecodeSlice(bit)
+	if err != nil {
+		return e(err, "")
+	}
+
+	items := make([]NFTSaleItem, len(hit))
+	for i, hinter := range hit {
+		item, ok := hinter.(NFTSaleItem)
+		if !ok {
+			return e(util.ErrWrongType.Errorf("expected NFTSaleItem, not %T", hinter), "")
+		}
+
+		items[i] = item
+	}
+	fact.items = items
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package collection
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+var NFTSaleItemHint = hint.MustNewHint("mitum-nft-sale-item-v0.0.1")
+
+type NFTSaleItem struct {
+	hint.BaseHinter
+	nft      nft.NFTID
+	price    currency.Amount
+	currency currency.CurrencyID
+}
+
+func NewNFTSaleItem(n nft.NFTID, price currency.Amount, currency currency.CurrencyID) NFTSaleItem {
+	return NFTSaleItem{
+		BaseHinter: hint.NewBaseHinter(NFTSaleItemHint),
+		nft:        n,
+		price:      price,
+		currency:   currency,
+	}
+}
+
+func (it NFTSaleItem) IsValid([]byte) error {
+	if err := util.CheckIsValiders(nil, false, it.BaseHinter, it.nft, it.price, it.currency); err != nil {
+		return err
+	}
+
+	if !it.price.Big().OverZero() {
+		return util.ErrInvalid.Errorf("price must be over zero, %q", it.price.Big())
+	}
+
+	return nil
+}
+
+func (it NFTSaleItem) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		it.nft.Bytes(),
+		it.price.Bytes(),
+		it.currency.Bytes(),
+	)
+}
+
+func (it NFTSaleItem) NFT() nft.NFTID {
+	return it.nft
+}
+
+func (it NFTSaleItem) Price() currency.Amount {
+	return it.price
+}
+
+func (it NFTSaleItem) Currency() currency.CurrencyID {
+	return it.currency
+}
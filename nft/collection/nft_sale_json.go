@@ -0,0 +1,69 @@
+package collection
+
+import (
+	"encoding/json"
+
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+)
+
+type NFTSaleFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender base.Address  `json:"sender"`
+	Buyer  base.Address  `json:"buyer"`
+	Items  []NFTSaleItem `json:"items"`
+}
+
+func (fact NFTSaleFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(NFTSaleFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Buyer:                 fact.buyer,
+		Items:                 fact.items,
+	})
+}
+
+type NFTSaleFactJSONUnmarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender string          `json:"sender"`
+	Buyer  string          `json:"buyer"`
+	Items  json.RawMessage `json:"items"`
+}
+
+func (fact *NFTSaleFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of NFTSaleFact")
+
+	var uf NFTSaleFactJSONUnmarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e(err, "")
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	return fact.unmarshal(enc, uf.Sender, uf.Buyer, uf.Items)
+}
+
+type nftSaleMarshaler struct {
+	currency.BaseOperationJSONMarshaler
+}
+
+func (op NFTSale) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(nftSaleMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *NFTSale) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of NFTSale")
+
+	var ubo currency.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e(err, "")
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
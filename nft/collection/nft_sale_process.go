@@ -0,0 +1,340 @@
+package collection
+
+import (
+	"context"
+	"sync"
+
+	extensioncurrency "github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var nftSaleItemProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(NFTSaleItemProcessor)
+	},
+}
+
+var nftSaleProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(NFTSaleProcessor)
+	},
+}
+
+func (NFTSale) Process(
+	ctx context.Context, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type NFTSaleItemProcessor struct {
+	h        util.Hash
+	sender   base.Address
+	buyer    base.Address
+	item     NFTSaleItem
+	balances *balanceChanges
+}
+
+func (ipp *NFTSaleItemProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) error {
+	price := ipp.item.Price()
+	if _, err := existsCurrencyPolicy(price.Currency(), getStateFunc); err != nil {
+		return errors.Errorf("currency of price not found, %q: %w", price.Currency(), err)
+	}
+
+	nid := ipp.item.NFT()
+
+	st, err := existsState(StateKeyCollectionDesign(nid.Collection()), "design", getStateFunc)
+	if err != nil {
+		return errors.Errorf("collection design not found, %q: %w", nid.Collection(), err)
+	}
+
+	design, err := StateCollectionDesignValue(st)
+	if err != nil {
+		return errors.Errorf("collection design not found, %q: %w", nid.Collection(), err)
+	}
+	if !design.Active() {
+		return errors.Errorf("deactivated collection, %q", design.Symbol())
+	}
+
+	st, err = existsState(extensioncurrency.StateKeyContractAccount(design.Parent()), "key of contract account", getStateFunc)
+	if err != nil {
+		return errors.Errorf("parent not found, %q: %w", design.Parent(), err)
+	}
+
+	ca, err := extensioncurrency.StateContractAccountValue(st)
+	if err != nil {
+		return errors.Errorf("parent account value not found, %q: %w", design.Parent(), err)
+	}
+
+	if !ca.IsActive() {
+		return errors.Errorf("deactivated contract account, %q", design.Parent())
+	}
+
+	st, err = existsState(StateKeyNFT(nid), "key of nft", getStateFunc)
+	if err != nil {
+		return errors.Errorf("nft not found, %q: %w", nid, err)
+	}
+
+	nv, err := StateNFTValue(st)
+	if err != nil {
+		return errors.Errorf("nft value not found, %q: %w", nid, err)
+	}
+
+	if !nv.Active() {
+		return errors.Errorf("burned nft, %q", nid)
+	}
+
+	if err := checkNotFrozen(nid, getStateFunc); err != nil {
+		return errors.Errorf("nft not available, %q: %w", nid, err)
+	}
+
+	if err := checkNotInAuction(nid, getStateFunc); err != nil {
+		return errors.Errorf("nft not available, %q: %w", nid, err)
+	}
+
+	if err := checkNotAttached(nid, getStateFunc); err != nil {
+		return errors.Errorf("nft not available, %q: %w", nid, err)
+	}
+
+	if err := checkTransferable(nid.Collection(), getStateFunc); err != nil {
+		return errors.Errorf("nft not transferable, %q: %w", nid, err)
+	}
+
+	if err := checkSigned(nv, getStateFunc); err != nil {
+		return errors.Errorf("nft not transferable, %q: %w", nid, err)
+	}
+
+	if err := checkChildrenMovable(nid, getStateFunc); err != nil {
+		return errors.Errorf("nft not transferable, %q: %w", nid, err)
+	}
+
+	if nv.Owner().Equal(ipp.buyer) {
+		return errors.Errorf("buyer already owns nft, %q", nid)
+	}
+
+	if !(nv.Owner().Equal(ipp.sender) || nv.Approved().Equal(ipp.sender)) {
+		if st, err := existsState(StateKeyAgentBox(nv.Owner(), nv.ID().Collection()), "agents", getStateFunc); err != nil {
+			return errors.Errorf("unauthorized sender, %q: %w", ipp.sender, err)
+		} else if box, err := StateAgentBoxValue(st); err != nil {
+			return errors.Errorf("agent box value not found, %q: %w", ipp.sender, err)
+		} else if !box.Exists(ipp.sender) {
+			return errors.Errorf("unauthorized sender, %q", ipp.sender)
+		}
+	}
+
+	return nil
+}
+
+func (ipp *NFTSaleItemProcessor) Process(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, error) {
+	nid := ipp.item.NFT()
+
+	st, err := existsState(StateKeyNFT(nid), "key of nft", getStateFunc)
+	if err != nil {
+		return nil, errors.Errorf("nft not found, %q: %w", nid, err)
+	}
+
+	nv, err := StateNFTValue(st)
+	if err != nil {
+		return nil, errors.Errorf("nft value not found, %q: %w", nid, err)
+	}
+
+	if err := ipp.balances.sub(ipp.buyer, ipp.item.Price()); err != nil {
+		return nil, errors.Errorf("failed to pay price, %q: %w", nid, err)
+	}
+
+	if err := payNFTPrice(ipp.balances, nv, nv.Owner(), ipp.item.Price(), getStateFunc); err != nil {
+		return nil, errors.Errorf("failed to settle price, %q: %w", nid, err)
+	}
+
+	n := nft.NewNFT(nid, nv.Active(), ipp.buyer, nv.NFTHash(), nv.URI(), ipp.buyer, nv.Creators(), nv.Copyrighters(), ipp.buyer, 0, nv.Royalty(), nv.RoyaltyReceiver())
+	if err := n.IsValid(nil); err != nil {
+		return nil, errors.Errorf("invalid nft, %q: %w", nid, err)
+	}
+
+	sts := []base.StateMergeValue{NewNFTStateMergeValue(st.Key(), NewNFTStateValue(n))}
+
+	sv, err := cancelListing(nid, getStateFunc)
+	if err != nil {
+		return nil, errors.Errorf("failed to cancel listing, %q: %w", nid, err)
+	}
+	if sv != nil {
+		sts = append(sts, sv)
+	}
+
+	s, err := moveChildren(nid, ipp.buyer, getStateFunc)
+	if err != nil {
+		return nil, errors.Errorf("failed to move children, %q: %w", nid, err)
+	}
+	sts = append(sts, s...)
+
+	return sts, nil
+}
+
+func (ipp *NFTSaleItemProcessor) Close() error {
+	ipp.h = nil
+	ipp.sender = nil
+	ipp.buyer = nil
+	ipp.item = NFTSaleItem{}
+	ipp.balances = nil
+
+	nftSaleItemProcessorPool.Put(ipp)
+
+	return nil
+}
+
+type NFTSaleProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewNFTSaleProcessor() extensioncurrency.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringErrorFunc("failed to create new NFTSaleProcessor")
+
+		nopp := nftSaleProcessorPool.Get()
+		opp, ok := nopp.(*NFTSaleProcessor)
+		if !ok {
+			return nil, e(nil, "expected NFTSaleProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e(err, "")
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *NFTSaleProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	e := util.StringErrorFunc("failed to preprocess NFTSale")
+
+	fact, ok := op.Fact().(NFTSaleFact)
+	if !ok {
+		return ctx, nil, e(nil, "expected NFTSaleFact, not %T", op.Fact())
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, nil, e(err, "")
+	}
+
+	if err := checkExistsState(currency.StateKeyAccount(fact.Sender()), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("sender not found, %q: %w", fact.Sender(), err), nil
+	}
+
+	if err := checkNotExistsState(extensioncurrency.StateKeyContractAccount(fact.Sender()), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("contract account cannot sell nfts, %q", fact.Sender()), nil
+	}
+
+	if err := checkExistsState(currency.StateKeyAccount(fact.Buyer()), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("buyer not found, %q: %w", fact.Buyer(), err), nil
+	}
+
+	if err := checkNotExistsState(extensioncurrency.StateKeyContractAccount(fact.Buyer()), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("contract account cannot buy nfts, %q", fact.Buyer()), nil
+	}
+
+	if err := checkMultiFactSignsByState([]base.Address{fact.Sender(), fact.Buyer()}, op.Signs(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("invalid signing: %w", err), nil
+	}
+
+	for _, item := range fact.Items() {
+		ip := nftSaleItemProcessorPool.Get()
+		ipc, ok := ip.(*NFTSaleItemProcessor)
+		if !ok {
+			return nil, nil, e(nil, "expected NFTSaleItemProcessor, not %T", ip)
+		}
+
+		ipc.h = op.Hash()
+		ipc.sender = fact.Sender()
+		ipc.buyer = fact.Buyer()
+		ipc.item = item
+		ipc.balances = nil
+
+		if err := ipc.PreProcess(ctx, op, getStateFunc); err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("fail to preprocess NFTSaleItem: %w", err), nil
+		}
+
+		ipc.Close()
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *NFTSaleProcessor) Process( // nolint:dupl
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	e := util.StringErrorFunc("failed to process NFTSale")
+
+	fact, ok := op.Fact().(NFTSaleFact)
+	if !ok {
+		return nil, nil, e(nil, "expected NFTSaleFact, not %T", op.Fact())
+	}
+
+	balances := newBalanceChanges(getStateFunc)
+
+	var sts []base.StateMergeValue // nolint:prealloc
+	for _, item := range fact.Items() {
+		ip := nftSaleItemProcessorPool.Get()
+		ipc, ok := ip.(*NFTSaleItemProcessor)
+		if !ok {
+			return nil, nil, e(nil, "expected NFTSaleItemProcessor, not %T", ip)
+		}
+
+		ipc.h = op.Hash()
+		ipc.sender = fact.Sender()
+		ipc.buyer = fact.Buyer()
+		ipc.item = item
+		ipc.balances = balances
+
+		s, err := ipc.Process(ctx, op, getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("failed to process NFTSaleItem: %w", err), nil
+		}
+		sts = append(sts, s...)
+
+		ipc.Close()
+	}
+
+	fitems := fact.Items()
+	items := make([]CollectionItem, len(fitems))
+	for i := range fact.Items() {
+		items[i] = fitems[i]
+	}
+
+	required, err := CalculateCollectionItemsFee(getStateFunc, items)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to calculate fee: %w", err), nil
+	}
+
+	if err := balances.subFee(fact.Sender(), required); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to check enough balance: %w", err), nil
+	}
+
+	sts = append(sts, balances.stateMergeValues()...)
+
+	return sts, nil, nil
+}
+
+func (opp *NFTSaleProcessor) Close() error {
+	nftSaleProcessorPool.Put(opp)
+
+	return nil
+}
@@ -82,6 +82,10 @@ func (ipp *NFTSignItemProcessor) PreProcess(
 		return errors.Errorf("burned nft, %q", nid)
 	}
 
+	if err := checkNotFrozen(nid, getStateFunc); err != nil {
+		return errors.Errorf("nft not available, %q: %w", nid, err)
+	}
+
 	switch ipp.item.Qualification() {
 	case CreatorQualification:
 		if nv.Creators().IsSignedByAddress(ipp.sender) {
@@ -141,9 +145,9 @@ func (ipp *NFTSignItemProcessor) Process(
 
 	var n nft.NFT
 	if ipp.item.Qualification() == CreatorQualification {
-		n = nft.NewNFT(nv.ID(), nv.Active(), nv.Owner(), nv.NFTHash(), nv.URI(), nv.Approved(), *sns, nv.Copyrighters())
+		n = nft.NewNFT(nv.ID(), nv.Active(), nv.Owner(), nv.NFTHash(), nv.URI(), nv.Approved(), *sns, nv.Copyrighters(), nv.User(), nv.UserExpiry(), nv.Royalty(), nv.RoyaltyReceiver())
 	} else {
-		n = nft.NewNFT(nv.ID(), nv.Active(), nv.Owner(), nv.NFTHash(), nv.URI(), nv.Approved(), nv.Creators(), *sns)
+		n = nft.NewNFT(nv.ID(), nv.Active(), nv.Owner(), nv.NFTHash(), nv.URI(), nv.Approved(), nv.Creators(), *sns, nv.User(), nv.UserExpiry(), nv.Royalty(), nv.RoyaltyReceiver())
 	}
 
 	if err := n.IsValid(nil); err != nil {
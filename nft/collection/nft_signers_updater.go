@@ -0,0 +1,141 @@
+package collection
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	NFTSignersUpdaterFactHint = hint.MustNewHint("mitum-nft-signers-updater-operation-fact-v0.0.1")
+	NFTSignersUpdaterHint     = hint.MustNewHint("mitum-nft-signers-updater-operation-v0.0.1")
+)
+
+type NFTSignersUpdaterFact struct {
+	base.BaseFact
+	sender        base.Address
+	nft           nft.NFTID
+	qualification Qualification
+	signers       nft.Signers
+	currency      currency.CurrencyID
+}
+
+func NewNFTSignersUpdaterFact(
+	token []byte, sender base.Address,
+	n nft.NFTID,
+	q Qualification,
+	signers nft.Signers,
+	currency currency.CurrencyID,
+) NFTSignersUpdaterFact {
+	bf := base.NewBaseFact(NFTSignersUpdaterFactHint, token)
+
+	fact := NFTSignersUpdaterFact{
+		BaseFact:      bf,
+		sender:        sender,
+		nft:           n,
+		qualification: q,
+		signers:       signers,
+		currency:      currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact NFTSignersUpdaterFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := currency.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if err := util.CheckIsValiders(
+		nil, false,
+		fact.sender,
+		fact.nft,
+		fact.qualification,
+		fact.signers,
+		fact.currency,
+	); err != nil {
+		return err
+	}
+
+	for _, signer := range fact.signers.Signers() {
+		if signer.Signed() {
+			return util.ErrInvalid.Errorf("cannot set signed signer, %q", signer.Account())
+		}
+	}
+
+	return nil
+}
+
+func (fact NFTSignersUpdaterFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact NFTSignersUpdaterFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact NFTSignersUpdaterFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.nft.Bytes(),
+		fact.qualification.Bytes(),
+		fact.signers.Bytes(),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact NFTSignersUpdaterFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact NFTSignersUpdaterFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact NFTSignersUpdaterFact) NFT() nft.NFTID {
+	return fact.nft
+}
+
+func (fact NFTSignersUpdaterFact) Qualification() Qualification {
+	return fact.qualification
+}
+
+func (fact NFTSignersUpdaterFact) Signers() nft.Signers {
+	return fact.signers
+}
+
+func (fact NFTSignersUpdaterFact) Currency() currency.CurrencyID {
+	return fact.currency
+}
+
+func (fact NFTSignersUpdaterFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 1)
+	as[0] = fact.sender
+	as = append(as, fact.signers.Addresses()...)
+	return as, nil
+}
+
+type NFTSignersUpdater struct {
+	currency.BaseOperation
+}
+
+func NewNFTSignersUpdater(fact NFTSignersUpdaterFact) (NFTSignersUpdater, error) {
+	return NFTSignersUpdater{BaseOperation: currency.NewBaseOperation(NFTSignersUpdaterHint, fact)}, nil
+}
+
+func (op *NFTSignersUpdater) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package collection
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+func (fact *NFTSignersUpdaterFact) unmarshal(
+	enc encoder.Encoder,
+	sd string,
+	bn []byte,
+	q string,
+	bsg []byte,
+	cid string,
+) error {
+	e := util.StringErrorFunc("failed to unmarshal NFTSignersUpdaterFact")
+
+	fact.qualification = Qualification(q)
+	fact.currency = currency.CurrencyID(cid)
+
+	sender, err := base.DecodeAddress(sd, enc)
+	if err != nil {
+		return e(err, "")
+	}
+	fact.sender = sender
+
+	if hinter, err := enc.Decode(bn); err != nil {
+		return e(err, "")
+	} else if n, ok := hinter.(nft.NFTID); !ok {
+		return e(util.ErrWrongType.Errorf("expected NFTID, not %T", hinter), "")
+	} else {
+		fact.nft = n
+	}
+
+	if hinter, err := enc.Decode(bsg); err != nil {
+		return e(err, "")
+	} else if signers, ok := hinter.(nft.Signers); !ok {
+		return e(util.ErrWrongType.Errorf("expected Signers, not %T", hinter), "")
+	} else {
+		fact.signers = signers
+	}
+
+	return nil
+}
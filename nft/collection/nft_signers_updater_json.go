@@ -0,0 +1,76 @@
+package collection
+
+import (
+	"encoding/json"
+
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+)
+
+type NFTSignersUpdaterFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender        base.Address        `json:"sender"`
+	NFT           nft.NFTID           `json:"nft"`
+	Qualification Qualification       `json:"qualification"`
+	Signers       nft.Signers         `json:"signers"`
+	Currency      currency.CurrencyID `json:"currency"`
+}
+
+func (fact NFTSignersUpdaterFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(NFTSignersUpdaterFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		NFT:                   fact.nft,
+		Qualification:         fact.qualification,
+		Signers:               fact.signers,
+		Currency:              fact.currency,
+	})
+}
+
+type NFTSignersUpdaterFactJSONUnmarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender        string          `json:"sender"`
+	NFT           json.RawMessage `json:"nft"`
+	Qualification string          `json:"qualification"`
+	Signers       json.RawMessage `json:"signers"`
+	Currency      string          `json:"currency"`
+}
+
+func (fact *NFTSignersUpdaterFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of NFTSignersUpdaterFact")
+
+	var u NFTSignersUpdaterFactJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(u.BaseFactJSONUnmarshaler)
+
+	return fact.unmarshal(enc, u.Sender, u.NFT, u.Qualification, u.Signers, u.Currency)
+}
+
+type nftSignersUpdaterMarshaler struct {
+	currency.BaseOperationJSONMarshaler
+}
+
+func (op NFTSignersUpdater) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(nftSignersUpdaterMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *NFTSignersUpdater) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of NFTSignersUpdater")
+
+	var ubo currency.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e(err, "")
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
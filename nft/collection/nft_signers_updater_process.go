@@ -0,0 +1,223 @@
+package collection
+
+import (
+	"context"
+	"sync"
+
+	extensioncurrency "github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var nftSignersUpdaterProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(NFTSignersUpdaterProcessor)
+	},
+}
+
+func (NFTSignersUpdater) Process(
+	ctx context.Context, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type NFTSignersUpdaterProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewNFTSignersUpdaterProcessor() extensioncurrency.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringErrorFunc("failed to create new NFTSignersUpdaterProcessor")
+
+		nopp := nftSignersUpdaterProcessorPool.Get()
+		opp, ok := nopp.(*NFTSignersUpdaterProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected NFTSignersUpdaterProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e(err, "")
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *NFTSignersUpdaterProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	e := util.StringErrorFunc("failed to preprocess NFTSignersUpdater")
+
+	fact, ok := op.Fact().(NFTSignersUpdaterFact)
+	if !ok {
+		return ctx, nil, e(nil, "expected NFTSignersUpdaterFact, not %T", op.Fact())
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, nil, e(err, "")
+	}
+
+	if err := checkExistsState(currency.StateKeyAccount(fact.Sender()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("sender not found, %q: %w", fact.Sender(), err), nil
+	}
+
+	if err := checkNotExistsState(extensioncurrency.StateKeyContractAccount(fact.Sender()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("contract account cannot update nft signers, %q: %w", fact.Sender(), err), nil
+	}
+
+	if err := checkExistsState(extensioncurrency.StateKeyCurrencyDesign(fact.Currency()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("currency not found, %q: %w", fact.Currency(), err), nil
+	}
+
+	nid := fact.NFT()
+
+	if err := checkActiveCollection(nid.Collection(), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("collection not available, %q: %w", nid.Collection(), err), nil
+	}
+
+	st, err := existsState(StateKeyNFT(nid), "key of nft", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("nft not found, %q: %w", nid, err), nil
+	}
+
+	nv, err := StateNFTValue(st)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("nft value not found, %q: %w", nid, err), nil
+	}
+
+	if !nv.Active() {
+		return nil, base.NewBaseOperationProcessReasonError("burned nft, %q", nid), nil
+	}
+
+	if err := checkNotFrozen(nid, getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("nft not available, %q: %w", nid, err), nil
+	}
+
+	signers, err := signersOf(nv, fact.Qualification())
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+	}
+
+	// every current signer must approve; the owner approves when no signer exists.
+	approvers := signers.Addresses()
+	if len(approvers) < 1 {
+		if !nv.Owner().Equal(fact.Sender()) {
+			return nil, base.NewBaseOperationProcessReasonError("not owner of nft without %s, %q, %q", fact.Qualification(), nid, fact.Sender()), nil
+		}
+		approvers = []base.Address{fact.Sender()}
+	} else if signers.IndexByAddress(fact.Sender()) < 0 {
+		return nil, base.NewBaseOperationProcessReasonError("sender not %s of nft, %q, %q", fact.Qualification(), nid, fact.Sender()), nil
+	}
+
+	if err := checkMultiFactSignsByState(approvers, op.Signs(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("invalid signing: %w", err), nil
+	}
+
+	for _, acc := range fact.Signers().Addresses() {
+		if err := checkExistsState(currency.StateKeyAccount(acc), getStateFunc); err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("%s not found, %q: %w", fact.Qualification(), acc, err), nil
+		}
+
+		if err := checkNotExistsState(extensioncurrency.StateKeyContractAccount(acc), getStateFunc); err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("contract account cannot be a %s, %q: %w", fact.Qualification(), acc, err), nil
+		}
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *NFTSignersUpdaterProcessor) Process(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	e := util.StringErrorFunc("failed to process NFTSignersUpdater")
+
+	fact, ok := op.Fact().(NFTSignersUpdaterFact)
+	if !ok {
+		return nil, nil, e(nil, "expected NFTSignersUpdaterFact, not %T", op.Fact())
+	}
+
+	nid := fact.NFT()
+
+	st, err := existsState(StateKeyNFT(nid), "key of nft", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("nft not found, %q: %w", nid, err), nil
+	}
+
+	nv, err := StateNFTValue(st)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("nft value not found, %q: %w", nid, err), nil
+	}
+
+	sgns := make([]nft.Signer, len(fact.Signers().Signers()))
+	for i, signer := range fact.Signers().Signers() {
+		sgns[i] = nft.NewSigner(signer.Account(), signer.Share(), false)
+	}
+	signers := nft.NewSigners(fact.Signers().Total(), sgns)
+
+	var n nft.NFT
+	switch fact.Qualification() {
+	case CreatorQualification:
+		n = nft.NewNFT(nid, nv.Active(), nv.Owner(), nv.NFTHash(), nv.URI(), nv.Approved(), signers, nv.Copyrighters(), nv.User(), nv.UserExpiry(), nv.Royalty(), nv.RoyaltyReceiver())
+	case CopyrighterQualification:
+		n = nft.NewNFT(nid, nv.Active(), nv.Owner(), nv.NFTHash(), nv.URI(), nv.Approved(), nv.Creators(), signers, nv.User(), nv.UserExpiry(), nv.Royalty(), nv.RoyaltyReceiver())
+	default:
+		return nil, base.NewBaseOperationProcessReasonError("wrong qualification, %q", fact.Qualification()), nil
+	}
+
+	if err := n.IsValid(nil); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("invalid nft, %q: %w", nid, err), nil
+	}
+
+	sts := []base.StateMergeValue{
+		NewNFTStateMergeValue(st.Key(), NewNFTStateValue(n)),
+	}
+
+	currencyPolicy, err := existsCurrencyPolicy(fact.Currency(), getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("currency not found, %q: %w", fact.Currency(), err), nil
+	}
+
+	fee, err := currencyPolicy.Feeer().Fee(currency.ZeroBig)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to check fee of currency, %q: %w", fact.Currency(), err), nil
+	}
+
+	balances := newBalanceChanges(getStateFunc)
+	if err := balances.sub(fact.Sender(), currency.NewAmount(fee, fact.Currency())); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to check enough balance: %w", err), nil
+	}
+
+	sts = append(sts, balances.stateMergeValues()...)
+
+	return sts, nil, nil
+}
+
+func (opp *NFTSignersUpdaterProcessor) Close() error {
+	nftSignersUpdaterProcessorPool.Put(opp)
+
+	return nil
+}
+
+func signersOf(nv nft.NFT, q Qualification) (nft.Signers, error) {
+	switch q {
+	case CreatorQualification:
+		return nv.Creators(), nil
+	case CopyrighterQualification:
+		return nv.Copyrighters(), nil
+	default:
+		return nft.Signers{}, errors.Errorf("wrong qualification, %q", q)
+	}
+}
@@ -35,6 +35,7 @@ type NFTTransferItemProcessor struct {
 	h      util.Hash
 	sender base.Address
 	item   NFTTransferItem
+	box    *NFTBox
 }
 
 func (ipp *NFTTransferItemProcessor) PreProcess(
@@ -93,16 +94,51 @@ func (ipp *NFTTransferItemProcessor) PreProcess(
 		return errors.Errorf("burned nft, %q", nid)
 	}
 
-	if !(nv.Owner().Equal(ipp.sender) || nv.Approved().Equal(ipp.sender)) {
-		if st, err := existsState(StateKeyAgentBox(nv.Owner(), nv.ID().Collection()), "agents", getStateFunc); err != nil {
-			return errors.Errorf("unauthorized sender, %q: %w", ipp.sender, err)
-		} else if box, err := StateAgentBoxValue(st); err != nil {
-			return errors.Errorf("agent box value not found, %q: %w", ipp.sender, err)
-		} else if !box.Exists(ipp.sender) {
-			return errors.Errorf("unauthorized sender, %q", ipp.sender)
+	if err := checkNotFrozen(nid, getStateFunc); err != nil {
+		return errors.Errorf("nft not available, %q: %w", nid, err)
+	}
+
+	if err := checkNotInAuction(nid, getStateFunc); err != nil {
+		return errors.Errorf("nft not available, %q: %w", nid, err)
+	}
+
+	if err := checkTransferable(nid.Collection(), getStateFunc); err != nil {
+		return errors.Errorf("nft not transferable, %q: %w", nid, err)
+	}
+
+	if err := checkSigned(nv, getStateFunc); err != nil {
+		return errors.Errorf("nft not transferable, %q: %w", nid, err)
+	}
+
+	if err := checkChildrenMovable(nid, getStateFunc); err != nil {
+		return errors.Errorf("nft not transferable, %q: %w", nid, err)
+	}
+
+	root, err := rootOf(nid, getStateFunc)
+	if err != nil {
+		return errors.Errorf("failed to find root, %q: %w", nid, err)
+	}
+
+	if !root.Equal(nid) {
+		st, err := existsState(StateKeyNFT(root), "key of nft", getStateFunc)
+		if err != nil {
+			return errors.Errorf("nft not found, %q: %w", root, err)
+		}
+
+		nv, err = StateNFTValue(st)
+		if err != nil {
+			return errors.Errorf("nft value not found, %q: %w", root, err)
+		}
+
+		if err := checkNotFrozen(root, getStateFunc); err != nil {
+			return errors.Errorf("nft not available, %q: %w", root, err)
 		}
 	}
 
+	if err := checkNFTAuthorized(nv, ipp.sender, getStateFunc); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -122,7 +158,7 @@ func (ipp *NFTTransferItemProcessor) Process(
 		return nil, errors.Errorf("nft value not found, %q: %w", nid, err)
 	}
 
-	n := nft.NewNFT(nid, nv.Active(), receiver, nv.NFTHash(), nv.URI(), receiver, nv.Creators(), nv.Copyrighters())
+	n := nft.NewNFT(nid, nv.Active(), receiver, nv.NFTHash(), nv.URI(), receiver, nv.Creators(), nv.Copyrighters(), receiver, 0, nv.Royalty(), nv.RoyaltyReceiver())
 	if err := n.IsValid(nil); err != nil {
 		return nil, errors.Errorf("invalid nft, %q: %w", nid, err)
 	}
@@ -131,6 +167,33 @@ func (ipp *NFTTransferItemProcessor) Process(
 
 	sts[0] = NewNFTStateMergeValue(StateKeyNFT(ipp.item.NFT()), NewNFTStateValue(n))
 
+	sv, err := cancelListing(nid, getStateFunc)
+	if err != nil {
+		return nil, errors.Errorf("failed to cancel listing, %q: %w", nid, err)
+	}
+	if sv != nil {
+		sts = append(sts, sv)
+	}
+
+	if ipp.box != nil {
+		parent, _, err := parentOf(nid, getStateFunc)
+		if err != nil {
+			return nil, errors.Errorf("failed to find parent, %q: %w", nid, err)
+		}
+
+		if err := ipp.box.Remove(nid); err != nil {
+			return nil, errors.Errorf("failed to remove child, %q: %w", nid, err)
+		}
+
+		sts = append(sts, NewNFTParentStateMergeValue(StateKeyNFTParent(nid), NewNFTParentStateValue(NewNFTLink(nid, parent, false))))
+	}
+
+	s, err := moveChildren(nid, receiver, getStateFunc)
+	if err != nil {
+		return nil, errors.Errorf("failed to move children, %q: %w", nid, err)
+	}
+	sts = append(sts, s...)
+
 	return sts, nil
 }
 
@@ -138,6 +201,7 @@ func (ipp *NFTTransferItemProcessor) Close() error {
 	ipp.h = nil
 	ipp.sender = nil
 	ipp.item = NFTTransferItem{}
+	ipp.box = nil
 
 	nftTransferItemProcessorPool.Put(ipp)
 
@@ -211,6 +275,7 @@ func (opp *NFTTransferProcessor) PreProcess(
 		ipc.h = op.Hash()
 		ipc.sender = fact.Sender()
 		ipc.item = item
+		ipc.box = nil
 
 		if err := ipc.PreProcess(ctx, op, getStateFunc); err != nil {
 			return nil, base.NewBaseOperationProcessReasonError("fail to preprocess NFTTransferItem: %w", err), nil
@@ -219,6 +284,24 @@ func (opp *NFTTransferProcessor) PreProcess(
 		ipc.Close()
 	}
 
+	nfts := map[string]struct{}{}
+	for _, item := range fact.Items() {
+		nfts[item.NFT().String()] = struct{}{}
+	}
+
+	for _, item := range fact.Items() {
+		ancestors, err := ancestorsOf(item.NFT(), getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("failed to find ancestors, %q: %w", item.NFT(), err), nil
+		}
+
+		for _, a := range ancestors {
+			if _, found := nfts[a.String()]; found {
+				return nil, base.NewBaseOperationProcessReasonError("nft moves with its parent in the same operation, %q", item.NFT()), nil
+			}
+		}
+	}
+
 	return ctx, nil, nil
 }
 
@@ -233,6 +316,30 @@ func (opp *NFTTransferProcessor) Process( // nolint:dupl
 		return nil, nil, e(nil, "expected NFTTransferFact, not %T", op.Fact())
 	}
 
+	var parents []nft.NFTID
+	boxes := map[string]*NFTBox{}
+	for _, item := range fact.Items() {
+		parent, attached, err := parentOf(item.NFT(), getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("failed to find parent, %q: %w", item.NFT(), err), nil
+		}
+
+		if !attached {
+			continue
+		}
+
+		if _, found := boxes[parent.String()]; found {
+			continue
+		}
+
+		box, err := childrenOf(parent, getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("failed to find children, %q: %w", parent, err), nil
+		}
+		boxes[parent.String()] = &box
+		parents = append(parents, parent)
+	}
+
 	var sts []base.StateMergeValue // nolint:prealloc
 	for _, item := range fact.Items() {
 		ip := nftTransferItemProcessorPool.Get()
@@ -244,6 +351,15 @@ func (opp *NFTTransferProcessor) Process( // nolint:dupl
 		ipc.h = op.Hash()
 		ipc.sender = fact.Sender()
 		ipc.item = item
+		ipc.box = nil
+
+		parent, attached, err := parentOf(item.NFT(), getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("failed to find parent, %q: %w", item.NFT(), err), nil
+		}
+		if attached {
+			ipc.box = boxes[parent.String()]
+		}
 
 		s, err := ipc.Process(ctx, op, getStateFunc)
 		if err != nil {
@@ -254,6 +370,10 @@ func (opp *NFTTransferProcessor) Process( // nolint:dupl
 		ipc.Close()
 	}
 
+	for _, parent := range parents {
+		sts = append(sts, NewNFTChildrenStateMergeValue(StateKeyNFTChildren(parent), NewNFTChildrenStateValue(*boxes[parent.String()])))
+	}
+
 	required, err := opp.calculateItemsFee(op, getStateFunc)
 	if err != nil {
 		return nil, base.NewBaseOperationProcessReasonError("failed to calculate fee: %w", err), nil
@@ -0,0 +1,129 @@
+package collection
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	NFTUnsignFactHint = hint.MustNewHint("mitum-nft-unsign-operation-fact-v0.0.1")
+	NFTUnsignHint     = hint.MustNewHint("mitum-nft-unsign-operation-v0.0.1")
+)
+
+var MaxNFTUnsignItems = 10
+
+type NFTUnsignFact struct {
+	base.BaseFact
+	sender base.Address
+	items  []NFTUnsignItem
+}
+
+func NewNFTUnsignFact(token []byte, sender base.Address, items []NFTUnsignItem) NFTUnsignFact {
+	bf := base.NewBaseFact(NFTUnsignFactHint, token)
+	fact := NFTUnsignFact{
+		BaseFact: bf,
+		sender:   sender,
+		items:    items,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact NFTUnsignFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := currency.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if l := len(fact.items); l < 1 {
+		return util.ErrInvalid.Errorf("empty items for NFTUnsignFact")
+	} else if l > int(MaxNFTUnsignItems) {
+		return util.ErrInvalid.Errorf("items over allowed, %d > %d", l, MaxNFTUnsignItems)
+	}
+
+	if err := fact.sender.IsValid(nil); err != nil {
+		return err
+	}
+
+	founds := map[string]struct{}{}
+	for _, item := range fact.items {
+		if err := item.IsValid(nil); err != nil {
+			return err
+		}
+
+		n := item.NFT()
+		if err := n.IsValid(nil); err != nil {
+			return err
+		}
+
+		if _, found := founds[n.String()]; found {
+			return util.ErrInvalid.Errorf("duplicate nft found, %q", n)
+		}
+
+		founds[n.String()] = struct{}{}
+	}
+
+	return nil
+}
+
+func (fact NFTUnsignFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact NFTUnsignFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact NFTUnsignFact) Bytes() []byte {
+	is := make([][]byte, len(fact.items))
+	for i := range fact.items {
+		is[i] = fact.items[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		util.ConcatBytesSlice(is...),
+	)
+}
+
+func (fact NFTUnsignFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact NFTUnsignFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact NFTUnsignFact) Items() []NFTUnsignItem {
+	return fact.items
+}
+
+func (fact NFTUnsignFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 1)
+	as[0] = fact.sender
+	return as, nil
+}
+
+type NFTUnsign struct {
+	currency.BaseOperation
+}
+
+func NewNFTUnsign(fact NFTUnsignFact) (NFTUnsign, error) {
+	return NFTUnsign{BaseOperation: currency.NewBaseOperation(NFTUnsignHint, fact)}, nil
+}
+
+func (op *NFTUnsign) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
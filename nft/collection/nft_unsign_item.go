@@ -0,0 +1,50 @@
+package collection
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+var NFTUnsignItemHint = hint.MustNewHint("mitum-nft-unsign-item-v0.0.1")
+
+type NFTUnsignItem struct {
+	hint.BaseHinter
+	qualification Qualification
+	nft           nft.NFTID
+	currency      currency.CurrencyID
+}
+
+func NewNFTUnsignItem(q Qualification, n nft.NFTID, currency currency.CurrencyID) NFTUnsignItem {
+	return NFTUnsignItem{
+		BaseHinter:    hint.NewBaseHinter(NFTUnsignItemHint),
+		qualification: q,
+		nft:           n,
+		currency:      currency,
+	}
+}
+
+func (it NFTUnsignItem) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		it.qualification.Bytes(),
+		it.nft.Bytes(),
+		it.currency.Bytes(),
+	)
+}
+
+func (it NFTUnsignItem) IsValid([]byte) error {
+	return util.CheckIsValiders(nil, false, it.BaseHinter, it.qualification, it.nft, it.currency)
+}
+
+func (it NFTUnsignItem) Qualification() Qualification {
+	return it.qualification
+}
+
+func (it NFTUnsignItem) NFT() nft.NFTID {
+	return it.nft
+}
+
+func (it NFTUnsignItem) Currency() currency.CurrencyID {
+	return it.currency
+}
@@ -0,0 +1,46 @@
+package collection
+
+import (
+	"encoding/json"
+
+	"github.com/ProtoconNet/mitum-nft/nft"
+
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+type NFTUnsignItemJSONMarshaler struct {
+	hint.BaseHinter
+	Qualification Qualification       `json:"qualification"`
+	NFT           nft.NFTID           `json:"nft"`
+	Currency      currency.CurrencyID `json:"currency"`
+}
+
+func (it NFTUnsignItem) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(NFTUnsignItemJSONMarshaler{
+		BaseHinter:    it.BaseHinter,
+		Qualification: it.qualification,
+		NFT:           it.nft,
+		Currency:      it.currency,
+	})
+}
+
+type NFTUnsignItemJSONUnmarshaler struct {
+	Hint          hint.Hint       `json:"_hint"`
+	Qualification string          `json:"qualification"`
+	NFT           json.RawMessage `json:"nft"`
+	Currency      string          `json:"currency"`
+}
+
+func (it *NFTUnsignItem) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of NFTUnsignItem")
+
+	var u NFTUnsignItemJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	return it.unmarshal(enc, u.Hint, u.Qualification, u.NFT, u.Currency)
+}
@@ -0,0 +1,67 @@
+package collection
+
+import (
+	"encoding/json"
+
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+)
+
+type NFTUnsignFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender base.Address    `json:"sender"`
+	Items  []NFTUnsignItem `json:"items"`
+}
+
+func (fact NFTUnsignFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(NFTUnsignFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Items:                 fact.items,
+	})
+}
+
+type NFTUnsignFactJSONUnmarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender string          `json:"sender"`
+	Items  json.RawMessage `json:"items"`
+}
+
+func (fact *NFTUnsignFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of NFTUnsignFact")
+
+	var uf NFTUnsignFactJSONUnmarshaler
+
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e(err, "")
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	return fact.unmarshal(enc, uf.Sender, uf.Items)
+}
+
+type nftUnsignMarshaler struct {
+	currency.BaseOperationJSONMarshaler
+}
+
+func (op NFTUnsign) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(nftUnsignMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *NFTUnsign) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of NFTUnsign")
+
+	var ubo currency.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e(err, "")
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,325 @@
+package collection
+
+import (
+	"context"
+	"sync"
+
+	extensioncurrency "github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var nftUnsignItemProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(NFTUnsignItemProcessor)
+	},
+}
+
+var nftUnsignProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(NFTUnsignProcessor)
+	},
+}
+
+func (NFTUnsign) Process(
+	ctx context.Context, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type NFTUnsignItemProcessor struct {
+	h      util.Hash
+	sender base.Address
+	item   NFTUnsignItem
+	height base.Height
+}
+
+func (ipp *NFTUnsignItemProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) error {
+	nid := ipp.item.NFT()
+
+	st, err := existsState(StateKeyCollectionDesign(nid.Collection()), "key of design", getStateFunc)
+	if err != nil {
+		return errors.Errorf("collection design not found, %q: %w", nid.Collection(), err)
+	}
+
+	design, err := StateCollectionDesignValue(st)
+	if err != nil {
+		return errors.Errorf("collection design value not found, %q: %w", nid.Collection(), err)
+	}
+
+	if !design.Active() {
+		return errors.Errorf("deactivated collection, %q", nid.Collection())
+	}
+	st, err = existsState(extensioncurrency.StateKeyContractAccount(design.Parent()), "contract account", getStateFunc)
+	if err != nil {
+		return errors.Errorf("parent not found, %q: %w", design.Parent(), err)
+	}
+
+	ca, err := extensioncurrency.StateContractAccountValue(st)
+	if err != nil {
+		return errors.Errorf("contract account value not found, %q: %w", design.Parent(), err)
+	}
+
+	if !ca.IsActive() {
+		return errors.Errorf("deactivated contract account, %q", design.Parent())
+	}
+
+	st, err = existsState(StateKeyNFT(nid), "key of nft", getStateFunc)
+	if err != nil {
+		return errors.Errorf("nft not found, %q: %w", nid, err)
+	}
+
+	nv, err := StateNFTValue(st)
+	if err != nil {
+		return errors.Errorf("nft value not found, %q: %w", nid, err)
+	}
+
+	if !nv.Active() {
+		return errors.Errorf("burned nft, %q", nid)
+	}
+
+	if err := checkNotFrozen(nid, getStateFunc); err != nil {
+		return errors.Errorf("nft not available, %q: %w", nid, err)
+	}
+
+	switch ipp.item.Qualification() {
+	case CreatorQualification:
+		if !nv.Creators().IsSignedByAddress(ipp.sender) {
+			return errors.Errorf("not signed nft, %q-%q", ipp.sender, nv.ID())
+		}
+	case CopyrighterQualification:
+		if !nv.Copyrighters().IsSignedByAddress(ipp.sender) {
+			return errors.Errorf("not signed nft, %q-%q", ipp.sender, nv.ID())
+		}
+	default:
+		return errors.Errorf("wrong qualification, %q", ipp.item.Qualification())
+	}
+
+	return nil
+}
+
+func (ipp *NFTUnsignItemProcessor) Process(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, error) {
+	nid := ipp.item.NFT()
+
+	st, err := existsState(StateKeyNFT(nid), "key of nft", getStateFunc)
+	if err != nil {
+		return nil, errors.Errorf("nft not found, %q: %w", nid, err)
+	}
+
+	nv, err := StateNFTValue(st)
+	if err != nil {
+		return nil, errors.Errorf("nft value not found, %q: %w", nid, err)
+	}
+
+	var signers nft.Signers
+
+	switch ipp.item.Qualification() {
+	case CreatorQualification:
+		signers = nv.Creators()
+	case CopyrighterQualification:
+		signers = nv.Copyrighters()
+	default:
+		return nil, errors.Errorf("wrong qualification, %q", ipp.item.Qualification())
+	}
+
+	idx := signers.IndexByAddress(ipp.sender)
+	if idx < 0 {
+		return nil, errors.Errorf("not signer of nft, %q-%q", ipp.sender, nv.ID())
+	}
+
+	signer := nft.NewSigner(signers.Signers()[idx].Account(), signers.Signers()[idx].Share(), false)
+	if err := signer.IsValid(nil); err != nil {
+		return nil, errors.Errorf("invalid signer, %q", signer.Account())
+	}
+
+	sns := &signers
+	if err := sns.SetSigner(signer); err != nil {
+		return nil, errors.Errorf("failed to set signer for signers, %q: %w", signer, err)
+	}
+
+	var n nft.NFT
+	if ipp.item.Qualification() == CreatorQualification {
+		n = nft.NewNFT(nv.ID(), nv.Active(), nv.Owner(), nv.NFTHash(), nv.URI(), nv.Approved(), *sns, nv.Copyrighters(), nv.User(), nv.UserExpiry(), nv.Royalty(), nv.RoyaltyReceiver())
+	} else {
+		n = nft.NewNFT(nv.ID(), nv.Active(), nv.Owner(), nv.NFTHash(), nv.URI(), nv.Approved(), nv.Creators(), *sns, nv.User(), nv.UserExpiry(), nv.Royalty(), nv.RoyaltyReceiver())
+	}
+
+	if err := n.IsValid(nil); err != nil {
+		return nil, errors.Errorf("invalid nft, %q: %w", n.ID(), err)
+	}
+
+	r := NewSignRevocation(nid, ipp.sender, ipp.item.Qualification(), ipp.height)
+	if err := r.IsValid(nil); err != nil {
+		return nil, errors.Errorf("invalid sign revocation, %q: %w", nid, err)
+	}
+
+	sts := make([]base.StateMergeValue, 2)
+
+	sts[0] = NewNFTStateMergeValue(StateKeyNFT(n.ID()), NewNFTStateValue(n))
+	sts[1] = NewSignRevocationStateMergeValue(StateKeySignRevocation(nid, ipp.sender, ipp.item.Qualification()), NewSignRevocationStateValue(r))
+
+	return sts, nil
+}
+
+func (ipp *NFTUnsignItemProcessor) Close() error {
+	ipp.h = nil
+	ipp.sender = nil
+	ipp.item = NFTUnsignItem{}
+	ipp.height = 0
+	nftUnsignItemProcessorPool.Put(ipp)
+
+	return nil
+}
+
+type NFTUnsignProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewNFTUnsignProcessor() extensioncurrency.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringErrorFunc("failed to create new NFTUnsignProcessor")
+
+		nopp := nftUnsignProcessorPool.Get()
+		opp, ok := nopp.(*NFTUnsignProcessor)
+		if !ok {
+			return nil, e(nil, "expected NFTUnsignProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e(err, "")
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *NFTUnsignProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	e := util.StringErrorFunc("failed to preprocess NFTUnsign")
+
+	fact, ok := op.Fact().(NFTUnsignFact)
+	if !ok {
+		return ctx, nil, e(nil, "expected NFTUnsignFact, not %T", op.Fact())
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, nil, e(err, "")
+	}
+
+	if err := checkExistsState(currency.StateKeyAccount(fact.Sender()), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("sender not found, %q: %w", fact.Sender(), err), nil
+	}
+
+	if err := checkNotExistsState(extensioncurrency.StateKeyContractAccount(fact.Sender()), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("contract account cannot unsign nfts, %q", fact.Sender()), nil
+	}
+
+	if err := checkFactSignsByState(fact.sender, op.Signs(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("invalid signing: %w", err), nil
+	}
+
+	for _, item := range fact.Items() {
+		ip := nftUnsignItemProcessorPool.Get()
+		ipc, ok := ip.(*NFTUnsignItemProcessor)
+		if !ok {
+			return nil, nil, e(nil, "expected NFTUnsignItemProcessor, not %T", ip)
+		}
+
+		ipc.h = op.Hash()
+		ipc.sender = fact.Sender()
+		ipc.item = item
+		ipc.height = opp.Height()
+
+		if err := ipc.PreProcess(ctx, op, getStateFunc); err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("fail to preprocess NFTUnsignItem: %w", err), nil
+		}
+
+		ipc.Close()
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *NFTUnsignProcessor) Process( // nolint:dupl
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	e := util.StringErrorFunc("failed to process NFTUnsign")
+
+	fact, ok := op.Fact().(NFTUnsignFact)
+	if !ok {
+		return nil, nil, e(nil, "expected NFTUnsignFact, not %T", op.Fact())
+	}
+
+	var sts []base.StateMergeValue
+
+	for _, item := range fact.Items() {
+		ip := nftUnsignItemProcessorPool.Get()
+		ipc, ok := ip.(*NFTUnsignItemProcessor)
+		if !ok {
+			return nil, nil, e(nil, "expected NFTUnsignItemProcessor, not %T", ip)
+		}
+
+		ipc.h = op.Hash()
+		ipc.sender = fact.Sender()
+		ipc.item = item
+		ipc.height = opp.Height()
+
+		s, err := ipc.Process(ctx, op, getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("failed to process NFTUnsignItem: %w", err), nil
+		}
+		sts = append(sts, s...)
+
+		ipc.Close()
+	}
+
+	fitems := fact.Items()
+	items := make([]CollectionItem, len(fitems))
+	for i := range fact.Items() {
+		items[i] = fitems[i]
+	}
+
+	required, err := CalculateCollectionItemsFee(getStateFunc, items)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to calculate fee: %w", err), nil
+	}
+	sb, err := currency.CheckEnoughBalance(fact.sender, required, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to check enough balance: %w", err), nil
+	}
+
+	for i := range sb {
+		v, ok := sb[i].Value().(currency.BalanceStateValue)
+		if !ok {
+			return nil, nil, e(nil, "expected BalanceStateValue, not %T", sb[i].Value())
+		}
+		stv := currency.NewBalanceStateValue(v.Amount.WithBig(v.Amount.Big().Sub(required[i][0])))
+		sts = append(sts, currency.NewBalanceStateMergeValue(sb[i].Key(), stv))
+	}
+
+	return sts, nil, nil
+}
+
+func (opp *NFTUnsignProcessor) Close() error {
+	nftUnsignProcessorPool.Put(opp)
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package collection
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+var OfferHint = hint.MustNewHint("mitum-nft-offer-v0.0.1")
+
+type Offer struct {
+	hint.BaseHinter
+	nft     nft.NFTID
+	active  bool
+	offerer base.Address
+	amount  currency.Amount
+}
+
+func NewOffer(n nft.NFTID, active bool, offerer base.Address, amount currency.Amount) Offer {
+	return Offer{
+		BaseHinter: hint.NewBaseHinter(OfferHint),
+		nft:        n,
+		active:     active,
+		offerer:    offerer,
+		amount:     amount,
+	}
+}
+
+func (o Offer) IsValid([]byte) error {
+	if err := util.CheckIsValiders(nil, false,
+		o.BaseHinter,
+		o.nft,
+		o.offerer,
+		o.amount,
+	); err != nil {
+		return err
+	}
+
+	if !o.amount.Big().OverZero() {
+		return util.ErrInvalid.Errorf("amount must be over zero, %q", o.amount.Big())
+	}
+
+	return nil
+}
+
+func (o Offer) Bytes() []byte {
+	ba := make([]byte, 1)
+
+	if o.active {
+		ba[0] = 1
+	} else {
+		ba[0] = 0
+	}
+
+	return util.ConcatBytesSlice(
+		o.nft.Bytes(),
+		ba,
+		o.offerer.Bytes(),
+		o.amount.Bytes(),
+	)
+}
+
+func (o Offer) NFT() nft.NFTID {
+	return o.nft
+}
+
+func (o Offer) Active() bool {
+	return o.active
+}
+
+func (o Offer) Offerer() base.Address {
+	return o.offerer
+}
+
+func (o Offer) Amount() currency.Amount {
+	return o.amount
+}
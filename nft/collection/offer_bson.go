@@ -0,0 +1,45 @@
+package collection
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	bsonenc "github.com/ProtoconNet/mitum-currency/v2/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (o Offer) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":   o.Hint().String(),
+			"nft":     o.nft,
+			"active":  o.active,
+			"offerer": o.offerer,
+			"amount":  o.amount,
+		},
+	)
+}
+
+type OfferBSONUnmarshaler struct {
+	Hint    string   `bson:"_hint"`
+	NFT     bson.Raw `bson:"nft"`
+	Active  bool     `bson:"active"`
+	Offerer string   `bson:"offerer"`
+	Amount  bson.Raw `bson:"amount"`
+}
+
+func (o *Offer) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode bson of Offer")
+
+	var u OfferBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e(err, "")
+	}
+
+	return o.unmarshal(enc, ht, u.NFT, u.Active, u.Offerer, u.Amount)
+}
@@ -0,0 +1,49 @@
+package collection
+
+import (
+	"encoding/json"
+
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+type OfferJSONMarshaler struct {
+	hint.BaseHinter
+	NFT     nft.NFTID       `json:"nft"`
+	Active  bool            `json:"active"`
+	Offerer base.Address    `json:"offerer"`
+	Amount  currency.Amount `json:"amount"`
+}
+
+func (o Offer) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(OfferJSONMarshaler{
+		BaseHinter: o.BaseHinter,
+		NFT:        o.nft,
+		Active:     o.active,
+		Offerer:    o.offerer,
+		Amount:     o.amount,
+	})
+}
+
+type OfferJSONUnmarshaler struct {
+	Hint    hint.Hint       `json:"_hint"`
+	NFT     json.RawMessage `json:"nft"`
+	Active  bool            `json:"active"`
+	Offerer string          `json:"offerer"`
+	Amount  json.RawMessage `json:"amount"`
+}
+
+func (o *Offer) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of Offer")
+
+	var u OfferJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	return o.unmarshal(enc, u.Hint, u.NFT, u.Active, u.Offerer, u.Amount)
+}
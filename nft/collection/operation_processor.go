@@ -8,6 +8,7 @@ import (
 
 	extensioncurrency "github.com/ProtoconNet/mitum-currency-extension/v2/currency"
 	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum-nft/nft"
 	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
 	"github.com/ProtoconNet/mitum2/util/hint"
@@ -162,6 +163,7 @@ func (opr *OperationProcessor) checkDuplication(op base.Operation) error {
 
 	var did string
 	var didtype DuplicationType
+	var subdids []string
 	var newAddresses []base.Address
 
 	switch t := op.(type) {
@@ -230,6 +232,7 @@ func (opr *OperationProcessor) checkDuplication(op base.Operation) error {
 		}
 		did = fact.Sender().String()
 		didtype = DuplicationTypeSender
+		subdids = []string{StateKeyCollectionBox(fact.Form().Target())}
 	case CollectionPolicyUpdater:
 		fact, ok := t.Fact().(CollectionPolicyUpdaterFact)
 		if !ok {
@@ -237,6 +240,10 @@ func (opr *OperationProcessor) checkDuplication(op base.Operation) error {
 		}
 		did = fact.Sender().String()
 		didtype = DuplicationTypeSender
+		subdids = []string{StateKeyCollectionDesign(fact.Collection())}
+		if parent := opr.collectionParent(fact.Collection()); parent != nil {
+			subdids = append(subdids, StateKeyCollectionBox(parent))
+		}
 	case Mint:
 		fact, ok := t.Fact().(MintFact)
 		if !ok {
@@ -251,6 +258,15 @@ func (opr *OperationProcessor) checkDuplication(op base.Operation) error {
 		}
 		did = fact.Sender().String()
 		didtype = DuplicationTypeSender
+		nids := make([]nft.NFTID, len(fact.Items()))
+		for i, it := range fact.Items() {
+			nids[i] = it.NFT()
+		}
+		keys, err := opr.nftKeys(nids)
+		if err != nil {
+			return err
+		}
+		subdids = append(subdids, keys...)
 	case Delegate:
 		fact, ok := t.Fact().(DelegateFact)
 		if !ok {
@@ -265,6 +281,10 @@ func (opr *OperationProcessor) checkDuplication(op base.Operation) error {
 		}
 		did = fact.Sender().String()
 		didtype = DuplicationTypeSender
+		subdids = make([]string, len(fact.Items()))
+		for i, it := range fact.Items() {
+			subdids[i] = StateKeyNFT(it.NFT())
+		}
 	case NFTSign:
 		fact, ok := t.Fact().(NFTSignFact)
 		if !ok {
@@ -272,23 +292,375 @@ func (opr *OperationProcessor) checkDuplication(op base.Operation) error {
 		}
 		did = fact.Sender().String()
 		didtype = DuplicationTypeSender
+		subdids = make([]string, len(fact.Items()))
+		for i, it := range fact.Items() {
+			subdids[i] = StateKeyNFT(it.NFT())
+		}
+	case Burn:
+		fact, ok := t.Fact().(BurnFact)
+		if !ok {
+			return errors.Errorf("expected BurnFact, not %T", t.Fact())
+		}
+		did = fact.Sender().String()
+		didtype = DuplicationTypeSender
+		subdids = make([]string, len(fact.Items()))
+		for i, it := range fact.Items() {
+			subdids[i] = StateKeyNFT(it.NFT())
+		}
+	case NFTSale:
+		fact, ok := t.Fact().(NFTSaleFact)
+		if !ok {
+			return errors.Errorf("expected NFTSaleFact, not %T", t.Fact())
+		}
+		did = fact.Sender().String()
+		didtype = DuplicationTypeSender
+		subdids = []string{fact.Buyer().String()}
+		nids := make([]nft.NFTID, len(fact.Items()))
+		for i, it := range fact.Items() {
+			nids[i] = it.NFT()
+		}
+		keys, err := opr.nftKeys(nids)
+		if err != nil {
+			return err
+		}
+		subdids = append(subdids, keys...)
+	case List:
+		fact, ok := t.Fact().(ListFact)
+		if !ok {
+			return errors.Errorf("expected ListFact, not %T", t.Fact())
+		}
+		did = fact.Sender().String()
+		didtype = DuplicationTypeSender
+		subdids = make([]string, len(fact.Items()))
+		for i, it := range fact.Items() {
+			subdids[i] = StateKeyNFT(it.NFT())
+		}
+	case Delist:
+		fact, ok := t.Fact().(DelistFact)
+		if !ok {
+			return errors.Errorf("expected DelistFact, not %T", t.Fact())
+		}
+		did = fact.Sender().String()
+		didtype = DuplicationTypeSender
+		subdids = make([]string, len(fact.Items()))
+		for i, it := range fact.Items() {
+			subdids[i] = StateKeyNFT(it.NFT())
+		}
+	case Buy:
+		fact, ok := t.Fact().(BuyFact)
+		if !ok {
+			return errors.Errorf("expected BuyFact, not %T", t.Fact())
+		}
+		did = fact.Sender().String()
+		didtype = DuplicationTypeSender
+		nids := make([]nft.NFTID, len(fact.Items()))
+		for i, it := range fact.Items() {
+			nids[i] = it.NFT()
+		}
+		keys, err := opr.nftKeys(nids)
+		if err != nil {
+			return err
+		}
+		subdids = append(subdids, keys...)
+	case AuctionCreate:
+		fact, ok := t.Fact().(AuctionCreateFact)
+		if !ok {
+			return errors.Errorf("expected AuctionCreateFact, not %T", t.Fact())
+		}
+		did = fact.Sender().String()
+		didtype = DuplicationTypeSender
+		subdids = make([]string, len(fact.Items()))
+		for i, it := range fact.Items() {
+			subdids[i] = StateKeyNFT(it.NFT())
+		}
+	case Bid:
+		fact, ok := t.Fact().(BidFact)
+		if !ok {
+			return errors.Errorf("expected BidFact, not %T", t.Fact())
+		}
+		did = fact.Sender().String()
+		didtype = DuplicationTypeSender
+		subdids = make([]string, len(fact.Items()))
+		for i, it := range fact.Items() {
+			subdids[i] = StateKeyNFT(it.NFT())
+		}
+	case AuctionSettle:
+		fact, ok := t.Fact().(AuctionSettleFact)
+		if !ok {
+			return errors.Errorf("expected AuctionSettleFact, not %T", t.Fact())
+		}
+		did = fact.Sender().String()
+		didtype = DuplicationTypeSender
+		nids := make([]nft.NFTID, len(fact.Items()))
+		for i, it := range fact.Items() {
+			nids[i] = it.NFT()
+		}
+		keys, err := opr.nftKeys(nids)
+		if err != nil {
+			return err
+		}
+		subdids = append(subdids, keys...)
+	case MakeOffer:
+		fact, ok := t.Fact().(MakeOfferFact)
+		if !ok {
+			return errors.Errorf("expected MakeOfferFact, not %T", t.Fact())
+		}
+		did = fact.Sender().String()
+		didtype = DuplicationTypeSender
+		subdids = make([]string, len(fact.Items()))
+		for i, it := range fact.Items() {
+			subdids[i] = StateKeyOffer(it.NFT(), fact.Sender())
+		}
+	case CancelOffer:
+		fact, ok := t.Fact().(CancelOfferFact)
+		if !ok {
+			return errors.Errorf("expected CancelOfferFact, not %T", t.Fact())
+		}
+		did = fact.Sender().String()
+		didtype = DuplicationTypeSender
+		subdids = make([]string, len(fact.Items()))
+		for i, it := range fact.Items() {
+			subdids[i] = StateKeyOffer(it.NFT(), fact.Sender())
+		}
+	case AcceptOffer:
+		fact, ok := t.Fact().(AcceptOfferFact)
+		if !ok {
+			return errors.Errorf("expected AcceptOfferFact, not %T", t.Fact())
+		}
+		did = fact.Sender().String()
+		didtype = DuplicationTypeSender
+		for _, it := range fact.Items() {
+			subdids = append(subdids, StateKeyOffer(it.NFT(), it.Offerer()))
+		}
+		nids := make([]nft.NFTID, len(fact.Items()))
+		for i, it := range fact.Items() {
+			nids[i] = it.NFT()
+		}
+		keys, err := opr.nftKeys(nids)
+		if err != nil {
+			return err
+		}
+		subdids = append(subdids, keys...)
+	case CollectionActiveUpdater:
+		fact, ok := t.Fact().(CollectionActiveUpdaterFact)
+		if !ok {
+			return errors.Errorf("expected CollectionActiveUpdaterFact, not %T", t.Fact())
+		}
+		did = fact.Sender().String()
+		didtype = DuplicationTypeSender
+		subdids = []string{StateKeyCollectionDesign(fact.Collection())}
+		if parent := opr.collectionParent(fact.Collection()); parent != nil {
+			subdids = append(subdids, StateKeyCollectionBox(parent))
+		}
+	case CollectionOwnershipTransfer:
+		fact, ok := t.Fact().(CollectionOwnershipTransferFact)
+		if !ok {
+			return errors.Errorf("expected CollectionOwnershipTransferFact, not %T", t.Fact())
+		}
+		did = fact.Sender().String()
+		didtype = DuplicationTypeSender
+		subdids = []string{StateKeyCollectionDesign(fact.Collection()), StateKeyCollectionBox(fact.Parent())}
+		if parent := opr.collectionParent(fact.Collection()); parent != nil && !parent.Equal(fact.Parent()) {
+			subdids = append(subdids, StateKeyCollectionBox(parent))
+		}
+	case Freeze:
+		fact, ok := t.Fact().(FreezeFact)
+		if !ok {
+			return errors.Errorf("expected FreezeFact, not %T", t.Fact())
+		}
+		did = fact.Sender().String()
+		didtype = DuplicationTypeSender
+	case Unfreeze:
+		fact, ok := t.Fact().(UnfreezeFact)
+		if !ok {
+			return errors.Errorf("expected UnfreezeFact, not %T", t.Fact())
+		}
+		did = fact.Sender().String()
+		didtype = DuplicationTypeSender
+	case EditionMint:
+		fact, ok := t.Fact().(EditionMintFact)
+		if !ok {
+			return errors.Errorf("expected EditionMintFact, not %T", t.Fact())
+		}
+		did = fact.Sender().String()
+		didtype = DuplicationTypeSender
+	case EditionTransfer:
+		fact, ok := t.Fact().(EditionTransferFact)
+		if !ok {
+			return errors.Errorf("expected EditionTransferFact, not %T", t.Fact())
+		}
+		did = fact.Sender().String()
+		didtype = DuplicationTypeSender
+		subdids = make([]string, 0, len(fact.Items())*2)
+		for _, it := range fact.Items() {
+			subdids = append(subdids, StateKeyEditionBalance(it.NFT(), fact.Sender()), StateKeyEditionBalance(it.NFT(), it.Receiver()))
+		}
+	case EditionBurn:
+		fact, ok := t.Fact().(EditionBurnFact)
+		if !ok {
+			return errors.Errorf("expected EditionBurnFact, not %T", t.Fact())
+		}
+		did = fact.Sender().String()
+		didtype = DuplicationTypeSender
+		subdids = make([]string, len(fact.Items()))
+		for i, it := range fact.Items() {
+			subdids[i] = StateKeyEditionBalance(it.NFT(), fact.Sender())
+		}
+	case Fractionalize:
+		fact, ok := t.Fact().(FractionalizeFact)
+		if !ok {
+			return errors.Errorf("expected FractionalizeFact, not %T", t.Fact())
+		}
+		did = fact.Sender().String()
+		didtype = DuplicationTypeSender
+		keys, err := opr.nftKeys([]nft.NFTID{fact.NFT()})
+		if err != nil {
+			return err
+		}
+		subdids = append([]string{fact.Share().String()}, keys...)
+	case Redeem:
+		fact, ok := t.Fact().(RedeemFact)
+		if !ok {
+			return errors.Errorf("expected RedeemFact, not %T", t.Fact())
+		}
+		did = fact.Sender().String()
+		didtype = DuplicationTypeSender
+		keys, err := opr.nftKeys([]nft.NFTID{fact.NFT()})
+		if err != nil {
+			return err
+		}
+		subdids = keys
+	case SetUser:
+		fact, ok := t.Fact().(SetUserFact)
+		if !ok {
+			return errors.Errorf("expected SetUserFact, not %T", t.Fact())
+		}
+		did = fact.Sender().String()
+		didtype = DuplicationTypeSender
+		subdids = make([]string, len(fact.Items()))
+		for i, it := range fact.Items() {
+			subdids[i] = StateKeyNFT(it.NFT())
+		}
+	case AttachChild:
+		fact, ok := t.Fact().(AttachChildFact)
+		if !ok {
+			return errors.Errorf("expected AttachChildFact, not %T", t.Fact())
+		}
+		did = fact.Sender().String()
+		didtype = DuplicationTypeSender
+		for _, it := range fact.Items() {
+			keys, err := opr.nftKeys([]nft.NFTID{it.Child()})
+			if err != nil {
+				return err
+			}
+			subdids = append(subdids, StateKeyNFT(it.Parent()), StateKeyNFTChildren(it.Parent()))
+			subdids = append(subdids, keys...)
+		}
+	case DetachChild:
+		fact, ok := t.Fact().(DetachChildFact)
+		if !ok {
+			return errors.Errorf("expected DetachChildFact, not %T", t.Fact())
+		}
+		did = fact.Sender().String()
+		didtype = DuplicationTypeSender
+		for _, it := range fact.Items() {
+			keys, err := opr.nftKeys([]nft.NFTID{it.Child()})
+			if err != nil {
+				return err
+			}
+			subdids = append(subdids, StateKeyNFT(it.Parent()), StateKeyNFTChildren(it.Parent()))
+			subdids = append(subdids, keys...)
+		}
+	case Swap:
+		fact, ok := t.Fact().(SwapFact)
+		if !ok {
+			return errors.Errorf("expected SwapFact, not %T", t.Fact())
+		}
+		did = fact.Left().Sender().String()
+		didtype = DuplicationTypeSender
+		subdids = []string{fact.Right().Sender().String()}
+		keys, err := opr.nftKeys(append(append([]nft.NFTID{}, fact.Left().NFTs()...), fact.Right().NFTs()...))
+		if err != nil {
+			return err
+		}
+		subdids = append(subdids, keys...)
+	case RedeemVoucher:
+		fact, ok := t.Fact().(RedeemVoucherFact)
+		if !ok {
+			return errors.Errorf("expected RedeemVoucherFact, not %T", t.Fact())
+		}
+		v := fact.Voucher()
+		did = fact.Sender().String()
+		didtype = DuplicationTypeSender
+		subdids = []string{StateKeyUsedVoucher(v.Collection(), v.Signer(), v.Nonce())}
+	case NFTUnsign:
+		fact, ok := t.Fact().(NFTUnsignFact)
+		if !ok {
+			return errors.Errorf("expected NFTUnsignFact, not %T", t.Fact())
+		}
+		did = fact.Sender().String()
+		didtype = DuplicationTypeSender
+		subdids = make([]string, len(fact.Items()))
+		for i, it := range fact.Items() {
+			subdids[i] = StateKeyNFT(it.NFT())
+		}
+	case NFTSignersUpdater:
+		fact, ok := t.Fact().(NFTSignersUpdaterFact)
+		if !ok {
+			return errors.Errorf("expected NFTSignersUpdaterFact, not %T", t.Fact())
+		}
+		did = fact.Sender().String()
+		didtype = DuplicationTypeSender
+		subdids = []string{StateKeyNFT(fact.NFT())}
+	case AddWhites:
+		fact, ok := t.Fact().(AddWhitesFact)
+		if !ok {
+			return errors.Errorf("expected AddWhitesFact, not %T", t.Fact())
+		}
+		did = fact.Sender().String()
+		didtype = DuplicationTypeSender
+		subdids = make([]string, len(fact.Whites()))
+		for i, white := range fact.Whites() {
+			subdids[i] = StateKeyWhite(fact.Collection(), white.Account())
+		}
+	case RemoveWhites:
+		fact, ok := t.Fact().(RemoveWhitesFact)
+		if !ok {
+			return errors.Errorf("expected RemoveWhitesFact, not %T", t.Fact())
+		}
+		did = fact.Sender().String()
+		didtype = DuplicationTypeSender
+		subdids = make([]string, len(fact.Whites()))
+		for i, white := range fact.Whites() {
+			subdids[i] = StateKeyWhite(fact.Collection(), white)
+		}
 	default:
 		return nil
 	}
 
 	if len(did) > 0 {
-		if _, found := opr.duplicated[did]; found {
-			switch didtype {
-			case DuplicationTypeSender:
-				return errors.Errorf("violates only one sender in proposal")
-			case DuplicationTypeCurrency:
-				return errors.Errorf("duplicate currency id, %q found in proposal", did)
-			default:
-				return errors.Errorf("violates duplication in proposal")
+		dids := append([]string{did}, subdids...)
+
+		for i, did := range dids {
+			if _, found := opr.duplicated[did]; found {
+				if i > 0 {
+					return errors.Errorf("state %q already changed by other operation in proposal", did)
+				}
+
+				switch didtype {
+				case DuplicationTypeSender:
+					return errors.Errorf("violates only one sender in proposal")
+				case DuplicationTypeCurrency:
+					return errors.Errorf("duplicate currency id, %q found in proposal", did)
+				default:
+					return errors.Errorf("violates duplication in proposal")
+				}
 			}
 		}
 
-		opr.duplicated[did] = didtype
+		for _, did := range dids {
+			opr.duplicated[did] = didtype
+		}
 	}
 
 	if len(newAddresses) > 0 {
@@ -300,6 +672,42 @@ func (opr *OperationProcessor) checkDuplication(op base.Operation) error {
 	return nil
 }
 
+// collectionParent returns the current parent of the collection, or nil when it can not be found.
+func (opr *OperationProcessor) collectionParent(id extensioncurrency.ContractID) base.Address {
+	st, found, err := opr.GetStateFunc(StateKeyCollectionDesign(id))
+	if err != nil || !found {
+		return nil
+	}
+
+	design, err := StateCollectionDesignValue(st)
+	if err != nil {
+		return nil
+	}
+
+	return design.Parent()
+}
+
+// nftKeys returns the state keys of the nfts and their descendants, which move along with them.
+func (opr *OperationProcessor) nftKeys(ids []nft.NFTID) ([]string, error) {
+	var keys []string // nolint:prealloc
+	for _, id := range ids {
+		keys = append(keys, StateKeyNFT(id))
+
+		box, err := childrenOf(id, opr.GetStateFunc)
+		if err != nil {
+			return nil, err
+		}
+
+		ks, err := opr.nftKeys(box.NFTs())
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, ks...)
+	}
+
+	return keys, nil
+}
+
 func (opr *OperationProcessor) checkNewAddressDuplication(as []base.Address) error {
 	for i := range as {
 		if _, found := opr.duplicatedNewAddress[as[i].String()]; found {
@@ -355,7 +763,36 @@ func (opr *OperationProcessor) getNewProcessor(op base.Operation) (base.Operatio
 		NFTTransfer,
 		Delegate,
 		Approve,
-		NFTSign:
+		NFTSign,
+		Burn,
+		NFTSale,
+		List,
+		Delist,
+		Buy,
+		AuctionCreate,
+		Bid,
+		AuctionSettle,
+		MakeOffer,
+		CancelOffer,
+		AcceptOffer,
+		CollectionActiveUpdater,
+		CollectionOwnershipTransfer,
+		Freeze,
+		Unfreeze,
+		EditionMint,
+		EditionTransfer,
+		EditionBurn,
+		Fractionalize,
+		Redeem,
+		SetUser,
+		AttachChild,
+		DetachChild,
+		Swap,
+		RedeemVoucher,
+		NFTUnsign,
+		NFTSignersUpdater,
+		AddWhites,
+		RemoveWhites:
 		return nil, false, errors.Errorf("%T needs SetProcessor", t)
 	default:
 		return nil, false, nil
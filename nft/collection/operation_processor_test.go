@@ -0,0 +1,219 @@
+package collection
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	testParent     = nft.NewNFTID("ABC", 1)
+	testChild      = nft.NewNFTID("ABC", 2)
+	testGrandChild = nft.NewNFTID("ABC", 3)
+	testOther      = nft.NewNFTID("ABC", 4)
+)
+
+func newTestOperationProcessor() *OperationProcessor {
+	children := map[string]NFTBox{
+		StateKeyNFTChildren(testParent): NewNFTBox([]nft.NFTID{testChild}),
+		StateKeyNFTChildren(testChild):  NewNFTBox([]nft.NFTID{testGrandChild}),
+	}
+
+	return &OperationProcessor{
+		duplicated:           map[string]DuplicationType{},
+		duplicatedNewAddress: map[string]struct{}{},
+		GetStateFunc: func(key string) (base.State, bool, error) {
+			box, found := children[key]
+			if !found {
+				return nil, false, nil
+			}
+
+			return base.NewBaseState(
+				base.Height(33),
+				key,
+				NewNFTChildrenStateValue(box),
+				valuehash.RandomSHA256(),
+				[]util.Hash{valuehash.RandomSHA256()},
+			), true, nil
+		},
+	}
+}
+
+func testTransfer(t *testing.T, sender base.Address, id nft.NFTID) base.Operation {
+	op, err := NewNFTTransfer(NewNFTTransferFact([]byte("token"), sender, []NFTTransferItem{
+		NewNFTTransferItem(currency.NewAddress("receiver"), id, "MCC"),
+	}))
+	if err != nil {
+		t.Fatalf("failed to create nft transfer: %v", err)
+	}
+
+	return op
+}
+
+func testApprove(t *testing.T, sender base.Address, id nft.NFTID) base.Operation {
+	op, err := NewApprove(NewApproveFact([]byte("token"), sender, []ApproveItem{
+		NewApproveItem(currency.NewAddress("approved"), id, "MCC"),
+	}))
+	if err != nil {
+		t.Fatalf("failed to create approve: %v", err)
+	}
+
+	return op
+}
+
+func testSale(t *testing.T, sender, buyer base.Address, id nft.NFTID) base.Operation {
+	op, err := NewNFTSale(NewNFTSaleFact([]byte("token"), sender, buyer, []NFTSaleItem{
+		NewNFTSaleItem(id, currency.NewAmount(currency.NewBig(100), "MCC"), "MCC"),
+	}))
+	if err != nil {
+		t.Fatalf("failed to create nft sale: %v", err)
+	}
+
+	return op
+}
+
+func testAttach(t *testing.T, sender base.Address, parent, child nft.NFTID) base.Operation {
+	op, err := NewAttachChild(NewAttachChildFact([]byte("token"), sender, []AttachChildItem{
+		NewAttachChildItem(parent, child, "MCC"),
+	}))
+	if err != nil {
+		t.Fatalf("failed to create attach child: %v", err)
+	}
+
+	return op
+}
+
+func testDetach(t *testing.T, sender base.Address, parent, child nft.NFTID) base.Operation {
+	op, err := NewDetachChild(NewDetachChildFact([]byte("token"), sender, []DetachChildItem{
+		NewDetachChildItem(parent, child, "MCC"),
+	}))
+	if err != nil {
+		t.Fatalf("failed to create detach child: %v", err)
+	}
+
+	return op
+}
+
+func TestNFTKeys(t *testing.T) {
+	opr := newTestOperationProcessor()
+
+	cases := []struct {
+		name     string
+		ids      []nft.NFTID
+		expected []string
+	}{
+		{
+			name:     "parent with descendants",
+			ids:      []nft.NFTID{testParent},
+			expected: []string{StateKeyNFT(testParent), StateKeyNFT(testChild), StateKeyNFT(testGrandChild)},
+		},
+		{
+			name:     "child with descendant",
+			ids:      []nft.NFTID{testChild},
+			expected: []string{StateKeyNFT(testChild), StateKeyNFT(testGrandChild)},
+		},
+		{
+			name:     "without children",
+			ids:      []nft.NFTID{testOther},
+			expected: []string{StateKeyNFT(testOther)},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			keys, err := opr.nftKeys(c.ids)
+			if err != nil {
+				t.Fatalf("failed to get nft keys: %v", err)
+			}
+
+			if strings.Join(keys, ",") != strings.Join(c.expected, ",") {
+				t.Fatalf("keys = %v, expected %v", keys, c.expected)
+			}
+		})
+	}
+}
+
+func TestCheckDuplication(t *testing.T) {
+	a := currency.NewAddress("senderA")
+	b := currency.NewAddress("senderB")
+
+	cases := []struct {
+		name   string
+		first  base.Operation
+		second base.Operation
+		err    string
+	}{
+		{
+			name:   "different nfts",
+			first:  testApprove(t, a, testParent),
+			second: testApprove(t, b, testOther),
+		},
+		{
+			name:   "same sender",
+			first:  testApprove(t, a, testParent),
+			second: testApprove(t, a, testOther),
+			err:    "violates only one sender in proposal",
+		},
+		{
+			name:   "same nft",
+			first:  testTransfer(t, a, testParent),
+			second: testApprove(t, b, testParent),
+			err:    "already changed by other operation in proposal",
+		},
+		{
+			name:   "descendant of transferred nft",
+			first:  testTransfer(t, a, testParent),
+			second: testTransfer(t, b, testGrandChild),
+			err:    "already changed by other operation in proposal",
+		},
+		{
+			name:   "ancestor of transferred nft",
+			first:  testTransfer(t, a, testGrandChild),
+			second: testTransfer(t, b, testParent),
+			err:    "already changed by other operation in proposal",
+		},
+		{
+			name:   "buyer of sale",
+			first:  testSale(t, a, b, testOther),
+			second: testTransfer(t, b, testParent),
+			err:    "violates only one sender in proposal",
+		},
+		{
+			name:   "detach from transferred parent",
+			first:  testTransfer(t, a, testChild),
+			second: testDetach(t, b, testParent, testChild),
+			err:    "already changed by other operation in proposal",
+		},
+		{
+			name:   "attach to transferred parent",
+			first:  testAttach(t, a, testParent, testOther),
+			second: testTransfer(t, b, testParent),
+			err:    "already changed by other operation in proposal",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			opr := newTestOperationProcessor()
+
+			if err := opr.checkDuplication(c.first); err != nil {
+				t.Fatalf("first operation failed: %v", err)
+			}
+
+			err := opr.checkDuplication(c.second)
+			switch {
+			case len(c.err) < 1 && err != nil:
+				t.Fatalf("unexpected error: %v", err)
+			case len(c.err) > 0 && err == nil:
+				t.Fatalf("expected error, %q", c.err)
+			case len(c.err) > 0 && !strings.Contains(err.Error(), c.err):
+				t.Fatalf("error = %q, expected %q", err, c.err)
+			}
+		})
+	}
+}
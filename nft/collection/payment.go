@@ -0,0 +1,196 @@
+package collection
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/pkg/errors"
+)
+
+type RoyaltyShare struct {
+	receiver base.Address
+	amount   currency.Amount
+}
+
+func (rs RoyaltyShare) Receiver() base.Address {
+	return rs.receiver
+}
+
+func (rs RoyaltyShare) Amount() currency.Amount {
+	return rs.amount
+}
+
+// CalculateRoyaltyShares splits royalty percent of price among creators by their shares.
+// The rest of price, including the remainder of division, goes to the seller.
+func CalculateRoyaltyShares(price currency.Amount, royalty nft.PaymentParameter, creators nft.Signers) ([]RoyaltyShare, currency.Amount) {
+	total := creators.Total()
+	if royalty.Uint() == 0 || total == 0 || !price.Big().OverZero() {
+		return nil, price
+	}
+
+	paid := currency.ZeroBig
+	royalties := price.Big().MulInt64(int64(royalty.Uint())).Div(currency.NewBig(100))
+
+	var shares []RoyaltyShare
+	for _, creator := range creators.Signers() {
+		a := royalties.MulInt64(int64(creator.Share())).Div(currency.NewBig(int64(total)))
+		if !a.OverZero() {
+			continue
+		}
+
+		shares = append(shares, RoyaltyShare{receiver: creator.Account(), amount: currency.NewAmount(a, price.Currency())})
+		paid = paid.Add(a)
+	}
+
+	return shares, price.WithBig(price.Big().Sub(paid))
+}
+
+// RoyaltyInfo resolves the royalty shares of price for the nft and the rest for the seller.
+func RoyaltyInfo(id nft.NFTID, price currency.Amount, getStateFunc base.GetStateFunc) ([]RoyaltyShare, currency.Amount, error) {
+	st, err := existsState(StateKeyNFT(id), "key of nft", getStateFunc)
+	if err != nil {
+		return nil, currency.Amount{}, err
+	}
+
+	nv, err := StateNFTValue(st)
+	if err != nil {
+		return nil, currency.Amount{}, err
+	}
+
+	return royaltyShares(nv, price, getStateFunc)
+}
+
+// royaltyShares pays the royalty of the nft to its receiver when set;
+// otherwise the collection royalty is split among creators.
+func royaltyShares(n nft.NFT, price currency.Amount, getStateFunc base.GetStateFunc) ([]RoyaltyShare, currency.Amount, error) {
+	if n.ExistsRoyalty() {
+		if n.Royalty() == 0 || !price.Big().OverZero() {
+			return nil, price, nil
+		}
+
+		a := price.Big().MulInt64(int64(n.Royalty().Uint())).Div(currency.NewBig(100))
+		if !a.OverZero() {
+			return nil, price, nil
+		}
+
+		share := RoyaltyShare{receiver: n.RoyaltyReceiver(), amount: currency.NewAmount(a, price.Currency())}
+
+		return []RoyaltyShare{share}, price.WithBig(price.Big().Sub(a)), nil
+	}
+
+	policy, err := existsCollectionPolicy(n.ID().Collection(), getStateFunc)
+	if err != nil {
+		return nil, currency.Amount{}, err
+	}
+
+	shares, rest := CalculateRoyaltyShares(price, policy.Royalty(), n.Creators())
+
+	return shares, rest, nil
+}
+
+type balanceChanges struct {
+	getStateFunc base.GetStateFunc
+	keys         []string
+	balances     map[string]currency.Amount
+}
+
+func newBalanceChanges(getStateFunc base.GetStateFunc) *balanceChanges {
+	return &balanceChanges{
+		getStateFunc: getStateFunc,
+		balances:     map[string]currency.Amount{},
+	}
+}
+
+func (bc *balanceChanges) balance(a base.Address, cid currency.CurrencyID) (currency.Amount, error) {
+	k := currency.StateKeyBalance(a, cid)
+	if am, found := bc.balances[k]; found {
+		return am, nil
+	}
+
+	var am currency.Amount
+	switch st, found, err := bc.getStateFunc(k); {
+	case err != nil:
+		return currency.Amount{}, err
+	case !found:
+		am = currency.NewZeroAmount(cid)
+	default:
+		b, err := currency.StateBalanceValue(st)
+		if err != nil {
+			return currency.Amount{}, err
+		}
+		am = b
+	}
+
+	bc.keys = append(bc.keys, k)
+	bc.balances[k] = am
+
+	return am, nil
+}
+
+func (bc *balanceChanges) add(a base.Address, am currency.Amount) error {
+	if !am.Big().OverZero() {
+		return nil
+	}
+
+	b, err := bc.balance(a, am.Currency())
+	if err != nil {
+		return err
+	}
+
+	bc.balances[currency.StateKeyBalance(a, am.Currency())] = b.WithBig(b.Big().Add(am.Big()))
+
+	return nil
+}
+
+func (bc *balanceChanges) sub(a base.Address, am currency.Amount) error {
+	if !am.Big().OverZero() {
+		return nil
+	}
+
+	b, err := bc.balance(a, am.Currency())
+	if err != nil {
+		return err
+	}
+
+	if b.Big().Compare(am.Big()) < 0 {
+		return errors.Errorf("insufficient balance of %q, %s < %s", a, b.Big(), am.Big())
+	}
+
+	bc.balances[currency.StateKeyBalance(a, am.Currency())] = b.WithBig(b.Big().Sub(am.Big()))
+
+	return nil
+}
+
+func (bc *balanceChanges) subFee(a base.Address, required map[currency.CurrencyID][2]currency.Big) error {
+	for cid, rq := range required {
+		if err := bc.sub(a, currency.NewAmount(rq[0], cid)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (bc *balanceChanges) stateMergeValues() []base.StateMergeValue {
+	sts := make([]base.StateMergeValue, len(bc.keys))
+	for i, k := range bc.keys {
+		sts[i] = currency.NewBalanceStateMergeValue(k, currency.NewBalanceStateValue(bc.balances[k]))
+	}
+
+	return sts
+}
+
+func payNFTPrice(bc *balanceChanges, n nft.NFT, seller base.Address, price currency.Amount, getStateFunc base.GetStateFunc) error {
+	shares, rest, err := royaltyShares(n, price, getStateFunc)
+	if err != nil {
+		return err
+	}
+
+	for _, share := range shares {
+		if err := bc.add(share.Receiver(), share.Amount()); err != nil {
+			return err
+		}
+	}
+
+	return bc.add(seller, rest)
+}
@@ -0,0 +1,19 @@
+package collection
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+)
+
+type RoyaltyShareJSONMarshaler struct {
+	Receiver base.Address    `json:"receiver"`
+	Amount   currency.Amount `json:"amount"`
+}
+
+func (rs RoyaltyShare) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(RoyaltyShareJSONMarshaler{
+		Receiver: rs.receiver,
+		Amount:   rs.amount,
+	})
+}
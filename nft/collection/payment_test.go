@@ -0,0 +1,118 @@
+package collection
+
+import (
+	"testing"
+
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum-nft/nft"
+)
+
+func TestCalculateRoyaltyShares(t *testing.T) {
+	a := currency.NewAddress("creatorA")
+	b := currency.NewAddress("creatorB")
+	c := currency.NewAddress("creatorC")
+
+	cases := []struct {
+		name     string
+		price    int64
+		royalty  nft.PaymentParameter
+		creators nft.Signers
+		shares   []int64
+		rest     int64
+	}{
+		{
+			name:     "single creator",
+			price:    1000,
+			royalty:  10,
+			creators: nft.NewSigners(100, []nft.Signer{nft.NewSigner(a, 100, false)}),
+			shares:   []int64{100},
+			rest:     900,
+		},
+		{
+			name:    "split with remainder",
+			price:   1000,
+			royalty: 10,
+			creators: nft.NewSigners(3, []nft.Signer{
+				nft.NewSigner(a, 1, false),
+				nft.NewSigner(b, 2, false),
+			}),
+			shares: []int64{33, 66},
+			rest:   901,
+		},
+		{
+			name:    "royalty rounded down",
+			price:   999,
+			royalty: 5,
+			creators: nft.NewSigners(100, []nft.Signer{
+				nft.NewSigner(a, 50, false),
+				nft.NewSigner(b, 50, false),
+			}),
+			shares: []int64{24, 24},
+			rest:   951,
+		},
+		{
+			name:    "zero share skipped",
+			price:   100,
+			royalty: 10,
+			creators: nft.NewSigners(100, []nft.Signer{
+				nft.NewSigner(a, 95, false),
+				nft.NewSigner(b, 3, false),
+				nft.NewSigner(c, 2, false),
+			}),
+			shares: []int64{9},
+			rest:   91,
+		},
+		{
+			name:     "zero royalty",
+			price:    1000,
+			royalty:  0,
+			creators: nft.NewSigners(100, []nft.Signer{nft.NewSigner(a, 100, false)}),
+			rest:     1000,
+		},
+		{
+			name:     "no creators",
+			price:    1000,
+			royalty:  10,
+			creators: nft.NewSigners(0, []nft.Signer{}),
+			rest:     1000,
+		},
+		{
+			name:     "zero price",
+			price:    0,
+			royalty:  10,
+			creators: nft.NewSigners(100, []nft.Signer{nft.NewSigner(a, 100, false)}),
+			rest:     0,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			price := currency.NewAmount(currency.NewBig(tc.price), "MCC")
+
+			shares, rest := CalculateRoyaltyShares(price, tc.royalty, tc.creators)
+			if len(shares) != len(tc.shares) {
+				t.Fatalf("shares = %d, expected %d", len(shares), len(tc.shares))
+			}
+
+			paid := currency.ZeroBig
+			for i, s := range shares {
+				if !s.Amount().Big().Equal(currency.NewBig(tc.shares[i])) {
+					t.Fatalf("share %d = %v, expected %d", i, s.Amount().Big(), tc.shares[i])
+				}
+
+				if s.Amount().Currency() != price.Currency() {
+					t.Fatalf("share %d currency = %q, expected %q", i, s.Amount().Currency(), price.Currency())
+				}
+				paid = paid.Add(s.Amount().Big())
+			}
+
+			if !rest.Big().Equal(currency.NewBig(tc.rest)) {
+				t.Fatalf("rest = %v, expected %d", rest.Big(), tc.rest)
+			}
+
+			if !paid.Add(rest.Big()).Equal(price.Big()) {
+				t.Fatal("shares and rest not equal to price")
+			}
+		})
+	}
+}
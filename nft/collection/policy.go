@@ -1,19 +1,16 @@
 package collection
 
 import (
-	"bytes"
 	"regexp"
-	"sort"
 
 	extensioncurrency "github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
 	"github.com/ProtoconNet/mitum-nft/nft"
 	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
 	"github.com/ProtoconNet/mitum2/util/hint"
 )
 
-var MaxWhites = 10
-
 var (
 	MinLengthCollectionName = 3
 	MaxLengthCollectionName = 30
@@ -50,23 +47,41 @@ func (cn CollectionName) String() string {
 	return string(cn)
 }
 
-var CollectionPolicyHint = hint.MustNewHint("mitum-nft-collection-policy-v0.0.1")
+var (
+	CollectionPolicyHint = hint.MustNewHint("mitum-nft-collection-policy-v0.0.2")
+	// LegacyCollectionPolicyHint is the hint of policies stored with inline whites.
+	LegacyCollectionPolicyHint = hint.MustNewHint("mitum-nft-collection-policy-v0.0.1")
+)
 
 type CollectionPolicy struct {
 	hint.BaseHinter
-	name    CollectionName
-	royalty nft.PaymentParameter
-	uri     nft.URI
-	whites  []base.Address
+	name         CollectionName
+	royalty      nft.PaymentParameter
+	uri          nft.URI
+	maxSupply    uint64
+	publicMint   bool
+	mintPrice    currency.Big
+	mintCurrency currency.CurrencyID
+	mintStart    base.Height
+	mintEnd      base.Height
+	transferable bool
+	requireSigns bool
 }
 
-func NewCollectionPolicy(name CollectionName, royalty nft.PaymentParameter, uri nft.URI, whites []base.Address) CollectionPolicy {
+func NewCollectionPolicy(name CollectionName, royalty nft.PaymentParameter, uri nft.URI, maxSupply uint64, publicMint bool, mintPrice currency.Big, mintCurrency currency.CurrencyID, mintStart base.Height, mintEnd base.Height, transferable bool, requireSigns bool) CollectionPolicy {
 	return CollectionPolicy{
-		BaseHinter: hint.NewBaseHinter(CollectionPolicyHint),
-		name:       name,
-		royalty:    royalty,
-		uri:        uri,
-		whites:     whites,
+		BaseHinter:   hint.NewBaseHinter(CollectionPolicyHint),
+		name:         name,
+		royalty:      royalty,
+		uri:          uri,
+		maxSupply:    maxSupply,
+		publicMint:   publicMint,
+		mintPrice:    mintPrice,
+		mintCurrency: mintCurrency,
+		mintStart:    mintStart,
+		mintEnd:      mintEnd,
+		transferable: transferable,
+		requireSigns: requireSigns,
 	}
 }
 
@@ -79,35 +94,67 @@ func (policy CollectionPolicy) IsValid([]byte) error {
 		return err
 	}
 
-	if l := len(policy.whites); l > MaxWhites {
-		return util.ErrInvalid.Errorf("whites over allowed, %d > %d", l, MaxWhites)
+	if policy.maxSupply > nft.MaxNFTIndex {
+		return util.ErrInvalid.Errorf("max supply over max nft index, %d > %d", policy.maxSupply, nft.MaxNFTIndex)
 	}
 
-	founds := map[string]struct{}{}
-	for _, white := range policy.whites {
-		if err := white.IsValid(nil); err != nil {
+	if policy.publicMint {
+		if err := policy.mintCurrency.IsValid(nil); err != nil {
 			return err
 		}
-		if _, found := founds[white.String()]; found {
-			return util.ErrInvalid.Errorf("duplicate white found, %q", white)
+
+		if !policy.mintPrice.OverNil() {
+			return util.ErrInvalid.Errorf("mint price under zero, %q", policy.mintPrice)
 		}
-		founds[white.String()] = struct{}{}
+	} else if policy.mintPrice.OverZero() || len(policy.mintCurrency) > 0 {
+		return util.ErrInvalid.Errorf("mint price set without public mint")
+	}
+
+	if policy.mintStart < 0 || policy.mintEnd < 0 {
+		return util.ErrInvalid.Errorf("mint height under zero, %d, %d", policy.mintStart, policy.mintEnd)
+	}
+
+	if policy.mintStart > 0 && policy.mintEnd > 0 && policy.mintEnd < policy.mintStart {
+		return util.ErrInvalid.Errorf("mint end height under start height, %d < %d", policy.mintEnd, policy.mintStart)
 	}
 
 	return nil
 }
 
 func (policy CollectionPolicy) Bytes() []byte {
-	as := make([][]byte, len(policy.whites))
-	for i, white := range policy.whites {
-		as[i] = white.Bytes()
+	pm := make([]byte, 1)
+	if policy.publicMint {
+		pm[0] = 1
+	} else {
+		pm[0] = 0
+	}
+
+	tf := make([]byte, 1)
+	if policy.transferable {
+		tf[0] = 1
+	} else {
+		tf[0] = 0
+	}
+
+	rs := make([]byte, 1)
+	if policy.requireSigns {
+		rs[0] = 1
+	} else {
+		rs[0] = 0
 	}
 
 	return util.ConcatBytesSlice(
 		policy.name.Bytes(),
 		policy.royalty.Bytes(),
 		policy.uri.Bytes(),
-		util.ConcatBytesSlice(as...),
+		util.Uint64ToBytes(policy.maxSupply),
+		pm,
+		policy.mintPrice.Bytes(),
+		policy.mintCurrency.Bytes(),
+		policy.mintStart.Bytes(),
+		policy.mintEnd.Bytes(),
+		tf,
+		rs,
 	)
 }
 
@@ -123,12 +170,39 @@ func (policy CollectionPolicy) URI() nft.URI {
 	return policy.uri
 }
 
-func (policy CollectionPolicy) Whites() []base.Address {
-	return policy.whites
+func (policy CollectionPolicy) MaxSupply() uint64 {
+	return policy.maxSupply
+}
+
+func (policy CollectionPolicy) PublicMint() bool {
+	return policy.publicMint
+}
+
+func (policy CollectionPolicy) MintPrice() currency.Amount {
+	return currency.NewAmount(policy.mintPrice, policy.mintCurrency)
+}
+
+// MintStart is the first height mint is allowed; 0 means no limit.
+func (policy CollectionPolicy) MintStart() base.Height {
+	return policy.mintStart
+}
+
+// MintEnd is the last height mint is allowed; 0 means no limit.
+func (policy CollectionPolicy) MintEnd() base.Height {
+	return policy.mintEnd
+}
+
+func (policy CollectionPolicy) Transferable() bool {
+	return policy.transferable
+}
+
+// RequireSignatures makes nfts not transferable until all creators and copyrighters sign.
+func (policy CollectionPolicy) RequireSignatures() bool {
+	return policy.requireSigns
 }
 
 func (policy CollectionPolicy) Addresses() ([]base.Address, error) {
-	return policy.whites, nil
+	return []base.Address{}, nil
 }
 
 func (policy CollectionPolicy) Equal(c nft.BasePolicy) bool {
@@ -149,26 +223,23 @@ func (policy CollectionPolicy) Equal(c nft.BasePolicy) bool {
 		return false
 	}
 
-	if len(policy.whites) != len(cpolicy.whites) {
+	if policy.maxSupply != cpolicy.maxSupply {
 		return false
 	}
 
-	whites := policy.Whites()
-	cwhites := cpolicy.Whites()
-	sort.Slice(whites, func(i, j int) bool {
-		return bytes.Compare(whites[j].Bytes(), whites[i].Bytes()) < 0
-	})
-	sort.Slice(cwhites, func(i, j int) bool {
-		return bytes.Compare(cwhites[j].Bytes(), cwhites[i].Bytes()) < 0
-	})
+	if policy.publicMint != cpolicy.publicMint || policy.mintCurrency != cpolicy.mintCurrency || !policy.mintPrice.Equal(cpolicy.mintPrice) {
+		return false
+	}
 
-	for i := range whites {
-		if !whites[i].Equal(cwhites[i]) {
-			return false
-		}
+	if policy.mintStart != cpolicy.mintStart || policy.mintEnd != cpolicy.mintEnd {
+		return false
+	}
+
+	if policy.transferable != cpolicy.transferable {
+		return false
 	}
 
-	return true
+	return policy.requireSigns == cpolicy.requireSigns
 }
 
 var CollectionDesignHint = hint.MustNewHint("mitum-nft-collection-design-v0.0.1")
@@ -3,27 +3,43 @@ package collection
 import (
 	"go.mongodb.org/mongo-driver/bson"
 
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
 	bsonenc "github.com/ProtoconNet/mitum-currency/v2/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
 	"github.com/ProtoconNet/mitum2/util/hint"
 )
 
 func (p CollectionPolicy) MarshalBSON() ([]byte, error) {
 	return bsonenc.Marshal(bson.M{
-		"_hint":   p.Hint().String(),
-		"name":    p.name,
-		"royalty": p.royalty,
-		"uri":     p.uri,
-		"whites":  p.whites,
+		"_hint":              p.Hint().String(),
+		"name":               p.name,
+		"royalty":            p.royalty,
+		"uri":                p.uri,
+		"max_supply":         p.maxSupply,
+		"public_mint":        p.publicMint,
+		"mint_price":         p.mintPrice,
+		"mint_currency":      p.mintCurrency,
+		"mint_start_height":  p.mintStart,
+		"mint_end_height":    p.mintEnd,
+		"transferable":       p.transferable,
+		"require_signatures": p.requireSigns,
 	})
 }
 
 type PolicyBSONUnmarshaler struct {
-	Hint    string   `bson:"_hint"`
-	Name    string   `bson:"name"`
-	Royalty uint     `bson:"royalty"`
-	URI     string   `bson:"uri"`
-	Whites  []string `bson:"whites"`
+	Hint         string       `bson:"_hint"`
+	Name         string       `bson:"name"`
+	Royalty      uint         `bson:"royalty"`
+	URI          string       `bson:"uri"`
+	MaxSupply    uint64       `bson:"max_supply"`
+	PublicMint   bool         `bson:"public_mint"`
+	MintPrice    currency.Big `bson:"mint_price"`
+	MintCurrency string       `bson:"mint_currency"`
+	MintStart    base.Height  `bson:"mint_start_height"`
+	MintEnd      base.Height  `bson:"mint_end_height"`
+	Transferable bool         `bson:"transferable"`
+	RequireSigns bool         `bson:"require_signatures"`
 }
 
 func (p *CollectionPolicy) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -39,5 +55,5 @@ func (p *CollectionPolicy) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
 		return e(err, "")
 	}
 
-	return p.unmarshal(enc, ht, u.Name, u.Royalty, u.URI, u.Whites)
+	return p.unmarshal(enc, ht, u.Name, u.Royalty, u.URI, u.MaxSupply, u.PublicMint, u.MintPrice, u.MintCurrency, u.MintStart, u.MintEnd, u.Transferable, u.RequireSigns)
 }
@@ -1,10 +1,10 @@
 package collection
 
 import (
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
 	"github.com/ProtoconNet/mitum-nft/nft"
 
 	"github.com/ProtoconNet/mitum2/base"
-	"github.com/ProtoconNet/mitum2/util"
 	"github.com/ProtoconNet/mitum2/util/encoder"
 	"github.com/ProtoconNet/mitum2/util/hint"
 )
@@ -15,24 +15,31 @@ func (p *CollectionPolicy) unmarshal(
 	nm string,
 	ry uint,
 	uri string,
-	bws []string,
+	ms uint64,
+	pm bool,
+	mp currency.Big,
+	mc string,
+	st base.Height,
+	ed base.Height,
+	tf bool,
+	rs bool,
 ) error {
-	e := util.StringErrorFunc("failed to unmarshal CollectionPoicy")
-
 	p.BaseHinter = hint.NewBaseHinter(ht)
 	p.name = CollectionName(nm)
 	p.royalty = nft.PaymentParameter(ry)
 	p.uri = nft.URI(uri)
+	p.maxSupply = ms
+	p.publicMint = pm
+	p.mintCurrency = currency.CurrencyID(mc)
+	p.mintStart = st
+	p.mintEnd = ed
+	p.transferable = tf
+	p.requireSigns = rs
 
-	whites := make([]base.Address, len(bws))
-	for i, bw := range bws {
-		white, err := base.DecodeAddress(bw, enc)
-		if err != nil {
-			return e(err, "")
-		}
-		whites[i] = white
+	if mp.Int == nil {
+		mp = currency.ZeroBig
 	}
-	p.whites = whites
+	p.mintPrice = mp
 
 	return nil
 }
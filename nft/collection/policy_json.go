@@ -1,6 +1,7 @@
 package collection
 
 import (
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
 	"github.com/ProtoconNet/mitum-nft/nft"
 
 	"github.com/ProtoconNet/mitum2/base"
@@ -11,28 +12,49 @@ import (
 
 type CollectionPolicyJSONMarshaler struct {
 	hint.BaseHinter
-	Name    CollectionName       `json:"name"`
-	Royalty nft.PaymentParameter `json:"royalty"`
-	URI     nft.URI              `json:"uri"`
-	Whites  []base.Address       `json:"whites"`
+	Name         CollectionName       `json:"name"`
+	Royalty      nft.PaymentParameter `json:"royalty"`
+	URI          nft.URI              `json:"uri"`
+	MaxSupply    uint64               `json:"max_supply"`
+	PublicMint   bool                 `json:"public_mint"`
+	MintPrice    currency.Big         `json:"mint_price"`
+	MintCurrency currency.CurrencyID  `json:"mint_currency"`
+	MintStart    base.Height          `json:"mint_start_height"`
+	MintEnd      base.Height          `json:"mint_end_height"`
+	Transferable bool                 `json:"transferable"`
+	RequireSigns bool                 `json:"require_signatures"`
 }
 
 func (p CollectionPolicy) MarshalJSON() ([]byte, error) {
 	return util.MarshalJSON(CollectionPolicyJSONMarshaler{
-		BaseHinter: p.BaseHinter,
-		Name:       p.name,
-		Royalty:    p.royalty,
-		URI:        p.uri,
-		Whites:     p.whites,
+		BaseHinter:   p.BaseHinter,
+		Name:         p.name,
+		Royalty:      p.royalty,
+		URI:          p.uri,
+		MaxSupply:    p.maxSupply,
+		PublicMint:   p.publicMint,
+		MintPrice:    p.mintPrice,
+		MintCurrency: p.mintCurrency,
+		MintStart:    p.mintStart,
+		MintEnd:      p.mintEnd,
+		Transferable: p.transferable,
+		RequireSigns: p.requireSigns,
 	})
 }
 
 type CollectionPolicyJSONUnmarshaler struct {
-	Hint    hint.Hint `json:"_hint"`
-	Name    string    `json:"name"`
-	Royalty uint      `json:"royalty"`
-	URI     string    `json:"uri"`
-	Whites  []string  `json:"whites"`
+	Hint         hint.Hint    `json:"_hint"`
+	Name         string       `json:"name"`
+	Royalty      uint         `json:"royalty"`
+	URI          string       `json:"uri"`
+	MaxSupply    uint64       `json:"max_supply"`
+	PublicMint   bool         `json:"public_mint"`
+	MintPrice    currency.Big `json:"mint_price"`
+	MintCurrency string       `json:"mint_currency"`
+	MintStart    base.Height  `json:"mint_start_height"`
+	MintEnd      base.Height  `json:"mint_end_height"`
+	Transferable bool         `json:"transferable"`
+	RequireSigns bool         `json:"require_signatures"`
 }
 
 func (p *CollectionPolicy) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
@@ -43,5 +65,5 @@ func (p *CollectionPolicy) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
 		return e(err, "")
 	}
 
-	return p.unmarshal(enc, u.Hint, u.Name, u.Royalty, u.URI, u.Whites)
+	return p.unmarshal(enc, u.Hint, u.Name, u.Royalty, u.URI, u.MaxSupply, u.PublicMint, u.MintPrice, u.MintCurrency, u.MintStart, u.MintEnd, u.Transferable, u.RequireSigns)
 }
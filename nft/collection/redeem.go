@@ -0,0 +1,116 @@
+package collection
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	RedeemFactHint = hint.MustNewHint("mitum-nft-redeem-operation-fact-v0.0.1")
+	RedeemHint     = hint.MustNewHint("mitum-nft-redeem-operation-v0.0.1")
+)
+
+type RedeemFact struct {
+	base.BaseFact
+	sender   base.Address
+	nft      nft.NFTID
+	currency currency.CurrencyID
+}
+
+func NewRedeemFact(
+	token []byte, sender base.Address,
+	n nft.NFTID,
+	currency currency.CurrencyID,
+) RedeemFact {
+	bf := base.NewBaseFact(RedeemFactHint, token)
+
+	fact := RedeemFact{
+		BaseFact: bf,
+		sender:   sender,
+		nft:      n,
+		currency: currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact RedeemFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := currency.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if err := util.CheckIsValiders(
+		nil, false,
+		fact.sender,
+		fact.nft,
+		fact.currency,
+	); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (fact RedeemFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact RedeemFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact RedeemFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.nft.Bytes(),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact RedeemFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact RedeemFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact RedeemFact) NFT() nft.NFTID {
+	return fact.nft
+}
+
+func (fact RedeemFact) Currency() currency.CurrencyID {
+	return fact.currency
+}
+
+func (fact RedeemFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 1)
+	as[0] = fact.sender
+	return as, nil
+}
+
+type Redeem struct {
+	currency.BaseOperation
+}
+
+func NewRedeem(fact RedeemFact) (Redeem, error) {
+	return Redeem{BaseOperation: currency.NewBaseOperation(RedeemHint, fact)}, nil
+}
+
+func (op *Redeem) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package collection
+
+import (
+	"encoding/json"
+
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+)
+
+type RedeemFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender   base.Address        `json:"sender"`
+	NFT      nft.NFTID           `json:"nft"`
+	Currency currency.CurrencyID `json:"currency"`
+}
+
+func (fact RedeemFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(RedeemFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		NFT:                   fact.nft,
+		Currency:              fact.currency,
+	})
+}
+
+type RedeemFactJSONUnmarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender   string          `json:"sender"`
+	NFT      json.RawMessage `json:"nft"`
+	Currency string          `json:"currency"`
+}
+
+func (fact *RedeemFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of RedeemFact")
+
+	var u RedeemFactJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(u.BaseFactJSONUnmarshaler)
+
+	return fact.unmarshal(enc, u.Sender, u.NFT, u.Currency)
+}
+
+type redeemMarshaler struct {
+	currency.BaseOperationJSONMarshaler
+}
+
+func (op Redeem) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(redeemMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *Redeem) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of Redeem")
+
+	var ubo currency.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e(err, "")
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
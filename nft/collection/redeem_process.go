@@ -0,0 +1,221 @@
+package collection
+
+import (
+	"context"
+	"sync"
+
+	extensioncurrency "github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var redeemProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(RedeemProcessor)
+	},
+}
+
+func (Redeem) Process(
+	ctx context.Context, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type RedeemProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewRedeemProcessor() extensioncurrency.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringErrorFunc("failed to create new RedeemProcessor")
+
+		nopp := redeemProcessorPool.Get()
+		opp, ok := nopp.(*RedeemProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected RedeemProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e(err, "")
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *RedeemProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	e := util.StringErrorFunc("failed to preprocess Redeem")
+
+	fact, ok := op.Fact().(RedeemFact)
+	if !ok {
+		return ctx, nil, e(nil, "expected RedeemFact, not %T", op.Fact())
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, nil, e(err, "")
+	}
+
+	if err := checkExistsState(currency.StateKeyAccount(fact.Sender()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("sender not found, %q: %w", fact.Sender(), err), nil
+	}
+
+	if err := checkNotExistsState(extensioncurrency.StateKeyContractAccount(fact.Sender()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("contract account cannot redeem nfts, %q: %w", fact.Sender(), err), nil
+	}
+
+	if err := checkFactSignsByState(fact.Sender(), op.Signs(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("invalid signing: %w", err), nil
+	}
+
+	nid := fact.NFT()
+
+	st, err := existsState(StateKeyFraction(nid), "key of fraction", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("fraction not found, %q: %w", nid, err), nil
+	}
+
+	fr, err := StateFractionValue(st)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("fraction value not found, %q: %w", nid, err), nil
+	}
+
+	if !fr.Active() {
+		return nil, base.NewBaseOperationProcessReasonError("already redeemed nft, %q", nid), nil
+	}
+
+	st, err = existsState(StateKeyNFT(nid), "key of nft", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("nft not found, %q: %w", nid, err), nil
+	}
+
+	nv, err := StateNFTValue(st)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("nft value not found, %q: %w", nid, err), nil
+	}
+
+	if !nv.Owner().Equal(fr.Vault()) {
+		return nil, base.NewBaseOperationProcessReasonError("nft not locked in vault, %q, %q", nid, fr.Vault()), nil
+	}
+
+	if err := checkNotFrozen(nid, getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("nft not available, %q: %w", nid, err), nil
+	}
+
+	if err := checkChildrenMovable(nid, getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("nft not available, %q: %w", nid, err), nil
+	}
+
+	st, err = existsState(currency.StateKeyBalance(fact.Sender(), fr.Share()), "key of share balance", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("share balance not found, %q: %w", fact.Sender(), err), nil
+	}
+
+	switch b, err := currency.StateBalanceValue(st); {
+	case err != nil:
+		return nil, base.NewBaseOperationProcessReasonError("failed to get share balance value, %q: %w", fact.Sender(), err), nil
+	case b.Big().Compare(fr.Supply()) < 0:
+		return nil, base.NewBaseOperationProcessReasonError("sender does not hold full supply of shares, %q; %s < %s", fr.Share(), b.Big(), fr.Supply()), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *RedeemProcessor) Process(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	e := util.StringErrorFunc("failed to process Redeem")
+
+	fact, ok := op.Fact().(RedeemFact)
+	if !ok {
+		return nil, nil, e(nil, "expected RedeemFact, not %T", op.Fact())
+	}
+
+	nid := fact.NFT()
+
+	st, err := existsState(StateKeyFraction(nid), "key of fraction", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("fraction not found, %q: %w", nid, err), nil
+	}
+
+	fr, err := StateFractionValue(st)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("fraction value not found, %q: %w", nid, err), nil
+	}
+
+	st, err = existsState(StateKeyNFT(nid), "key of nft", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("nft not found, %q: %w", nid, err), nil
+	}
+
+	nv, err := StateNFTValue(st)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("nft value not found, %q: %w", nid, err), nil
+	}
+
+	n := nft.NewNFT(nid, nv.Active(), fact.Sender(), nv.NFTHash(), nv.URI(), fact.Sender(), nv.Creators(), nv.Copyrighters(), fact.Sender(), 0, nv.Royalty(), nv.RoyaltyReceiver())
+	if err := n.IsValid(nil); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("invalid nft, %q: %w", nid, err), nil
+	}
+
+	rf := NewFraction(nid, fr.Vault(), fr.Share(), fr.Supply(), false)
+
+	sts := []base.StateMergeValue{
+		NewNFTStateMergeValue(st.Key(), NewNFTStateValue(n)),
+		NewFractionStateMergeValue(StateKeyFraction(nid), NewFractionStateValue(rf)),
+		NewFractionStateMergeValue(StateKeyShareFraction(fr.Share()), NewFractionStateValue(rf)),
+	}
+
+	s, err := moveChildren(nid, fact.Sender(), getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to move children, %q: %w", nid, err), nil
+	}
+	sts = append(sts, s...)
+
+	currencyPolicy, err := existsCurrencyPolicy(fact.Currency(), getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("currency not found, %q: %w", fact.Currency(), err), nil
+	}
+
+	fee, err := currencyPolicy.Feeer().Fee(currency.ZeroBig)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to check fee of currency, %q: %w", fact.Currency(), err), nil
+	}
+
+	balances := newBalanceChanges(getStateFunc)
+	// shares are locked in the vault instead of burned, so the supply of the share currency keeps matching balances.
+	shares := currency.NewAmount(fr.Supply(), fr.Share())
+	if err := balances.sub(fact.Sender(), shares); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to lock shares, %q: %w", fr.Share(), err), nil
+	}
+	if err := balances.add(fr.Vault(), shares); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to lock shares, %q: %w", fr.Share(), err), nil
+	}
+	if err := balances.sub(fact.Sender(), currency.NewAmount(fee, fact.Currency())); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to check enough balance: %w", err), nil
+	}
+
+	sts = append(sts, balances.stateMergeValues()...)
+
+	return sts, nil, nil
+}
+
+func (opp *RedeemProcessor) Close() error {
+	redeemProcessorPool.Put(opp)
+
+	return nil
+}
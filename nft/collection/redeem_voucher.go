@@ -0,0 +1,111 @@
+package collection
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	RedeemVoucherFactHint = hint.MustNewHint("mitum-nft-redeem-voucher-operation-fact-v0.0.1")
+	RedeemVoucherHint     = hint.MustNewHint("mitum-nft-redeem-voucher-operation-v0.0.1")
+)
+
+type RedeemVoucherFact struct {
+	base.BaseFact
+	sender   base.Address
+	voucher  Voucher
+	currency currency.CurrencyID
+}
+
+func NewRedeemVoucherFact(token []byte, sender base.Address, voucher Voucher, currency currency.CurrencyID) RedeemVoucherFact {
+	bf := base.NewBaseFact(RedeemVoucherFactHint, token)
+
+	fact := RedeemVoucherFact{
+		BaseFact: bf,
+		sender:   sender,
+		voucher:  voucher,
+		currency: currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact RedeemVoucherFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := currency.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if err := util.CheckIsValiders(nil, false, fact.sender, fact.currency); err != nil {
+		return err
+	}
+
+	if err := fact.voucher.IsValid(b); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (fact RedeemVoucherFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact RedeemVoucherFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact RedeemVoucherFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.voucher.Bytes(),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact RedeemVoucherFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact RedeemVoucherFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact RedeemVoucherFact) Voucher() Voucher {
+	return fact.voucher
+}
+
+func (fact RedeemVoucherFact) Currency() currency.CurrencyID {
+	return fact.currency
+}
+
+func (fact RedeemVoucherFact) Addresses() ([]base.Address, error) {
+	as := []base.Address{fact.sender, fact.voucher.Signer()}
+	as = append(as, fact.voucher.Creators().Addresses()...)
+
+	return as, nil
+}
+
+type RedeemVoucher struct {
+	currency.BaseOperation
+}
+
+func NewRedeemVoucher(fact RedeemVoucherFact) (RedeemVoucher, error) {
+	return RedeemVoucher{BaseOperation: currency.NewBaseOperation(RedeemVoucherHint, fact)}, nil
+}
+
+func (op *RedeemVoucher) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
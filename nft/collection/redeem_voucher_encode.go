@@ -0,0 +1,30 @@
+package collection
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+func (fact *RedeemVoucherFact) unmarshal(enc encoder.Encoder, sd string, bv []byte, cid string) error {
+	e := util.StringErrorFunc("failed to unmarshal RedeemVoucherFact")
+
+	sender, err := base.DecodeAddress(sd, enc)
+	if err != nil {
+		return e(err, "")
+	}
+	fact.sender = sender
+
+	if hinter, err := enc.Decode(bv); err != nil {
+		return e(err, "")
+	} else if v, ok := hinter.(Voucher); !ok {
+		return e(util.ErrWrongType.Errorf("expected Voucher, not %T", hinter), "")
+	} else {
+		fact.voucher = v
+	}
+
+	fact.currency = currency.CurrencyID(cid)
+
+	return nil
+}
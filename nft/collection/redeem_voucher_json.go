@@ -0,0 +1,69 @@
+package collection
+
+import (
+	"encoding/json"
+
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+)
+
+type RedeemVoucherFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender   base.Address        `json:"sender"`
+	Voucher  Voucher             `json:"voucher"`
+	Currency currency.CurrencyID `json:"currency"`
+}
+
+func (fact RedeemVoucherFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(RedeemVoucherFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Voucher:               fact.voucher,
+		Currency:              fact.currency,
+	})
+}
+
+type RedeemVoucherFactJSONUnmarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender   string          `json:"sender"`
+	Voucher  json.RawMessage `json:"voucher"`
+	Currency string          `json:"currency"`
+}
+
+func (fact *RedeemVoucherFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of RedeemVoucherFact")
+
+	var uf RedeemVoucherFactJSONUnmarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e(err, "")
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	return fact.unmarshal(enc, uf.Sender, uf.Voucher, uf.Currency)
+}
+
+type redeemVoucherMarshaler struct {
+	currency.BaseOperationJSONMarshaler
+}
+
+func (op RedeemVoucher) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(redeemVoucherMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *RedeemVoucher) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of RedeemVoucher")
+
+	var ubo currency.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e(err, "")
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
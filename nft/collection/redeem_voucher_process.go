@@ -0,0 +1,266 @@
+package collection
+
+import (
+	"context"
+	"sync"
+
+	extensioncurrency "github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var redeemVoucherProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(RedeemVoucherProcessor)
+	},
+}
+
+func (RedeemVoucher) Process(
+	ctx context.Context, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type RedeemVoucherProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewRedeemVoucherProcessor() extensioncurrency.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringErrorFunc("failed to create new RedeemVoucherProcessor")
+
+		nopp := redeemVoucherProcessorPool.Get()
+		opp, ok := nopp.(*RedeemVoucherProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected RedeemVoucherProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e(err, "")
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *RedeemVoucherProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	e := util.StringErrorFunc("failed to preprocess RedeemVoucher")
+
+	fact, ok := op.Fact().(RedeemVoucherFact)
+	if !ok {
+		return ctx, nil, e(nil, "expected RedeemVoucherFact, not %T", op.Fact())
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, nil, e(err, "")
+	}
+
+	if err := checkExistsState(currency.StateKeyAccount(fact.Sender()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("sender not found, %q: %w", fact.Sender(), err), nil
+	}
+
+	if err := checkNotExistsState(extensioncurrency.StateKeyContractAccount(fact.Sender()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("contract account cannot redeem vouchers, %q: %w", fact.Sender(), err), nil
+	}
+
+	if err := checkFactSignsByState(fact.Sender(), op.Signs(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("invalid signing: %w", err), nil
+	}
+
+	v := fact.Voucher()
+
+	if err := checkFactSignsByState(v.Signer(), v.Signs(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("invalid voucher signing: %w", err), nil
+	}
+
+	if err := checkNotExistsState(StateKeyUsedVoucher(v.Collection(), v.Signer(), v.Nonce()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("voucher already used, %q, %d: %w", v.Signer(), v.Nonce(), err), nil
+	}
+
+	if _, err := existsCurrencyPolicy(v.Price().Currency(), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("currency of price not found, %q: %w", v.Price().Currency(), err), nil
+	}
+
+	if err := checkActiveCollection(v.Collection(), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("collection not available, %q: %w", v.Collection(), err), nil
+	}
+
+	policy, err := existsCollectionPolicy(v.Collection(), getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("collection policy not found, %q: %w", v.Collection(), err), nil
+	}
+
+	if h := opp.Height(); (policy.MintStart() > 0 && h < policy.MintStart()) || (policy.MintEnd() > 0 && h > policy.MintEnd()) {
+		return nil, base.NewBaseOperationProcessReasonError("out of mint window, %q; %d not in [%d, %d]", v.Collection(), h, policy.MintStart(), policy.MintEnd()), nil
+	}
+
+	white, isWhite, err := whiteOf(v.Collection(), v.Signer(), getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to get white, %q: %w", v.Collection(), err), nil
+	}
+
+	if !isWhite {
+		return nil, base.NewBaseOperationProcessReasonError("voucher signer not in whitelist, %q", v.Signer()), nil
+	}
+
+	if white.Quota() > 0 {
+		minted, err := mintCount(v.Collection(), v.Signer(), getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("failed to get mint count, %q: %w", v.Collection(), err), nil
+		}
+
+		if minted+1 > white.Quota() {
+			return nil, base.NewBaseOperationProcessReasonError("mint quota of signer exceeded, %q, %q; %d", v.Collection(), v.Signer(), white.Quota()), nil
+		}
+	}
+
+	st, err := existsState(StateKeyCollectionLastNFTIndex(v.Collection()), "key of collection index", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("collection last index not found, %q: %w", v.Collection(), err), nil
+	}
+
+	idx, err := StateCollectionLastNFTIndexValue(st)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("collection last index value not found, %q: %w", v.Collection(), err), nil
+	}
+
+	if ms := policy.MaxSupply(); ms > 0 && idx+1 > ms {
+		return nil, base.NewBaseOperationProcessReasonError("max supply of collection reached, %q; %d", v.Collection(), ms), nil
+	}
+
+	for _, creator := range v.Creators().Signers() {
+		acc := creator.Account()
+		if err := checkExistsState(currency.StateKeyAccount(acc), getStateFunc); err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("creator not found, %q: %w", acc, err), nil
+		}
+		if err := checkNotExistsState(extensioncurrency.StateKeyContractAccount(acc), getStateFunc); err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("contract account cannot be a creator, %q: %w", acc, err), nil
+		}
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *RedeemVoucherProcessor) Process( // nolint:dupl
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	e := util.StringErrorFunc("failed to process RedeemVoucher")
+
+	fact, ok := op.Fact().(RedeemVoucherFact)
+	if !ok {
+		return nil, nil, e(nil, "expected RedeemVoucherFact, not %T", op.Fact())
+	}
+
+	v := fact.Voucher()
+
+	st, err := existsState(StateKeyCollectionLastNFTIndex(v.Collection()), "key of collection index", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("collection last index not found, %q: %w", v.Collection(), err), nil
+	}
+
+	idx, err := StateCollectionLastNFTIndexValue(st)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("collection last index value not found, %q: %w", v.Collection(), err), nil
+	}
+	idx++
+
+	id := nft.NewNFTID(v.Collection(), idx)
+	if err := id.IsValid(nil); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("invalid nft id, %q: %w", id, err), nil
+	}
+
+	if err := checkNotExistsState(StateKeyNFT(id), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("nft already exists, %q: %w", id, err), nil
+	}
+
+	n := nft.NewNFT(id, true, fact.Sender(), v.NFTHash(), v.URI(), fact.Sender(), v.Creators(), nft.NewSigners(0, []nft.Signer{}), fact.Sender(), 0, 0, nil)
+	if err := n.IsValid(nil); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("invalid nft, %q: %w", id, err), nil
+	}
+
+	var box NFTBox
+	switch st, found, err := getStateFunc(StateKeyNFTBox(v.Collection())); {
+	case err != nil:
+		return nil, base.NewBaseOperationProcessReasonError("failed to get nft box state, %q: %w", v.Collection(), err), nil
+	case !found:
+		box = NewNFTBox(nil)
+	default:
+		b, err := StateNFTBoxValue(st)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("failed to get nft box state value, %q: %w", v.Collection(), err), nil
+		}
+		box = b
+	}
+
+	if err := box.Append(id); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to append nft id to nft box, %q: %w", id, err), nil
+	}
+
+	minted, err := mintCount(v.Collection(), v.Signer(), getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to get mint count, %q: %w", v.Collection(), err), nil
+	}
+
+	sts := []base.StateMergeValue{
+		NewNFTStateMergeValue(StateKeyNFT(id), NewNFTStateValue(n)),
+		NewNFTBoxStateMergeValue(StateKeyNFTBox(v.Collection()), NewNFTBoxStateValue(box)),
+		NewCollectionLastNFTIndexStateMergeValue(
+			StateKeyCollectionLastNFTIndex(v.Collection()),
+			NewCollectionLastNFTIndexStateValue(v.Collection(), idx),
+		),
+		NewMintCountStateMergeValue(
+			StateKeyMintCount(v.Collection(), v.Signer()),
+			NewMintCountStateValue(v.Collection(), v.Signer(), minted+1),
+		),
+		NewUsedVoucherStateMergeValue(
+			StateKeyUsedVoucher(v.Collection(), v.Signer(), v.Nonce()),
+			NewUsedVoucherStateValue(v.Collection(), v.Signer(), v.Nonce()),
+		),
+	}
+
+	currencyPolicy, err := existsCurrencyPolicy(fact.Currency(), getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("currency not found, %q: %w", fact.Currency(), err), nil
+	}
+
+	fee, err := currencyPolicy.Feeer().Fee(currency.ZeroBig)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to check fee of currency, %q: %w", fact.Currency(), err), nil
+	}
+
+	balances := newBalanceChanges(getStateFunc)
+	if err := balances.sub(fact.Sender(), v.Price()); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to pay voucher price: %w", err), nil
+	}
+	if err := balances.add(v.Signer(), v.Price()); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to pay voucher price, %q: %w", v.Signer(), err), nil
+	}
+	if err := balances.sub(fact.Sender(), currency.NewAmount(fee, fact.Currency())); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to check enough balance: %w", err), nil
+	}
+
+	sts = append(sts, balances.stateMergeValues()...)
+
+	return sts, nil, nil
+}
+
+func (opp *RedeemVoucherProcessor) Close() error {
+	redeemVoucherProcessorPool.Put(opp)
+
+	return nil
+}
@@ -0,0 +1,148 @@
+package collection
+
+import (
+	extensioncurrency "github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	RemoveWhitesFactHint = hint.MustNewHint("mitum-nft-remove-whites-operation-fact-v0.0.1")
+	RemoveWhitesHint     = hint.MustNewHint("mitum-nft-remove-whites-operation-v0.0.1")
+)
+
+type RemoveWhitesFact struct {
+	base.BaseFact
+	sender     base.Address
+	collection extensioncurrency.ContractID
+	whites     []base.Address
+	currency   currency.CurrencyID
+}
+
+func NewRemoveWhitesFact(
+	token []byte, sender base.Address,
+	collection extensioncurrency.ContractID,
+	whites []base.Address,
+	currency currency.CurrencyID,
+) RemoveWhitesFact {
+	bf := base.NewBaseFact(RemoveWhitesFactHint, token)
+
+	fact := RemoveWhitesFact{
+		BaseFact:   bf,
+		sender:     sender,
+		collection: collection,
+		whites:     whites,
+		currency:   currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact RemoveWhitesFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := currency.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if err := util.CheckIsValiders(
+		nil, false,
+		fact.sender,
+		fact.collection,
+		fact.currency,
+	); err != nil {
+		return err
+	}
+
+	if l := len(fact.whites); l < 1 {
+		return util.ErrInvalid.Errorf("empty whites for RemoveWhitesFact")
+	} else if l > MaxWhites {
+		return util.ErrInvalid.Errorf("whites over allowed, %d > %d", l, MaxWhites)
+	}
+
+	founds := map[string]struct{}{}
+	for _, white := range fact.whites {
+		if err := white.IsValid(nil); err != nil {
+			return err
+		}
+
+		if _, found := founds[white.String()]; found {
+			return util.ErrInvalid.Errorf("duplicate white found, %q", white)
+		}
+		founds[white.String()] = struct{}{}
+	}
+
+	return nil
+}
+
+func (fact RemoveWhitesFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact RemoveWhitesFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact RemoveWhitesFact) Bytes() []byte {
+	ws := make([][]byte, len(fact.whites))
+	for i, white := range fact.whites {
+		ws[i] = white.Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.collection.Bytes(),
+		util.ConcatBytesSlice(ws...),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact RemoveWhitesFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact RemoveWhitesFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact RemoveWhitesFact) Collection() extensioncurrency.ContractID {
+	return fact.collection
+}
+
+func (fact RemoveWhitesFact) Whites() []base.Address {
+	return fact.whites
+}
+
+func (fact RemoveWhitesFact) Currency() currency.CurrencyID {
+	return fact.currency
+}
+
+func (fact RemoveWhitesFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, len(fact.whites)+1)
+	as[0] = fact.sender
+	copy(as[1:], fact.whites)
+	return as, nil
+}
+
+type RemoveWhites struct {
+	currency.BaseOperation
+}
+
+func NewRemoveWhites(fact RemoveWhitesFact) (RemoveWhites, error) {
+	return RemoveWhites{BaseOperation: currency.NewBaseOperation(RemoveWhitesHint, fact)}, nil
+}
+
+func (op *RemoveWhites) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package collection
+
+import (
+	extensioncurrency "github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+func (fact *RemoveWhitesFact) unmarshal(
+	enc encoder.Encoder,
+	sd string,
+	col string,
+	bws []string,
+	cid string,
+) error {
+	e := util.StringErrorFunc("failed to unmarshal RemoveWhitesFact")
+
+	fact.collection = extensioncurrency.ContractID(col)
+	fact.currency = currency.CurrencyID(cid)
+
+	sender, err := base.DecodeAddress(sd, enc)
+	if err != nil {
+		return e(err, "")
+	}
+	fact.sender = sender
+
+	whites := make([]base.Address, len(bws))
+	for i, bw := range bws {
+		white, err := base.DecodeAddress(bw, enc)
+		if err != nil {
+			return e(err, "")
+		}
+		whites[i] = white
+	}
+	fact.whites = whites
+
+	return nil
+}
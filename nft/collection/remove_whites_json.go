@@ -0,0 +1,71 @@
+package collection
+
+import (
+	extensioncurrency "github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+)
+
+type RemoveWhitesFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender     base.Address                 `json:"sender"`
+	Collection extensioncurrency.ContractID `json:"collection"`
+	Whites     []base.Address               `json:"whites"`
+	Currency   currency.CurrencyID          `json:"currency"`
+}
+
+func (fact RemoveWhitesFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(RemoveWhitesFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Collection:            fact.collection,
+		Whites:                fact.whites,
+		Currency:              fact.currency,
+	})
+}
+
+type RemoveWhitesFactJSONUnmarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender     string   `json:"sender"`
+	Collection string   `json:"collection"`
+	Whites     []string `json:"whites"`
+	Currency   string   `json:"currency"`
+}
+
+func (fact *RemoveWhitesFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of RemoveWhitesFact")
+
+	var u RemoveWhitesFactJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(u.BaseFactJSONUnmarshaler)
+
+	return fact.unmarshal(enc, u.Sender, u.Collection, u.Whites, u.Currency)
+}
+
+type removeWhitesMarshaler struct {
+	currency.BaseOperationJSONMarshaler
+}
+
+func (op RemoveWhites) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(removeWhitesMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *RemoveWhites) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of RemoveWhites")
+
+	var ubo currency.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e(err, "")
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,155 @@
+package collection
+
+import (
+	"context"
+	"sync"
+
+	extensioncurrency "github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var removeWhitesProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(RemoveWhitesProcessor)
+	},
+}
+
+func (RemoveWhites) Process(
+	ctx context.Context, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type RemoveWhitesProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewRemoveWhitesProcessor() extensioncurrency.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringErrorFunc("failed to create new RemoveWhitesProcessor")
+
+		nopp := removeWhitesProcessorPool.Get()
+		opp, ok := nopp.(*RemoveWhitesProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected RemoveWhitesProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e(err, "")
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *RemoveWhitesProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	e := util.StringErrorFunc("failed to preprocess RemoveWhites")
+
+	fact, ok := op.Fact().(RemoveWhitesFact)
+	if !ok {
+		return ctx, nil, e(nil, "expected RemoveWhitesFact, not %T", op.Fact())
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, nil, e(err, "")
+	}
+
+	if err := checkExistsState(currency.StateKeyAccount(fact.Sender()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("sender not found, %q: %w", fact.Sender(), err), nil
+	}
+
+	if err := checkNotExistsState(extensioncurrency.StateKeyContractAccount(fact.Sender()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("contract account cannot update collection policy, %q: %w", fact.Sender(), err), nil
+	}
+
+	if err := checkFactSignsByState(fact.Sender(), op.Signs(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("invalid signing: %w", err), nil
+	}
+
+	if err := checkExistsState(extensioncurrency.StateKeyCurrencyDesign(fact.Currency()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("currency not found, %q: %w", fact.Currency(), err), nil
+	}
+
+	if err := checkCollectionCreator(fact.Collection(), fact.Sender(), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("collection not available, %q: %w", fact.Collection(), err), nil
+	}
+
+	for _, white := range fact.Whites() {
+		switch _, isWhite, err := whiteOf(fact.Collection(), white, getStateFunc); {
+		case err != nil:
+			return nil, base.NewBaseOperationProcessReasonError("failed to get white, %q: %w", white, err), nil
+		case !isWhite:
+			return nil, base.NewBaseOperationProcessReasonError("white not found, %q", white), nil
+		}
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *RemoveWhitesProcessor) Process(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	e := util.StringErrorFunc("failed to process RemoveWhites")
+
+	fact, ok := op.Fact().(RemoveWhitesFact)
+	if !ok {
+		return nil, nil, e(nil, "expected RemoveWhitesFact, not %T", op.Fact())
+	}
+
+	if err := checkCollectionCreator(fact.Collection(), fact.Sender(), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("collection not available, %q: %w", fact.Collection(), err), nil
+	}
+
+	sts := make([]base.StateMergeValue, 0, len(fact.Whites())+1)
+	for _, account := range fact.Whites() {
+		white, _, err := whiteOf(fact.Collection(), account, getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("failed to get white, %q: %w", account, err), nil
+		}
+
+		sts = append(sts, NewWhiteStateMergeValue(
+			StateKeyWhite(fact.Collection(), account),
+			NewWhiteStateValue(fact.Collection(), NewWhite(account, white.Quota()), false),
+		))
+	}
+
+	currencyPolicy, err := existsCurrencyPolicy(fact.Currency(), getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("currency not found, %q: %w", fact.Currency(), err), nil
+	}
+
+	fee, err := currencyPolicy.Feeer().Fee(currency.ZeroBig)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to check fee of currency, %q: %w", fact.Currency(), err), nil
+	}
+
+	balances := newBalanceChanges(getStateFunc)
+	if err := balances.sub(fact.Sender(), currency.NewAmount(fee, fact.Currency())); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to check enough balance: %w", err), nil
+	}
+
+	sts = append(sts, balances.stateMergeValues()...)
+
+	return sts, nil, nil
+}
+
+func (opp *RemoveWhitesProcessor) Close() error {
+	removeWhitesProcessorPool.Put(opp)
+
+	return nil
+}
@@ -0,0 +1,136 @@
+package collection
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var MaxSetUserItems = 10
+
+var (
+	SetUserFactHint = hint.MustNewHint("mitum-nft-set-user-operation-fact-v0.0.1")
+	SetUserHint     = hint.MustNewHint("mitum-nft-set-user-operation-v0.0.1")
+)
+
+type SetUserFact struct {
+	base.BaseFact
+	sender base.Address
+	items  []SetUserItem
+}
+
+func NewSetUserFact(token []byte, sender base.Address, items []SetUserItem) SetUserFact {
+	bf := base.NewBaseFact(SetUserFactHint, token)
+	fact := SetUserFact{
+		BaseFact: bf,
+		sender:   sender,
+		items:    items,
+	}
+
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact SetUserFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := currency.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if l := len(fact.items); l < 1 {
+		return util.ErrInvalid.Errorf("empty items for SetUserFact")
+	} else if l > int(MaxSetUserItems) {
+		return util.ErrInvalid.Errorf("items over allowed, %d > %d", l, MaxSetUserItems)
+	}
+
+	if err := fact.sender.IsValid(nil); err != nil {
+		return err
+	}
+
+	founds := map[string]struct{}{}
+	for _, item := range fact.items {
+		if err := item.IsValid(nil); err != nil {
+			return err
+		}
+
+		n := item.NFT()
+		if err := n.IsValid(nil); err != nil {
+			return err
+		}
+
+		if _, found := founds[n.String()]; found {
+			return util.ErrInvalid.Errorf("duplicate nft found, %q", n)
+		}
+
+		founds[n.String()] = struct{}{}
+	}
+
+	return nil
+}
+
+func (fact SetUserFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact SetUserFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact SetUserFact) Bytes() []byte {
+	is := make([][]byte, len(fact.items))
+
+	for i, item := range fact.items {
+		is[i] = item.Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		util.ConcatBytesSlice(is...),
+	)
+}
+
+func (fact SetUserFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact SetUserFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact SetUserFact) Items() []SetUserItem {
+	return fact.items
+}
+
+func (fact SetUserFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, len(fact.items)+1)
+
+	for i := range fact.items {
+		as[i] = fact.items[i].User()
+	}
+	as[len(fact.items)] = fact.sender
+
+	return as, nil
+}
+
+type SetUser struct {
+	currency.BaseOperation
+}
+
+func NewSetUser(fact SetUserFact) (SetUser, error) {
+	return SetUser{BaseOperation: currency.NewBaseOperation(SetUserHint, fact)}, nil
+}
+
+func (op *SetUser) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package collection
+
+import (
+	"github.com/ProtoconNet/mitum-nft/nft"
+
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+var SetUserItemHint = hint.MustNewHint("mitum-nft-set-user-item-v0.0.1")
+
+type SetUserItem struct {
+	hint.BaseHinter
+	user     base.Address
+	expiry   base.Height
+	nft      nft.NFTID
+	currency currency.CurrencyID
+}
+
+func NewSetUserItem(user base.Address, expiry base.Height, n nft.NFTID, currency currency.CurrencyID) SetUserItem {
+	return SetUserItem{
+		BaseHinter: hint.NewBaseHinter(SetUserItemHint),
+		user:       user,
+		expiry:     expiry,
+		nft:        n,
+		currency:   currency,
+	}
+}
+
+func (it SetUserItem) IsValid([]byte) error {
+	if err := util.CheckIsValiders(nil, false,
+		it.BaseHinter,
+		it.user,
+		it.nft,
+		it.currency,
+	); err != nil {
+		return err
+	}
+
+	if it.expiry < 0 {
+		return util.ErrInvalid.Errorf("user expiry under zero, %d", it.expiry)
+	}
+
+	return nil
+}
+
+func (it SetUserItem) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		it.user.Bytes(),
+		it.expiry.Bytes(),
+		it.nft.Bytes(),
+		it.currency.Bytes(),
+	)
+}
+
+func (it SetUserItem) User() base.Address {
+	return it.user
+}
+
+func (it SetUserItem) Expiry() base.Height {
+	return it.expiry
+}
+
+func (it SetUserItem) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 1)
+	as[0] = it.user
+	return as, nil
+}
+
+func (it SetUserItem) NFT() nft.NFTID {
+	return it.nft
+}
+
+func (it SetUserItem) Currency() currency.CurrencyID {
+	return it.currency
+}
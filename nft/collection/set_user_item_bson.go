@@ -0,0 +1,45 @@
+package collection
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	bsonenc "github.com/ProtoconNet/mitum-currency/v2/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (it SetUserItem) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":    it.Hint().String(),
+			"user":     it.user,
+			"expiry":   it.expiry,
+			"nft":      it.nft,
+			"currency": it.currency,
+		})
+}
+
+type SetUserItemBSONUnmarshaler struct {
+	Hint     string      `bson:"_hint"`
+	User     string      `bson:"user"`
+	Expiry   base.Height `bson:"expiry"`
+	NFT      bson.Raw    `bson:"nft"`
+	Currency string      `bson:"currency"`
+}
+
+func (it *SetUserItem) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode bson of SetUserItem")
+
+	var u SetUserItemBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e(err, "")
+	}
+
+	return it.unmarshal(enc, ht, u.User, u.Expiry, u.NFT, u.Currency)
+}
@@ -0,0 +1,42 @@
+package collection
+
+import (
+	"github.com/ProtoconNet/mitum-nft/nft"
+
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (it *SetUserItem) unmarshal(
+	enc encoder.Encoder,
+	ht hint.Hint,
+	us string,
+	ex base.Height,
+	bn []byte,
+	cid string,
+) error {
+	e := util.StringErrorFunc("failed to unmarshal SetUserItem")
+
+	it.BaseHinter = hint.NewBaseHinter(ht)
+	it.expiry = ex
+	it.currency = currency.CurrencyID(cid)
+
+	user, err := base.DecodeAddress(us, enc)
+	if err != nil {
+		return e(err, "")
+	}
+	it.user = user
+
+	if hinter, err := enc.Decode(bn); err != nil {
+		return e(err, "")
+	} else if n, ok := hinter.(nft.NFTID); !ok {
+		return e(util.ErrWrongType.Errorf("expected NFTID, not %T", hinter), "")
+	} else {
+		it.nft = n
+	}
+
+	return nil
+}
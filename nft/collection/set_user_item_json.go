@@ -0,0 +1,50 @@
+package collection
+
+import (
+	"encoding/json"
+
+	"github.com/ProtoconNet/mitum-nft/nft"
+
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+type SetUserItemJSONMarshaler struct {
+	hint.BaseHinter
+	User     base.Address        `json:"user"`
+	Expiry   base.Height         `json:"expiry"`
+	NFT      nft.NFTID           `json:"nft"`
+	Currency currency.CurrencyID `json:"currency"`
+}
+
+func (it SetUserItem) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(SetUserItemJSONMarshaler{
+		BaseHinter: it.BaseHinter,
+		User:       it.user,
+		Expiry:     it.expiry,
+		NFT:        it.nft,
+		Currency:   it.currency,
+	})
+}
+
+type SetUserItemJSONUnmarshaler struct {
+	Hint     hint.Hint       `json:"_hint"`
+	User     string          `json:"user"`
+	Expiry   base.Height     `json:"expiry"`
+	NFT      json.RawMessage `json:"nft"`
+	Currency string          `json:"currency"`
+}
+
+func (it *SetUserItem) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of SetUserItem")
+
+	var u SetUserItemJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	return it.unmarshal(enc, u.Hint, u.User, u.Expiry, u.NFT, u.Currency)
+}
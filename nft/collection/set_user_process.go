@@ -0,0 +1,303 @@
+package collection
+
+import (
+	"context"
+	"sync"
+
+	extensioncurrency "github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var setUserItemProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(SetUserItemProcessor)
+	},
+}
+
+var setUserProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(SetUserProcessor)
+	},
+}
+
+func (SetUser) Process(
+	ctx context.Context, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type SetUserItemProcessor struct {
+	h      util.Hash
+	sender base.Address
+	item   SetUserItem
+	height base.Height
+}
+
+func (ipp *SetUserItemProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) error {
+	if err := checkExistsState(currency.StateKeyAccount(ipp.item.User()), getStateFunc); err != nil {
+		return errors.Errorf("user not found, %q: %w", ipp.item.User(), err)
+	}
+
+	nid := ipp.item.NFT()
+
+	st, err := existsState(StateKeyCollectionDesign(nid.Collection()), "key of design", getStateFunc)
+	if err != nil {
+		return errors.Errorf("collection design not found, %q: %w", nid.Collection(), err)
+	}
+
+	design, err := StateCollectionDesignValue(st)
+	if err != nil {
+		return errors.Errorf("collection design value not found, %q: %w", nid.Collection(), err)
+	}
+
+	if !design.Active() {
+		return errors.Errorf("deactivated collection, %q", nid.Collection())
+	}
+
+	st, err = existsState(extensioncurrency.StateKeyContractAccount(design.Parent()), "contract account", getStateFunc)
+	if err != nil {
+		return errors.Errorf("parent not found, %q: %w", design.Parent(), err)
+	}
+
+	ca, err := extensioncurrency.StateContractAccountValue(st)
+	if err != nil {
+		return errors.Errorf("contract account value not found, %q: %w", design.Parent(), err)
+	}
+
+	if !ca.IsActive() {
+		return errors.Errorf("deactivated contract account, %q", design.Parent())
+	}
+
+	st, err = existsState(StateKeyNFT(nid), "key of nft", getStateFunc)
+	if err != nil {
+		return errors.Errorf("nft not found, %q: %w", nid, err)
+	}
+
+	nv, err := StateNFTValue(st)
+	if err != nil {
+		return errors.Errorf("nft value not found, %q: %w", nid, err)
+	}
+
+	if !nv.Active() {
+		return errors.Errorf("burned nft, %q", nid)
+	}
+
+	if err := checkNotFrozen(nid, getStateFunc); err != nil {
+		return errors.Errorf("nft not available, %q: %w", nid, err)
+	}
+
+	if err := checkNotInAuction(nid, getStateFunc); err != nil {
+		return errors.Errorf("nft not available, %q: %w", nid, err)
+	}
+
+	if !ipp.item.User().Equal(nv.Owner()) && ipp.item.Expiry() < ipp.height {
+		return errors.Errorf("user expiry already passed, %d < %d", ipp.item.Expiry(), ipp.height)
+	}
+
+	if cu := nv.CurrentUser(ipp.height); !cu.Equal(nv.Owner()) && !cu.Equal(ipp.item.User()) {
+		return errors.Errorf("nft already in use, %q", cu)
+	}
+
+	if !nv.Owner().Equal(ipp.sender) {
+		if err := checkExistsState(currency.StateKeyAccount(nv.Owner()), getStateFunc); err != nil {
+			return errors.Errorf("nft owner not found, %q: %w", nv.Owner(), err)
+		}
+
+		st, err = existsState(StateKeyAgentBox(nv.Owner(), nv.ID().Collection()), "key of agents", getStateFunc)
+		if err != nil {
+			return errors.Errorf("unauthorized sender, %q: %w", ipp.sender, err)
+		}
+
+		box, err := StateAgentBoxValue(st)
+		if err != nil {
+			return errors.Errorf("agent box value not found, %q: %w", StateKeyAgentBox(nv.Owner(), nv.ID().Collection()), err)
+		}
+
+		if !box.Exists(ipp.sender) {
+			return errors.Errorf("unauthorized sender, %q", ipp.sender)
+		}
+	}
+
+	return nil
+}
+
+func (ipp *SetUserItemProcessor) Process(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, error) {
+	nid := ipp.item.NFT()
+
+	st, err := existsState(StateKeyNFT(nid), "key of nft", getStateFunc)
+	if err != nil {
+		return nil, errors.Errorf("nft not found, %q: %w", nid, err)
+	}
+
+	nv, err := StateNFTValue(st)
+	if err != nil {
+		return nil, errors.Errorf("nft value not found, %q: %w", nid, err)
+	}
+
+	n := nft.NewNFT(nv.ID(), nv.Active(), nv.Owner(), nv.NFTHash(), nv.URI(), nv.Approved(), nv.Creators(), nv.Copyrighters(), ipp.item.User(), ipp.item.Expiry(), nv.Royalty(), nv.RoyaltyReceiver())
+	if err := n.IsValid(nil); err != nil {
+		return nil, err
+	}
+
+	return []base.StateMergeValue{NewNFTStateMergeValue(st.Key(), NewNFTStateValue(n))}, nil
+}
+
+func (ipp *SetUserItemProcessor) Close() error {
+	ipp.h = nil
+	ipp.sender = nil
+	ipp.item = SetUserItem{}
+	ipp.height = 0
+
+	setUserItemProcessorPool.Put(ipp)
+
+	return nil
+}
+
+type SetUserProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewSetUserProcessor() extensioncurrency.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringErrorFunc("failed to create new SetUserProcessor")
+
+		nopp := setUserProcessorPool.Get()
+		opp, ok := nopp.(*SetUserProcessor)
+		if !ok {
+			return nil, e(nil, "expected SetUserProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e(err, "")
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *SetUserProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	e := util.StringErrorFunc("failed to preprocess SetUser")
+
+	fact, ok := op.Fact().(SetUserFact)
+	if !ok {
+		return ctx, nil, e(nil, "expected SetUserFact, not %T", op.Fact())
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, nil, e(err, "")
+	}
+
+	if err := checkExistsState(currency.StateKeyAccount(fact.Sender()), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("sender not found, %q: %w", fact.Sender(), err), nil
+	}
+
+	if err := checkFactSignsByState(fact.sender, op.Signs(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("invalid signing: %w", err), nil
+	}
+
+	for _, item := range fact.Items() {
+		ip := setUserItemProcessorPool.Get()
+		ipc, ok := ip.(*SetUserItemProcessor)
+		if !ok {
+			return nil, nil, e(nil, "expected SetUserItemProcessor, not %T", ipc)
+		}
+
+		ipc.h = op.Hash()
+		ipc.sender = fact.Sender()
+		ipc.item = item
+		ipc.height = opp.Height()
+
+		if err := ipc.PreProcess(ctx, op, getStateFunc); err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("fail to preprocess SetUserItem: %w", err), nil
+		}
+
+		ipc.Close()
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *SetUserProcessor) Process(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	e := util.StringErrorFunc("failed to process SetUser")
+
+	fact, ok := op.Fact().(SetUserFact)
+	if !ok {
+		return nil, nil, e(nil, "expected SetUserFact, not %T", op.Fact())
+	}
+
+	var sts []base.StateMergeValue // nolint:prealloc
+	for _, item := range fact.Items() {
+		ip := setUserItemProcessorPool.Get()
+		ipc, ok := ip.(*SetUserItemProcessor)
+		if !ok {
+			return nil, nil, e(nil, "expected SetUserItemProcessor, not %T", ip)
+		}
+
+		ipc.h = op.Hash()
+		ipc.sender = fact.Sender()
+		ipc.item = item
+		ipc.height = opp.Height()
+
+		s, err := ipc.Process(ctx, op, getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("failed to process SetUserItem: %w", err), nil
+		}
+		sts = append(sts, s...)
+
+		ipc.Close()
+	}
+
+	fitems := fact.Items()
+	items := make([]CollectionItem, len(fitems))
+	for i := range fact.Items() {
+		items[i] = fitems[i]
+	}
+
+	required, err := CalculateCollectionItemsFee(getStateFunc, items)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to calculate fee: %w", err), nil
+	}
+	sb, err := currency.CheckEnoughBalance(fact.sender, required, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to check enough balance: %w", err), nil
+	}
+
+	for i := range sb {
+		v, ok := sb[i].Value().(currency.BalanceStateValue)
+		if !ok {
+			return nil, nil, e(nil, "expected BalanceStateValue, not %T", sb[i].Value())
+		}
+		stv := currency.NewBalanceStateValue(v.Amount.WithBig(v.Amount.Big().Sub(required[i][0])))
+		sts = append(sts, currency.NewBalanceStateMergeValue(sb[i].Key(), stv))
+	}
+
+	return sts, nil, nil
+}
+
+func (opp *SetUserProcessor) Close() error {
+	setUserProcessorPool.Put(opp)
+
+	return nil
+}
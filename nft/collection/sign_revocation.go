@@ -0,0 +1,71 @@
+package collection
+
+import (
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+var SignRevocationHint = hint.MustNewHint("mitum-nft-sign-revocation-v0.0.1")
+
+type SignRevocation struct {
+	hint.BaseHinter
+	nft           nft.NFTID
+	account       base.Address
+	qualification Qualification
+	height        base.Height
+}
+
+func NewSignRevocation(n nft.NFTID, account base.Address, q Qualification, height base.Height) SignRevocation {
+	return SignRevocation{
+		BaseHinter:    hint.NewBaseHinter(SignRevocationHint),
+		nft:           n,
+		account:       account,
+		qualification: q,
+		height:        height,
+	}
+}
+
+func (r SignRevocation) IsValid([]byte) error {
+	if err := util.CheckIsValiders(nil, false,
+		r.BaseHinter,
+		r.nft,
+		r.account,
+		r.qualification,
+	); err != nil {
+		return err
+	}
+
+	if r.height < 0 {
+		return util.ErrInvalid.Errorf("revocation height under zero, %d", r.height)
+	}
+
+	return nil
+}
+
+func (r SignRevocation) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		r.nft.Bytes(),
+		r.account.Bytes(),
+		r.qualification.Bytes(),
+		r.height.Bytes(),
+	)
+}
+
+func (r SignRevocation) NFT() nft.NFTID {
+	return r.nft
+}
+
+func (r SignRevocation) Account() base.Address {
+	return r.account
+}
+
+func (r SignRevocation) Qualification() Qualification {
+	return r.qualification
+}
+
+// Height is the block height the signature was revoked at.
+func (r SignRevocation) Height() base.Height {
+	return r.height
+}
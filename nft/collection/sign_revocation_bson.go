@@ -0,0 +1,43 @@
+package collection
+
+import (
+	bsonenc "github.com/ProtoconNet/mitum-currency/v2/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (r SignRevocation) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(bson.M{
+		"_hint":         r.Hint().String(),
+		"nft":           r.nft,
+		"account":       r.account,
+		"qualification": r.qualification,
+		"height":        r.height,
+	})
+}
+
+type SignRevocationBSONUnmarshaler struct {
+	Hint          string      `bson:"_hint"`
+	NFT           bson.Raw    `bson:"nft"`
+	Account       string      `bson:"account"`
+	Qualification string      `bson:"qualification"`
+	Height        base.Height `bson:"height"`
+}
+
+func (r *SignRevocation) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode bson of SignRevocation")
+
+	var u SignRevocationBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e(err, "")
+	}
+
+	return r.unmarshal(enc, ht, u.NFT, u.Account, u.Qualification, u.Height)
+}
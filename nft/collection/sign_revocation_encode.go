@@ -0,0 +1,40 @@
+package collection
+
+import (
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (r *SignRevocation) unmarshal(
+	enc encoder.Encoder,
+	ht hint.Hint,
+	bn []byte,
+	ac string,
+	q string,
+	height base.Height,
+) error {
+	e := util.StringErrorFunc("failed to unmarshal SignRevocation")
+
+	r.BaseHinter = hint.NewBaseHinter(ht)
+	r.qualification = Qualification(q)
+	r.height = height
+
+	if hinter, err := enc.Decode(bn); err != nil {
+		return e(err, "")
+	} else if n, ok := hinter.(nft.NFTID); !ok {
+		return e(util.ErrWrongType.Errorf("expected NFTID, not %T", hinter), "")
+	} else {
+		r.nft = n
+	}
+
+	account, err := base.DecodeAddress(ac, enc)
+	if err != nil {
+		return e(err, "")
+	}
+	r.account = account
+
+	return nil
+}
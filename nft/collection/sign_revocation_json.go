@@ -0,0 +1,48 @@
+package collection
+
+import (
+	"encoding/json"
+
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+type SignRevocationJSONMarshaler struct {
+	hint.BaseHinter
+	NFT           nft.NFTID     `json:"nft"`
+	Account       base.Address  `json:"account"`
+	Qualification Qualification `json:"qualification"`
+	Height        base.Height   `json:"height"`
+}
+
+func (r SignRevocation) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(SignRevocationJSONMarshaler{
+		BaseHinter:    r.BaseHinter,
+		NFT:           r.nft,
+		Account:       r.account,
+		Qualification: r.qualification,
+		Height:        r.height,
+	})
+}
+
+type SignRevocationJSONUnmarshaler struct {
+	Hint          hint.Hint       `json:"_hint"`
+	NFT           json.RawMessage `json:"nft"`
+	Account       string          `json:"account"`
+	Qualification string          `json:"qualification"`
+	Height        base.Height     `json:"height"`
+}
+
+func (r *SignRevocation) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of SignRevocation")
+
+	var u SignRevocationJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	return r.unmarshal(enc, u.Hint, u.NFT, u.Account, u.Qualification, u.Height)
+}
@@ -28,3 +28,45 @@ func checkFactSignsByState(
 
 	return nil
 }
+
+func checkMultiFactSignsByState(
+	addresses []base.Address,
+	fs []base.Sign,
+	getState base.GetStateFunc,
+) error {
+	signed := make([]bool, len(fs))
+
+	for _, address := range addresses {
+		st, err := existsState(currency.StateKeyAccount(address), "keys of account", getState)
+		if err != nil {
+			return err
+		}
+		keys, err := currency.StateKeysValue(st)
+		switch {
+		case err != nil:
+			return base.NewBaseOperationProcessReasonError("failed to get Keys %w", err)
+		case keys == nil:
+			return base.NewBaseOperationProcessReasonError("empty keys found")
+		}
+
+		var afs []base.Sign
+		for i := range fs {
+			if _, found := keys.Key(fs[i].Signer()); found {
+				afs = append(afs, fs[i])
+				signed[i] = true
+			}
+		}
+
+		if err := checkThreshold(afs, keys); err != nil {
+			return base.NewBaseOperationProcessReasonError("failed to check threshold of %q %w", address, err)
+		}
+	}
+
+	for i := range signed {
+		if !signed[i] {
+			return base.NewBaseOperationProcessReasonError("unknown key found, %q", fs[i].Signer())
+		}
+	}
+
+	return nil
+}
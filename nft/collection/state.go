@@ -430,6 +430,1335 @@ func NewAgentBoxStateMergeValue(key string, stv base.StateValue) base.StateMerge
 	)
 }
 
+var (
+	CollectionBoxStateValueHint = hint.MustNewHint("collection-box-state-value-v0.0.1")
+	StateKeyCollectionBoxSuffix = ":collectionbox"
+)
+
+type CollectionBoxStateValue struct {
+	hint.BaseHinter
+	Box CollectionBox
+}
+
+func NewCollectionBoxStateValue(box CollectionBox) CollectionBoxStateValue {
+	return CollectionBoxStateValue{
+		BaseHinter: hint.NewBaseHinter(CollectionBoxStateValueHint),
+		Box:        box,
+	}
+}
+
+func (cb CollectionBoxStateValue) Hint() hint.Hint {
+	return cb.BaseHinter.Hint()
+}
+
+func (cb CollectionBoxStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid CollectionBoxStateValue")
+
+	if err := cb.BaseHinter.IsValid(CollectionBoxStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := cb.Box.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (cb CollectionBoxStateValue) HashBytes() []byte {
+	return cb.Box.Bytes()
+}
+
+func StateCollectionBoxValue(st base.State) (CollectionBox, error) {
+	v := st.Value()
+	if v == nil {
+		return CollectionBox{}, util.ErrNotFound.Errorf("collection box not found in State")
+	}
+
+	cb, ok := v.(CollectionBoxStateValue)
+	if !ok {
+		return CollectionBox{}, errors.Errorf("invalid collection box value found, %T", v)
+	}
+
+	return cb.Box, nil
+}
+
+func IsStateCollectionBoxKey(key string) bool {
+	return strings.HasSuffix(key, StateKeyCollectionBoxSuffix)
+}
+
+func StateKeyCollectionBox(addr base.Address) string {
+	return fmt.Sprintf("%s%s", addr, StateKeyCollectionBoxSuffix)
+}
+
+type CollectionBoxStateValueMerger struct {
+	*base.BaseStateValueMerger
+}
+
+func NewCollectionBoxStateValueMerger(height base.Height, key string, st base.State) *CollectionBoxStateValueMerger {
+	s := &CollectionBoxStateValueMerger{
+		BaseStateValueMerger: base.NewBaseStateValueMerger(height, key, st),
+	}
+
+	return s
+}
+
+func NewCollectionBoxStateMergeValue(key string, stv base.StateValue) base.StateMergeValue {
+	return base.NewBaseStateMergeValue(
+		key,
+		stv,
+		func(height base.Height, st base.State) base.StateValueMerger {
+			return NewCollectionBoxStateValueMerger(height, key, st)
+		},
+	)
+}
+
+// CollectionsOf returns the collections registered under the contract account.
+// Collections registered before the collection box was introduced are indexed
+// the next time their design is updated.
+func CollectionsOf(ca base.Address, getStateFunc base.GetStateFunc) ([]extensioncurrency.ContractID, error) {
+	switch st, found, err := getStateFunc(StateKeyCollectionBox(ca)); {
+	case err != nil:
+		return nil, err
+	case !found:
+		return []extensioncurrency.ContractID{}, nil
+	default:
+		box, err := StateCollectionBoxValue(st)
+		if err != nil {
+			return nil, err
+		}
+
+		return box.Collections(), nil
+	}
+}
+
+// indexCollection returns the collection box of the parent with the collection appended,
+// or nil when the collection is already indexed.
+func indexCollection(design CollectionDesign, getStateFunc base.GetStateFunc) (base.StateMergeValue, error) {
+	collections, err := CollectionsOf(design.Parent(), getStateFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	box := NewCollectionBox(design.Parent(), append([]extensioncurrency.ContractID{}, collections...))
+	if box.Exists(design.Symbol()) {
+		return nil, nil
+	}
+
+	if err := box.Append(design.Symbol()); err != nil {
+		return nil, err
+	}
+
+	if err := box.IsValid(nil); err != nil {
+		return nil, err
+	}
+
+	return NewCollectionBoxStateMergeValue(StateKeyCollectionBox(design.Parent()), NewCollectionBoxStateValue(box)), nil
+}
+
+var (
+	ListingStateValueHint = hint.MustNewHint("listing-state-value-v0.0.1")
+	StateKeyListingSuffix = ":listing"
+)
+
+type ListingStateValue struct {
+	hint.BaseHinter
+	Listing Listing
+}
+
+func NewListingStateValue(listing Listing) ListingStateValue {
+	return ListingStateValue{
+		BaseHinter: hint.NewBaseHinter(ListingStateValueHint),
+		Listing:    listing,
+	}
+}
+
+func (ls ListingStateValue) Hint() hint.Hint {
+	return ls.BaseHinter.Hint()
+}
+
+func (ls ListingStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid ListingStateValue")
+
+	if err := ls.BaseHinter.IsValid(ListingStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := ls.Listing.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (ls ListingStateValue) HashBytes() []byte {
+	return ls.Listing.Bytes()
+}
+
+func StateListingValue(st base.State) (Listing, error) {
+	v := st.Value()
+	if v == nil {
+		return Listing{}, util.ErrNotFound.Errorf("listing not found in State")
+	}
+
+	ls, ok := v.(ListingStateValue)
+	if !ok {
+		return Listing{}, errors.Errorf("invalid listing value found, %T", v)
+	}
+
+	return ls.Listing, nil
+}
+
+func IsStateListingKey(key string) bool {
+	return strings.HasSuffix(key, StateKeyListingSuffix)
+}
+
+func StateKeyListing(id nft.NFTID) string {
+	return fmt.Sprintf("%s%s", id, StateKeyListingSuffix)
+}
+
+type ListingStateValueMerger struct {
+	*base.BaseStateValueMerger
+}
+
+func NewListingStateValueMerger(height base.Height, key string, st base.State) *ListingStateValueMerger {
+	s := &ListingStateValueMerger{
+		BaseStateValueMerger: base.NewBaseStateValueMerger(height, key, st),
+	}
+
+	return s
+}
+
+func NewListingStateMergeValue(key string, stv base.StateValue) base.StateMergeValue {
+	return base.NewBaseStateMergeValue(
+		key,
+		stv,
+		func(height base.Height, st base.State) base.StateValueMerger {
+			return NewListingStateValueMerger(height, key, st)
+		},
+	)
+}
+
+var (
+	AuctionStateValueHint = hint.MustNewHint("auction-state-value-v0.0.1")
+	StateKeyAuctionSuffix = ":auction"
+)
+
+type AuctionStateValue struct {
+	hint.BaseHinter
+	Auction Auction
+}
+
+func NewAuctionStateValue(auction Auction) AuctionStateValue {
+	return AuctionStateValue{
+		BaseHinter: hint.NewBaseHinter(AuctionStateValueHint),
+		Auction:    auction,
+	}
+}
+
+func (as AuctionStateValue) Hint() hint.Hint {
+	return as.BaseHinter.Hint()
+}
+
+func (as AuctionStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid AuctionStateValue")
+
+	if err := as.BaseHinter.IsValid(AuctionStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := as.Auction.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (as AuctionStateValue) HashBytes() []byte {
+	return as.Auction.Bytes()
+}
+
+func StateAuctionValue(st base.State) (Auction, error) {
+	v := st.Value()
+	if v == nil {
+		return Auction{}, util.ErrNotFound.Errorf("auction not found in State")
+	}
+
+	as, ok := v.(AuctionStateValue)
+	if !ok {
+		return Auction{}, errors.Errorf("invalid auction value found, %T", v)
+	}
+
+	return as.Auction, nil
+}
+
+func IsStateAuctionKey(key string) bool {
+	return strings.HasSuffix(key, StateKeyAuctionSuffix)
+}
+
+func StateKeyAuction(id nft.NFTID) string {
+	return fmt.Sprintf("%s%s", id, StateKeyAuctionSuffix)
+}
+
+type AuctionStateValueMerger struct {
+	*base.BaseStateValueMerger
+}
+
+func NewAuctionStateValueMerger(height base.Height, key string, st base.State) *AuctionStateValueMerger {
+	s := &AuctionStateValueMerger{
+		BaseStateValueMerger: base.NewBaseStateValueMerger(height, key, st),
+	}
+
+	return s
+}
+
+func NewAuctionStateMergeValue(key string, stv base.StateValue) base.StateMergeValue {
+	return base.NewBaseStateMergeValue(
+		key,
+		stv,
+		func(height base.Height, st base.State) base.StateValueMerger {
+			return NewAuctionStateValueMerger(height, key, st)
+		},
+	)
+}
+
+var (
+	OfferStateValueHint = hint.MustNewHint("offer-state-value-v0.0.1")
+	StateKeyOfferSuffix = ":offer"
+)
+
+type OfferStateValue struct {
+	hint.BaseHinter
+	Offer Offer
+}
+
+func NewOfferStateValue(offer Offer) OfferStateValue {
+	return OfferStateValue{
+		BaseHinter: hint.NewBaseHinter(OfferStateValueHint),
+		Offer:      offer,
+	}
+}
+
+func (ov OfferStateValue) Hint() hint.Hint {
+	return ov.BaseHinter.Hint()
+}
+
+func (ov OfferStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid OfferStateValue")
+
+	if err := ov.BaseHinter.IsValid(OfferStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := ov.Offer.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (ov OfferStateValue) HashBytes() []byte {
+	return ov.Offer.Bytes()
+}
+
+func StateOfferValue(st base.State) (Offer, error) {
+	v := st.Value()
+	if v == nil {
+		return Offer{}, util.ErrNotFound.Errorf("offer not found in State")
+	}
+
+	ov, ok := v.(OfferStateValue)
+	if !ok {
+		return Offer{}, errors.Errorf("invalid offer value found, %T", v)
+	}
+
+	return ov.Offer, nil
+}
+
+func IsStateOfferKey(key string) bool {
+	return strings.HasSuffix(key, StateKeyOfferSuffix)
+}
+
+func StateKeyOffer(id nft.NFTID, offerer base.Address) string {
+	return fmt.Sprintf("%s-%s%s", id, offerer, StateKeyOfferSuffix)
+}
+
+type OfferStateValueMerger struct {
+	*base.BaseStateValueMerger
+}
+
+func NewOfferStateValueMerger(height base.Height, key string, st base.State) *OfferStateValueMerger {
+	s := &OfferStateValueMerger{
+		BaseStateValueMerger: base.NewBaseStateValueMerger(height, key, st),
+	}
+
+	return s
+}
+
+func NewOfferStateMergeValue(key string, stv base.StateValue) base.StateMergeValue {
+	return base.NewBaseStateMergeValue(
+		key,
+		stv,
+		func(height base.Height, st base.State) base.StateValueMerger {
+			return NewOfferStateValueMerger(height, key, st)
+		},
+	)
+}
+
+var (
+	CollectionStatusStateValueHint = hint.MustNewHint("collection-status-state-value-v0.0.1")
+	StateKeyCollectionStatusSuffix = ":collectionstatus"
+)
+
+type CollectionStatusStateValue struct {
+	hint.BaseHinter
+	CollectionStatus CollectionStatus
+}
+
+func NewCollectionStatusStateValue(status CollectionStatus) CollectionStatusStateValue {
+	return CollectionStatusStateValue{
+		BaseHinter:       hint.NewBaseHinter(CollectionStatusStateValueHint),
+		CollectionStatus: status,
+	}
+}
+
+func (cs CollectionStatusStateValue) Hint() hint.Hint {
+	return cs.BaseHinter.Hint()
+}
+
+func (cs CollectionStatusStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid CollectionStatusStateValue")
+
+	if err := cs.BaseHinter.IsValid(CollectionStatusStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := cs.CollectionStatus.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (cs CollectionStatusStateValue) HashBytes() []byte {
+	return cs.CollectionStatus.Bytes()
+}
+
+func StateCollectionStatusValue(st base.State) (CollectionStatus, error) {
+	v := st.Value()
+	if v == nil {
+		return CollectionStatus{}, util.ErrNotFound.Errorf("collection status not found in State")
+	}
+
+	cs, ok := v.(CollectionStatusStateValue)
+	if !ok {
+		return CollectionStatus{}, errors.Errorf("invalid collection status value found, %T", v)
+	}
+
+	return cs.CollectionStatus, nil
+}
+
+func IsStateCollectionStatusKey(key string) bool {
+	return strings.HasSuffix(key, StateKeyCollectionStatusSuffix)
+}
+
+func StateKeyCollectionStatus(id extensioncurrency.ContractID) string {
+	return fmt.Sprintf("%s%s", id, StateKeyCollectionStatusSuffix)
+}
+
+type CollectionStatusStateValueMerger struct {
+	*base.BaseStateValueMerger
+}
+
+func NewCollectionStatusStateValueMerger(height base.Height, key string, st base.State) *CollectionStatusStateValueMerger {
+	s := &CollectionStatusStateValueMerger{
+		BaseStateValueMerger: base.NewBaseStateValueMerger(height, key, st),
+	}
+
+	return s
+}
+
+func NewCollectionStatusStateMergeValue(key string, stv base.StateValue) base.StateMergeValue {
+	return base.NewBaseStateMergeValue(
+		key,
+		stv,
+		func(height base.Height, st base.State) base.StateValueMerger {
+			return NewCollectionStatusStateValueMerger(height, key, st)
+		},
+	)
+}
+
+var (
+	MintCountStateValueHint = hint.MustNewHint("mint-count-state-value-v0.0.1")
+	StateKeyMintCountSuffix = ":mintcount"
+)
+
+type MintCountStateValue struct {
+	hint.BaseHinter
+	Collection extensioncurrency.ContractID
+	Account    base.Address
+	Count      uint64
+}
+
+func NewMintCountStateValue(collection extensioncurrency.ContractID, account base.Address, count uint64) MintCountStateValue {
+	return MintCountStateValue{
+		BaseHinter: hint.NewBaseHinter(MintCountStateValueHint),
+		Collection: collection,
+		Account:    account,
+		Count:      count,
+	}
+}
+
+func (mc MintCountStateValue) Hint() hint.Hint {
+	return mc.BaseHinter.Hint()
+}
+
+func (mc MintCountStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid MintCountStateValue")
+
+	if err := mc.BaseHinter.IsValid(MintCountStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(nil, false, mc.Collection, mc.Account); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (mc MintCountStateValue) HashBytes() []byte {
+	return util.ConcatBytesSlice(mc.Collection.Bytes(), mc.Account.Bytes(), util.Uint64ToBytes(mc.Count))
+}
+
+func StateMintCountValue(st base.State) (uint64, error) {
+	v := st.Value()
+	if v == nil {
+		return 0, util.ErrNotFound.Errorf("mint count not found in State")
+	}
+
+	mc, ok := v.(MintCountStateValue)
+	if !ok {
+		return 0, errors.Errorf("invalid mint count value found, %T", v)
+	}
+
+	return mc.Count, nil
+}
+
+func IsStateMintCountKey(key string) bool {
+	return strings.HasSuffix(key, StateKeyMintCountSuffix)
+}
+
+func StateKeyMintCount(id extensioncurrency.ContractID, account base.Address) string {
+	return fmt.Sprintf("%s-%s%s", id, account, StateKeyMintCountSuffix)
+}
+
+type MintCountStateValueMerger struct {
+	*base.BaseStateValueMerger
+}
+
+func NewMintCountStateValueMerger(height base.Height, key string, st base.State) *MintCountStateValueMerger {
+	s := &MintCountStateValueMerger{
+		BaseStateValueMerger: base.NewBaseStateValueMerger(height, key, st),
+	}
+
+	return s
+}
+
+func NewMintCountStateMergeValue(key string, stv base.StateValue) base.StateMergeValue {
+	return base.NewBaseStateMergeValue(
+		key,
+		stv,
+		func(height base.Height, st base.State) base.StateValueMerger {
+			return NewMintCountStateValueMerger(height, key, st)
+		},
+	)
+}
+
+var (
+	WhiteStateValueHint = hint.MustNewHint("white-state-value-v0.0.1")
+	StateKeyWhiteSuffix = ":white"
+)
+
+type WhiteStateValue struct {
+	hint.BaseHinter
+	Collection extensioncurrency.ContractID
+	Account    base.Address
+	Quota      uint64
+	Active     bool
+}
+
+func NewWhiteStateValue(collection extensioncurrency.ContractID, white White, active bool) WhiteStateValue {
+	return WhiteStateValue{
+		BaseHinter: hint.NewBaseHinter(WhiteStateValueHint),
+		Collection: collection,
+		Account:    white.Account(),
+		Quota:      white.Quota(),
+		Active:     active,
+	}
+}
+
+func (ws WhiteStateValue) Hint() hint.Hint {
+	return ws.BaseHinter.Hint()
+}
+
+func (ws WhiteStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid WhiteStateValue")
+
+	if err := ws.BaseHinter.IsValid(WhiteStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(nil, false, ws.Collection, ws.Account); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (ws WhiteStateValue) HashBytes() []byte {
+	bs := []byte{0}
+	if ws.Active {
+		bs[0] = 1
+	}
+
+	return util.ConcatBytesSlice(ws.Collection.Bytes(), ws.Account.Bytes(), util.Uint64ToBytes(ws.Quota), bs)
+}
+
+// StateWhiteValue returns the white of the state and whether it is still in the whitelist.
+func StateWhiteValue(st base.State) (White, bool, error) {
+	v := st.Value()
+	if v == nil {
+		return White{}, false, util.ErrNotFound.Errorf("white not found in State")
+	}
+
+	ws, ok := v.(WhiteStateValue)
+	if !ok {
+		return White{}, false, errors.Errorf("invalid white value found, %T", v)
+	}
+
+	return NewWhite(ws.Account, ws.Quota), ws.Active, nil
+}
+
+func IsStateWhiteKey(key string) bool {
+	return strings.HasSuffix(key, StateKeyWhiteSuffix)
+}
+
+func StateKeyWhite(id extensioncurrency.ContractID, account base.Address) string {
+	return fmt.Sprintf("%s-%s%s", id, account, StateKeyWhiteSuffix)
+}
+
+type WhiteStateValueMerger struct {
+	*base.BaseStateValueMerger
+}
+
+func NewWhiteStateValueMerger(height base.Height, key string, st base.State) *WhiteStateValueMerger {
+	s := &WhiteStateValueMerger{
+		BaseStateValueMerger: base.NewBaseStateValueMerger(height, key, st),
+	}
+
+	return s
+}
+
+func NewWhiteStateMergeValue(key string, stv base.StateValue) base.StateMergeValue {
+	return base.NewBaseStateMergeValue(
+		key,
+		stv,
+		func(height base.Height, st base.State) base.StateValueMerger {
+			return NewWhiteStateValueMerger(height, key, st)
+		},
+	)
+}
+
+var (
+	FreezeStateValueHint = hint.MustNewHint("freeze-state-value-v0.0.1")
+	StateKeyFreezeSuffix = ":freeze"
+)
+
+type FreezeStateValue struct {
+	hint.BaseHinter
+	NFT    nft.NFTID
+	Frozen bool
+}
+
+func NewFreezeStateValue(id nft.NFTID, frozen bool) FreezeStateValue {
+	return FreezeStateValue{
+		BaseHinter: hint.NewBaseHinter(FreezeStateValueHint),
+		NFT:        id,
+		Frozen:     frozen,
+	}
+}
+
+func (fs FreezeStateValue) Hint() hint.Hint {
+	return fs.BaseHinter.Hint()
+}
+
+func (fs FreezeStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid FreezeStateValue")
+
+	if err := fs.BaseHinter.IsValid(FreezeStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := fs.NFT.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fs FreezeStateValue) HashBytes() []byte {
+	bs := []byte{0}
+	if fs.Frozen {
+		bs[0] = 1
+	}
+
+	return util.ConcatBytesSlice(fs.NFT.Bytes(), bs)
+}
+
+func StateFreezeValue(st base.State) (bool, error) {
+	v := st.Value()
+	if v == nil {
+		return false, util.ErrNotFound.Errorf("freeze not found in State")
+	}
+
+	fs, ok := v.(FreezeStateValue)
+	if !ok {
+		return false, errors.Errorf("invalid freeze value found, %T", v)
+	}
+
+	return fs.Frozen, nil
+}
+
+func IsStateFreezeKey(key string) bool {
+	return strings.HasSuffix(key, StateKeyFreezeSuffix)
+}
+
+func StateKeyFreeze(id nft.NFTID) string {
+	return fmt.Sprintf("%s%s", id, StateKeyFreezeSuffix)
+}
+
+type FreezeStateValueMerger struct {
+	*base.BaseStateValueMerger
+}
+
+func NewFreezeStateValueMerger(height base.Height, key string, st base.State) *FreezeStateValueMerger {
+	s := &FreezeStateValueMerger{
+		BaseStateValueMerger: base.NewBaseStateValueMerger(height, key, st),
+	}
+
+	return s
+}
+
+func NewFreezeStateMergeValue(key string, stv base.StateValue) base.StateMergeValue {
+	return base.NewBaseStateMergeValue(
+		key,
+		stv,
+		func(height base.Height, st base.State) base.StateValueMerger {
+			return NewFreezeStateValueMerger(height, key, st)
+		},
+	)
+}
+
+var (
+	EditionStateValueHint = hint.MustNewHint("edition-state-value-v0.0.1")
+	StateKeyEditionSuffix = ":edition"
+)
+
+type EditionStateValue struct {
+	hint.BaseHinter
+	Edition Edition
+}
+
+func NewEditionStateValue(edition Edition) EditionStateValue {
+	return EditionStateValue{
+		BaseHinter: hint.NewBaseHinter(EditionStateValueHint),
+		Edition:    edition,
+	}
+}
+
+func (es EditionStateValue) Hint() hint.Hint {
+	return es.BaseHinter.Hint()
+}
+
+func (es EditionStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid EditionStateValue")
+
+	if err := es.BaseHinter.IsValid(EditionStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := es.Edition.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (es EditionStateValue) HashBytes() []byte {
+	return es.Edition.Bytes()
+}
+
+func StateEditionValue(st base.State) (Edition, error) {
+	v := st.Value()
+	if v == nil {
+		return Edition{}, util.ErrNotFound.Errorf("edition not found in State")
+	}
+
+	es, ok := v.(EditionStateValue)
+	if !ok {
+		return Edition{}, errors.Errorf("invalid edition value found, %T", v)
+	}
+
+	return es.Edition, nil
+}
+
+func IsStateEditionKey(key string) bool {
+	return strings.HasSuffix(key, StateKeyEditionSuffix)
+}
+
+func StateKeyEdition(id nft.NFTID) string {
+	return fmt.Sprintf("%s%s", id, StateKeyEditionSuffix)
+}
+
+type EditionStateValueMerger struct {
+	*base.BaseStateValueMerger
+}
+
+func NewEditionStateValueMerger(height base.Height, key string, st base.State) *EditionStateValueMerger {
+	s := &EditionStateValueMerger{
+		BaseStateValueMerger: base.NewBaseStateValueMerger(height, key, st),
+	}
+
+	return s
+}
+
+func NewEditionStateMergeValue(key string, stv base.StateValue) base.StateMergeValue {
+	return base.NewBaseStateMergeValue(
+		key,
+		stv,
+		func(height base.Height, st base.State) base.StateValueMerger {
+			return NewEditionStateValueMerger(height, key, st)
+		},
+	)
+}
+
+var (
+	EditionBalanceStateValueHint = hint.MustNewHint("edition-balance-state-value-v0.0.1")
+	StateKeyEditionBalanceSuffix = ":editionbalance"
+)
+
+type EditionBalanceStateValue struct {
+	hint.BaseHinter
+	Token  nft.NFTID
+	Holder base.Address
+	Amount uint64
+}
+
+func NewEditionBalanceStateValue(token nft.NFTID, holder base.Address, amount uint64) EditionBalanceStateValue {
+	return EditionBalanceStateValue{
+		BaseHinter: hint.NewBaseHinter(EditionBalanceStateValueHint),
+		Token:      token,
+		Holder:     holder,
+		Amount:     amount,
+	}
+}
+
+func (eb EditionBalanceStateValue) Hint() hint.Hint {
+	return eb.BaseHinter.Hint()
+}
+
+func (eb EditionBalanceStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid EditionBalanceStateValue")
+
+	if err := eb.BaseHinter.IsValid(EditionBalanceStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(nil, false, eb.Token, eb.Holder); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (eb EditionBalanceStateValue) HashBytes() []byte {
+	return util.ConcatBytesSlice(eb.Token.Bytes(), eb.Holder.Bytes(), util.Uint64ToBytes(eb.Amount))
+}
+
+func StateEditionBalanceValue(st base.State) (uint64, error) {
+	v := st.Value()
+	if v == nil {
+		return 0, util.ErrNotFound.Errorf("edition balance not found in State")
+	}
+
+	eb, ok := v.(EditionBalanceStateValue)
+	if !ok {
+		return 0, errors.Errorf("invalid edition balance value found, %T", v)
+	}
+
+	return eb.Amount, nil
+}
+
+func IsStateEditionBalanceKey(key string) bool {
+	return strings.HasSuffix(key, StateKeyEditionBalanceSuffix)
+}
+
+func StateKeyEditionBalance(id nft.NFTID, holder base.Address) string {
+	return fmt.Sprintf("%s-%s%s", id, holder, StateKeyEditionBalanceSuffix)
+}
+
+type EditionBalanceStateValueMerger struct {
+	*base.BaseStateValueMerger
+}
+
+func NewEditionBalanceStateValueMerger(height base.Height, key string, st base.State) *EditionBalanceStateValueMerger {
+	s := &EditionBalanceStateValueMerger{
+		BaseStateValueMerger: base.NewBaseStateValueMerger(height, key, st),
+	}
+
+	return s
+}
+
+func NewEditionBalanceStateMergeValue(key string, stv base.StateValue) base.StateMergeValue {
+	return base.NewBaseStateMergeValue(
+		key,
+		stv,
+		func(height base.Height, st base.State) base.StateValueMerger {
+			return NewEditionBalanceStateValueMerger(height, key, st)
+		},
+	)
+}
+
+var (
+	FractionStateValueHint      = hint.MustNewHint("fraction-state-value-v0.0.1")
+	StateKeyFractionSuffix      = ":fraction"
+	StateKeyShareFractionSuffix = ":sharefraction"
+)
+
+type FractionStateValue struct {
+	hint.BaseHinter
+	Fraction Fraction
+}
+
+func NewFractionStateValue(fraction Fraction) FractionStateValue {
+	return FractionStateValue{
+		BaseHinter: hint.NewBaseHinter(FractionStateValueHint),
+		Fraction:   fraction,
+	}
+}
+
+func (fs FractionStateValue) Hint() hint.Hint {
+	return fs.BaseHinter.Hint()
+}
+
+func (fs FractionStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid FractionStateValue")
+
+	if err := fs.BaseHinter.IsValid(FractionStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := fs.Fraction.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fs FractionStateValue) HashBytes() []byte {
+	return fs.Fraction.Bytes()
+}
+
+func StateFractionValue(st base.State) (Fraction, error) {
+	v := st.Value()
+	if v == nil {
+		return Fraction{}, util.ErrNotFound.Errorf("fraction not found in State")
+	}
+
+	fs, ok := v.(FractionStateValue)
+	if !ok {
+		return Fraction{}, errors.Errorf("invalid fraction value found, %T", v)
+	}
+
+	return fs.Fraction, nil
+}
+
+func IsStateFractionKey(key string) bool {
+	return strings.HasSuffix(key, StateKeyFractionSuffix)
+}
+
+func StateKeyFraction(id nft.NFTID) string {
+	return fmt.Sprintf("%s%s", id, StateKeyFractionSuffix)
+}
+
+func IsStateShareFractionKey(key string) bool {
+	return strings.HasSuffix(key, StateKeyShareFractionSuffix)
+}
+
+// StateKeyShareFraction is the key of the fraction backed by the share currency.
+func StateKeyShareFraction(share currency.CurrencyID) string {
+	return fmt.Sprintf("%s%s", share, StateKeyShareFractionSuffix)
+}
+
+type FractionStateValueMerger struct {
+	*base.BaseStateValueMerger
+}
+
+func NewFractionStateValueMerger(height base.Height, key string, st base.State) *FractionStateValueMerger {
+	s := &FractionStateValueMerger{
+		BaseStateValueMerger: base.NewBaseStateValueMerger(height, key, st),
+	}
+
+	return s
+}
+
+func NewFractionStateMergeValue(key string, stv base.StateValue) base.StateMergeValue {
+	return base.NewBaseStateMergeValue(
+		key,
+		stv,
+		func(height base.Height, st base.State) base.StateValueMerger {
+			return NewFractionStateValueMerger(height, key, st)
+		},
+	)
+}
+
+var (
+	NFTParentStateValueHint = hint.MustNewHint("nft-parent-state-value-v0.0.1")
+	StateKeyNFTParentSuffix = ":parent"
+)
+
+type NFTParentStateValue struct {
+	hint.BaseHinter
+	Link NFTLink
+}
+
+func NewNFTParentStateValue(link NFTLink) NFTParentStateValue {
+	return NFTParentStateValue{
+		BaseHinter: hint.NewBaseHinter(NFTParentStateValueHint),
+		Link:       link,
+	}
+}
+
+func (np NFTParentStateValue) Hint() hint.Hint {
+	return np.BaseHinter.Hint()
+}
+
+func (np NFTParentStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid NFTParentStateValue")
+
+	if err := np.BaseHinter.IsValid(NFTParentStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := np.Link.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (np NFTParentStateValue) HashBytes() []byte {
+	return np.Link.Bytes()
+}
+
+func StateNFTParentValue(st base.State) (NFTLink, error) {
+	v := st.Value()
+	if v == nil {
+		return NFTLink{}, util.ErrNotFound.Errorf("nft parent not found in State")
+	}
+
+	np, ok := v.(NFTParentStateValue)
+	if !ok {
+		return NFTLink{}, errors.Errorf("invalid nft parent value found, %T", v)
+	}
+
+	return np.Link, nil
+}
+
+func IsStateNFTParentKey(key string) bool {
+	return strings.HasSuffix(key, StateKeyNFTParentSuffix)
+}
+
+func StateKeyNFTParent(id nft.NFTID) string {
+	return fmt.Sprintf("%s%s", id, StateKeyNFTParentSuffix)
+}
+
+type NFTParentStateValueMerger struct {
+	*base.BaseStateValueMerger
+}
+
+func NewNFTParentStateValueMerger(height base.Height, key string, st base.State) *NFTParentStateValueMerger {
+	s := &NFTParentStateValueMerger{
+		BaseStateValueMerger: base.NewBaseStateValueMerger(height, key, st),
+	}
+
+	return s
+}
+
+func NewNFTParentStateMergeValue(key string, stv base.StateValue) base.StateMergeValue {
+	return base.NewBaseStateMergeValue(
+		key,
+		stv,
+		func(height base.Height, st base.State) base.StateValueMerger {
+			return NewNFTParentStateValueMerger(height, key, st)
+		},
+	)
+}
+
+var (
+	NFTChildrenStateValueHint = hint.MustNewHint("nft-children-state-value-v0.0.1")
+	StateKeyNFTChildrenSuffix = ":children"
+)
+
+type NFTChildrenStateValue struct {
+	hint.BaseHinter
+	Box NFTBox
+}
+
+func NewNFTChildrenStateValue(box NFTBox) NFTChildrenStateValue {
+	return NFTChildrenStateValue{
+		BaseHinter: hint.NewBaseHinter(NFTChildrenStateValueHint),
+		Box:        box,
+	}
+}
+
+func (nc NFTChildrenStateValue) Hint() hint.Hint {
+	return nc.BaseHinter.Hint()
+}
+
+func (nc NFTChildrenStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid NFTChildrenStateValue")
+
+	if err := nc.BaseHinter.IsValid(NFTChildrenStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := nc.Box.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (nc NFTChildrenStateValue) HashBytes() []byte {
+	return nc.Box.Bytes()
+}
+
+func StateNFTChildrenValue(st base.State) (NFTBox, error) {
+	v := st.Value()
+	if v == nil {
+		return NFTBox{}, util.ErrNotFound.Errorf("nft children not found in State")
+	}
+
+	nc, ok := v.(NFTChildrenStateValue)
+	if !ok {
+		return NFTBox{}, errors.Errorf("invalid nft children value found, %T", v)
+	}
+
+	return nc.Box, nil
+}
+
+func IsStateNFTChildrenKey(key string) bool {
+	return strings.HasSuffix(key, StateKeyNFTChildrenSuffix)
+}
+
+func StateKeyNFTChildren(id nft.NFTID) string {
+	return fmt.Sprintf("%s%s", id, StateKeyNFTChildrenSuffix)
+}
+
+type NFTChildrenStateValueMerger struct {
+	*base.BaseStateValueMerger
+}
+
+func NewNFTChildrenStateValueMerger(height base.Height, key string, st base.State) *NFTChildrenStateValueMerger {
+	s := &NFTChildrenStateValueMerger{
+		BaseStateValueMerger: base.NewBaseStateValueMerger(height, key, st),
+	}
+
+	return s
+}
+
+func NewNFTChildrenStateMergeValue(key string, stv base.StateValue) base.StateMergeValue {
+	return base.NewBaseStateMergeValue(
+		key,
+		stv,
+		func(height base.Height, st base.State) base.StateValueMerger {
+			return NewNFTChildrenStateValueMerger(height, key, st)
+		},
+	)
+}
+
+var (
+	UsedVoucherStateValueHint = hint.MustNewHint("used-voucher-state-value-v0.0.1")
+	StateKeyUsedVoucherSuffix = ":voucher"
+)
+
+type UsedVoucherStateValue struct {
+	hint.BaseHinter
+	Collection extensioncurrency.ContractID
+	Signer     base.Address
+	Nonce      uint64
+}
+
+func NewUsedVoucherStateValue(collection extensioncurrency.ContractID, signer base.Address, nonce uint64) UsedVoucherStateValue {
+	return UsedVoucherStateValue{
+		BaseHinter: hint.NewBaseHinter(UsedVoucherStateValueHint),
+		Collection: collection,
+		Signer:     signer,
+		Nonce:      nonce,
+	}
+}
+
+func (uv UsedVoucherStateValue) Hint() hint.Hint {
+	return uv.BaseHinter.Hint()
+}
+
+func (uv UsedVoucherStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid UsedVoucherStateValue")
+
+	if err := uv.BaseHinter.IsValid(UsedVoucherStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(nil, false, uv.Collection, uv.Signer); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (uv UsedVoucherStateValue) HashBytes() []byte {
+	return util.ConcatBytesSlice(uv.Collection.Bytes(), uv.Signer.Bytes(), util.Uint64ToBytes(uv.Nonce))
+}
+
+func StateUsedVoucherValue(st base.State) (uint64, error) {
+	v := st.Value()
+	if v == nil {
+		return 0, util.ErrNotFound.Errorf("used voucher not found in State")
+	}
+
+	uv, ok := v.(UsedVoucherStateValue)
+	if !ok {
+		return 0, errors.Errorf("invalid used voucher value found, %T", v)
+	}
+
+	return uv.Nonce, nil
+}
+
+func IsStateUsedVoucherKey(key string) bool {
+	return strings.HasSuffix(key, StateKeyUsedVoucherSuffix)
+}
+
+func StateKeyUsedVoucher(id extensioncurrency.ContractID, signer base.Address, nonce uint64) string {
+	return fmt.Sprintf("%s-%s-%d%s", id, signer, nonce, StateKeyUsedVoucherSuffix)
+}
+
+type UsedVoucherStateValueMerger struct {
+	*base.BaseStateValueMerger
+}
+
+func NewUsedVoucherStateValueMerger(height base.Height, key string, st base.State) *UsedVoucherStateValueMerger {
+	s := &UsedVoucherStateValueMerger{
+		BaseStateValueMerger: base.NewBaseStateValueMerger(height, key, st),
+	}
+
+	return s
+}
+
+func NewUsedVoucherStateMergeValue(key string, stv base.StateValue) base.StateMergeValue {
+	return base.NewBaseStateMergeValue(
+		key,
+		stv,
+		func(height base.Height, st base.State) base.StateValueMerger {
+			return NewUsedVoucherStateValueMerger(height, key, st)
+		},
+	)
+}
+
+var (
+	SignRevocationStateValueHint = hint.MustNewHint("sign-revocation-state-value-v0.0.1")
+	StateKeySignRevocationSuffix = ":revocation"
+)
+
+type SignRevocationStateValue struct {
+	hint.BaseHinter
+	Revocation SignRevocation
+}
+
+func NewSignRevocationStateValue(revocation SignRevocation) SignRevocationStateValue {
+	return SignRevocationStateValue{
+		BaseHinter: hint.NewBaseHinter(SignRevocationStateValueHint),
+		Revocation: revocation,
+	}
+}
+
+func (sr SignRevocationStateValue) Hint() hint.Hint {
+	return sr.BaseHinter.Hint()
+}
+
+func (sr SignRevocationStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid SignRevocationStateValue")
+
+	if err := sr.BaseHinter.IsValid(SignRevocationStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := sr.Revocation.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (sr SignRevocationStateValue) HashBytes() []byte {
+	return sr.Revocation.Bytes()
+}
+
+func StateSignRevocationValue(st base.State) (SignRevocation, error) {
+	v := st.Value()
+	if v == nil {
+		return SignRevocation{}, util.ErrNotFound.Errorf("sign revocation not found in State")
+	}
+
+	sr, ok := v.(SignRevocationStateValue)
+	if !ok {
+		return SignRevocation{}, errors.Errorf("invalid sign revocation value found, %T", v)
+	}
+
+	return sr.Revocation, nil
+}
+
+func IsStateSignRevocationKey(key string) bool {
+	return strings.HasSuffix(key, StateKeySignRevocationSuffix)
+}
+
+func StateKeySignRevocation(id nft.NFTID, account base.Address, q Qualification) string {
+	return fmt.Sprintf("%s-%s-%s%s", id, account, q, StateKeySignRevocationSuffix)
+}
+
+type SignRevocationStateValueMerger struct {
+	*base.BaseStateValueMerger
+}
+
+func NewSignRevocationStateValueMerger(height base.Height, key string, st base.State) *SignRevocationStateValueMerger {
+	s := &SignRevocationStateValueMerger{
+		BaseStateValueMerger: base.NewBaseStateValueMerger(height, key, st),
+	}
+
+	return s
+}
+
+func NewSignRevocationStateMergeValue(key string, stv base.StateValue) base.StateMergeValue {
+	return base.NewBaseStateMergeValue(
+		key,
+		stv,
+		func(height base.Height, st base.State) base.StateValueMerger {
+			return NewSignRevocationStateValueMerger(height, key, st)
+		},
+	)
+}
+
 func checkExistsState(
 	key string,
 	getState base.GetStateFunc,
@@ -531,3 +1860,431 @@ func existsCollectionPolicy(id extensioncurrency.ContractID, getStateFunc base.G
 
 	return policy, nil
 }
+
+func cancelListing(id nft.NFTID, getStateFunc base.GetStateFunc) (base.StateMergeValue, error) {
+	switch st, found, err := getStateFunc(StateKeyListing(id)); {
+	case err != nil:
+		return nil, err
+	case !found:
+		return nil, nil
+	default:
+		l, err := StateListingValue(st)
+		if err != nil {
+			return nil, err
+		}
+
+		if !l.Active() {
+			return nil, nil
+		}
+
+		return NewListingStateMergeValue(st.Key(), NewListingStateValue(NewListing(l.NFT(), false, l.Seller(), l.Price()))), nil
+	}
+}
+
+func mintCount(collection extensioncurrency.ContractID, account base.Address, getStateFunc base.GetStateFunc) (uint64, error) {
+	switch st, found, err := getStateFunc(StateKeyMintCount(collection, account)); {
+	case err != nil:
+		return 0, err
+	case !found:
+		return 0, nil
+	default:
+		return StateMintCountValue(st)
+	}
+}
+
+// whiteOf returns the white of the account in the collection; false if the account is not in the whitelist.
+func whiteOf(collection extensioncurrency.ContractID, account base.Address, getStateFunc base.GetStateFunc) (White, bool, error) {
+	switch st, found, err := getStateFunc(StateKeyWhite(collection, account)); {
+	case err != nil:
+		return White{}, false, err
+	case !found:
+		return White{}, false, nil
+	default:
+		return StateWhiteValue(st)
+	}
+}
+
+func checkTransferable(id extensioncurrency.ContractID, getStateFunc base.GetStateFunc) error {
+	policy, err := existsCollectionPolicy(id, getStateFunc)
+	if err != nil {
+		return err
+	}
+
+	if !policy.Transferable() {
+		return errors.Errorf("soulbound collection, %q", id)
+	}
+
+	return nil
+}
+
+func checkSigned(nv nft.NFT, getStateFunc base.GetStateFunc) error {
+	policy, err := existsCollectionPolicy(nv.ID().Collection(), getStateFunc)
+	if err != nil {
+		return err
+	}
+
+	if policy.RequireSignatures() && !nv.Verified() {
+		return errors.Errorf("not signed by all creators and copyrighters, %q", nv.ID())
+	}
+
+	return nil
+}
+
+// isTransferBlocked reports whether the nft is frozen or still waits for signatures of its signers.
+func isTransferBlocked(nv nft.NFT, getStateFunc base.GetStateFunc) (bool, error) {
+	if frozen, err := isFrozen(nv.ID(), getStateFunc); err != nil || frozen {
+		return frozen, err
+	}
+
+	policy, err := existsCollectionPolicy(nv.ID().Collection(), getStateFunc)
+	if err != nil {
+		return false, err
+	}
+
+	return policy.RequireSignatures() && !nv.Verified(), nil
+}
+
+func isFrozen(id nft.NFTID, getStateFunc base.GetStateFunc) (bool, error) {
+	switch st, found, err := getStateFunc(StateKeyFreeze(id)); {
+	case err != nil:
+		return false, err
+	case !found:
+		return false, nil
+	default:
+		return StateFreezeValue(st)
+	}
+}
+
+func checkNotFrozen(id nft.NFTID, getStateFunc base.GetStateFunc) error {
+	frozen, err := isFrozen(id, getStateFunc)
+	if err != nil {
+		return err
+	}
+
+	if frozen {
+		return errors.Errorf("frozen nft, %q", id)
+	}
+
+	return nil
+}
+
+func editionBalance(id nft.NFTID, holder base.Address, getStateFunc base.GetStateFunc) (uint64, error) {
+	switch st, found, err := getStateFunc(StateKeyEditionBalance(id, holder)); {
+	case err != nil:
+		return 0, err
+	case !found:
+		return 0, nil
+	default:
+		return StateEditionBalanceValue(st)
+	}
+}
+
+func checkNotInAuction(id nft.NFTID, getStateFunc base.GetStateFunc) error {
+	switch st, found, err := getStateFunc(StateKeyAuction(id)); {
+	case err != nil:
+		return err
+	case !found:
+		return nil
+	default:
+		a, err := StateAuctionValue(st)
+		if err != nil {
+			return err
+		}
+
+		if a.Active() {
+			return errors.Errorf("nft in auction, %q", id)
+		}
+
+		return nil
+	}
+}
+
+func parentOf(id nft.NFTID, getStateFunc base.GetStateFunc) (nft.NFTID, bool, error) {
+	switch st, found, err := getStateFunc(StateKeyNFTParent(id)); {
+	case err != nil:
+		return nft.NFTID{}, false, err
+	case !found:
+		return nft.NFTID{}, false, nil
+	default:
+		l, err := StateNFTParentValue(st)
+		if err != nil {
+			return nft.NFTID{}, false, err
+		}
+
+		if !l.Attached() {
+			return nft.NFTID{}, false, nil
+		}
+
+		return l.Parent(), true, nil
+	}
+}
+
+func ancestorsOf(id nft.NFTID, getStateFunc base.GetStateFunc) ([]nft.NFTID, error) {
+	var ancestors []nft.NFTID
+
+	current := id
+	for i := 0; i <= MaxNFTDepth; i++ {
+		parent, attached, err := parentOf(current, getStateFunc)
+		if err != nil {
+			return nil, err
+		}
+
+		if !attached {
+			return ancestors, nil
+		}
+
+		ancestors = append(ancestors, parent)
+		current = parent
+	}
+
+	return nil, errors.Errorf("nft nested too deep, %q", id)
+}
+
+func rootOf(id nft.NFTID, getStateFunc base.GetStateFunc) (nft.NFTID, error) {
+	ancestors, err := ancestorsOf(id, getStateFunc)
+	if err != nil {
+		return nft.NFTID{}, err
+	}
+
+	if len(ancestors) == 0 {
+		return id, nil
+	}
+
+	return ancestors[len(ancestors)-1], nil
+}
+
+func childrenOf(id nft.NFTID, getStateFunc base.GetStateFunc) (NFTBox, error) {
+	switch st, found, err := getStateFunc(StateKeyNFTChildren(id)); {
+	case err != nil:
+		return NFTBox{}, err
+	case !found:
+		return NewNFTBox(nil), nil
+	default:
+		return StateNFTChildrenValue(st)
+	}
+}
+
+func childrenDepth(id nft.NFTID, getStateFunc base.GetStateFunc) (int, error) {
+	box, err := childrenOf(id, getStateFunc)
+	if err != nil {
+		return 0, err
+	}
+
+	depth := 0
+	for _, c := range box.NFTs() {
+		d, err := childrenDepth(c, getStateFunc)
+		if err != nil {
+			return 0, err
+		}
+
+		if d+1 > depth {
+			depth = d + 1
+		}
+	}
+
+	if depth > MaxNFTDepth {
+		return 0, errors.Errorf("nft nested too deep, %q", id)
+	}
+
+	return depth, nil
+}
+
+func checkNotAttached(id nft.NFTID, getStateFunc base.GetStateFunc) error {
+	parent, attached, err := parentOf(id, getStateFunc)
+	if err != nil {
+		return err
+	}
+
+	if attached {
+		return errors.Errorf("nft attached to parent, %q, %q", id, parent)
+	}
+
+	return nil
+}
+
+func checkNoChildren(id nft.NFTID, getStateFunc base.GetStateFunc) error {
+	box, err := childrenOf(id, getStateFunc)
+	if err != nil {
+		return err
+	}
+
+	if !box.IsEmpty() {
+		return errors.Errorf("nft has children, %q", id)
+	}
+
+	return nil
+}
+
+func checkNFTAuthorized(nv nft.NFT, sender base.Address, getStateFunc base.GetStateFunc) error {
+	if nv.Owner().Equal(sender) || nv.Approved().Equal(sender) {
+		return nil
+	}
+
+	st, err := existsState(StateKeyAgentBox(nv.Owner(), nv.ID().Collection()), "agents", getStateFunc)
+	if err != nil {
+		return errors.Errorf("unauthorized sender, %q: %w", sender, err)
+	}
+
+	box, err := StateAgentBoxValue(st)
+	if err != nil {
+		return errors.Errorf("agent box value not found, %q: %w", sender, err)
+	}
+
+	if !box.Exists(sender) {
+		return errors.Errorf("unauthorized sender, %q", sender)
+	}
+
+	return nil
+}
+
+// checkChildrenMovable runs the transfer checks of the nft on all its descendants, which move along with it.
+func checkChildrenMovable(id nft.NFTID, getStateFunc base.GetStateFunc) error {
+	box, err := childrenOf(id, getStateFunc)
+	if err != nil {
+		return err
+	}
+
+	for _, c := range box.NFTs() {
+		st, err := existsState(StateKeyNFT(c), "key of nft", getStateFunc)
+		if err != nil {
+			return err
+		}
+
+		nv, err := StateNFTValue(st)
+		if err != nil {
+			return err
+		}
+
+		if err := checkNotFrozen(c, getStateFunc); err != nil {
+			return errors.Errorf("child not movable, %q: %w", c, err)
+		}
+
+		if err := checkNotInAuction(c, getStateFunc); err != nil {
+			return errors.Errorf("child not movable, %q: %w", c, err)
+		}
+
+		if err := checkTransferable(c.Collection(), getStateFunc); err != nil {
+			return errors.Errorf("child not movable, %q: %w", c, err)
+		}
+
+		if err := checkSigned(nv, getStateFunc); err != nil {
+			return errors.Errorf("child not movable, %q: %w", c, err)
+		}
+
+		if err := checkChildrenMovable(c, getStateFunc); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func moveChildren(id nft.NFTID, owner base.Address, getStateFunc base.GetStateFunc) ([]base.StateMergeValue, error) {
+	box, err := childrenOf(id, getStateFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	var sts []base.StateMergeValue // nolint:prealloc
+	for _, c := range box.NFTs() {
+		st, err := existsState(StateKeyNFT(c), "key of nft", getStateFunc)
+		if err != nil {
+			return nil, err
+		}
+
+		nv, err := StateNFTValue(st)
+		if err != nil {
+			return nil, err
+		}
+
+		n := nft.NewNFT(c, nv.Active(), owner, nv.NFTHash(), nv.URI(), owner, nv.Creators(), nv.Copyrighters(), owner, 0, nv.Royalty(), nv.RoyaltyReceiver())
+		if err := n.IsValid(nil); err != nil {
+			return nil, err
+		}
+
+		sts = append(sts, NewNFTStateMergeValue(st.Key(), NewNFTStateValue(n)))
+
+		sv, err := cancelListing(c, getStateFunc)
+		if err != nil {
+			return nil, err
+		}
+		if sv != nil {
+			sts = append(sts, sv)
+		}
+
+		s, err := moveChildren(c, owner, getStateFunc)
+		if err != nil {
+			return nil, err
+		}
+		sts = append(sts, s...)
+	}
+
+	return sts, nil
+}
+
+func checkActiveCollection(id extensioncurrency.ContractID, getStateFunc base.GetStateFunc) error {
+	st, err := existsState(StateKeyCollectionDesign(id), "design", getStateFunc)
+	if err != nil {
+		return errors.Errorf("collection design not found, %q: %w", id, err)
+	}
+
+	design, err := StateCollectionDesignValue(st)
+	if err != nil {
+		return errors.Errorf("collection design not found, %q: %w", id, err)
+	}
+	if !design.Active() {
+		return errors.Errorf("deactivated collection, %q", design.Symbol())
+	}
+
+	st, err = existsState(extensioncurrency.StateKeyContractAccount(design.Parent()), "key of contract account", getStateFunc)
+	if err != nil {
+		return errors.Errorf("parent not found, %q: %w", design.Parent(), err)
+	}
+
+	ca, err := extensioncurrency.StateContractAccountValue(st)
+	if err != nil {
+		return errors.Errorf("parent account value not found, %q: %w", design.Parent(), err)
+	}
+
+	if !ca.IsActive() {
+		return errors.Errorf("deactivated contract account, %q", design.Parent())
+	}
+
+	return nil
+}
+
+func checkWhiteAccounts(whites []White, getStateFunc base.GetStateFunc) error {
+	for _, white := range whites {
+		acc := white.Account()
+		if err := checkExistsState(currency.StateKeyAccount(acc), getStateFunc); err != nil {
+			return errors.Errorf("whitelist account not found, %q: %w", acc, err)
+		} else if err = checkNotExistsState(extensioncurrency.StateKeyContractAccount(acc), getStateFunc); err != nil {
+			return errors.Errorf("whitelist account is contract account, %q: %w", acc, err)
+		}
+	}
+
+	return nil
+}
+
+// checkCollectionCreator checks sender created the active collection.
+func checkCollectionCreator(id extensioncurrency.ContractID, sender base.Address, getStateFunc base.GetStateFunc) error {
+	if err := checkActiveCollection(id, getStateFunc); err != nil {
+		return err
+	}
+
+	st, err := existsState(StateKeyCollectionDesign(id), "key of design", getStateFunc)
+	if err != nil {
+		return errors.Errorf("collection design not found, %q: %w", id, err)
+	}
+
+	design, err := StateCollectionDesignValue(st)
+	if err != nil {
+		return errors.Errorf("collection design value not found, %q: %w", id, err)
+	}
+
+	if !design.Creator().Equal(sender) {
+		return errors.Errorf("not creator of collection design, %q", id)
+	}
+
+	return nil
+}
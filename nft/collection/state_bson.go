@@ -4,6 +4,7 @@ import (
 	extensioncurrency "github.com/ProtoconNet/mitum-currency-extension/v2/currency"
 	bsonenc "github.com/ProtoconNet/mitum-currency/v2/digest/util/bson"
 	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
 	"github.com/ProtoconNet/mitum2/util/hint"
 	"go.mongodb.org/mongo-driver/bson"
@@ -192,3 +193,596 @@ func (s *AgentBoxStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
 
 	return nil
 }
+
+func (s CollectionBoxStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":         s.Hint().String(),
+			"collectionbox": s.Box,
+		},
+	)
+}
+
+type CollectionBoxStateValueBSONUnmarshaler struct {
+	Hint string   `bson:"_hint"`
+	Box  bson.Raw `bson:"collectionbox"`
+}
+
+func (s *CollectionBoxStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode bson of CollectionBoxStateValue")
+
+	var u CollectionBoxStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e(err, "")
+	}
+	s.BaseHinter = hint.NewBaseHinter(ht)
+
+	var box CollectionBox
+	if err := box.DecodeBSON(u.Box, enc); err != nil {
+		return e(err, "")
+	}
+	s.Box = box
+
+	return nil
+}
+
+func (s ListingStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":   s.Hint().String(),
+			"listing": s.Listing,
+		},
+	)
+}
+
+type ListingStateValueBSONUnmarshaler struct {
+	Hint    string   `bson:"_hint"`
+	Listing bson.Raw `bson:"listing"`
+}
+
+func (s *ListingStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode bson of ListingStateValue")
+
+	var u ListingStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e(err, "")
+	}
+	s.BaseHinter = hint.NewBaseHinter(ht)
+
+	var listing Listing
+	if err := listing.DecodeBSON(u.Listing, enc); err != nil {
+		return e(err, "")
+	}
+	s.Listing = listing
+
+	return nil
+}
+
+func (s AuctionStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":   s.Hint().String(),
+			"auction": s.Auction,
+		},
+	)
+}
+
+type AuctionStateValueBSONUnmarshaler struct {
+	Hint    string   `bson:"_hint"`
+	Auction bson.Raw `bson:"auction"`
+}
+
+func (s *AuctionStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode bson of AuctionStateValue")
+
+	var u AuctionStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e(err, "")
+	}
+	s.BaseHinter = hint.NewBaseHinter(ht)
+
+	var auction Auction
+	if err := auction.DecodeBSON(u.Auction, enc); err != nil {
+		return e(err, "")
+	}
+	s.Auction = auction
+
+	return nil
+}
+
+func (s OfferStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint": s.Hint().String(),
+			"offer": s.Offer,
+		},
+	)
+}
+
+type OfferStateValueBSONUnmarshaler struct {
+	Hint  string   `bson:"_hint"`
+	Offer bson.Raw `bson:"offer"`
+}
+
+func (s *OfferStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode bson of OfferStateValue")
+
+	var u OfferStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e(err, "")
+	}
+	s.BaseHinter = hint.NewBaseHinter(ht)
+
+	var offer Offer
+	if err := offer.DecodeBSON(u.Offer, enc); err != nil {
+		return e(err, "")
+	}
+	s.Offer = offer
+
+	return nil
+}
+
+func (s CollectionStatusStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":  s.Hint().String(),
+			"status": s.CollectionStatus,
+		},
+	)
+}
+
+type CollectionStatusStateValueBSONUnmarshaler struct {
+	Hint             string   `bson:"_hint"`
+	CollectionStatus bson.Raw `bson:"status"`
+}
+
+func (s *CollectionStatusStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode bson of CollectionStatusStateValue")
+
+	var u CollectionStatusStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e(err, "")
+	}
+	s.BaseHinter = hint.NewBaseHinter(ht)
+
+	var status CollectionStatus
+	if err := status.DecodeBSON(u.CollectionStatus, enc); err != nil {
+		return e(err, "")
+	}
+	s.CollectionStatus = status
+
+	return nil
+}
+
+func (s MintCountStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":      s.Hint().String(),
+			"collection": s.Collection,
+			"account":    s.Account,
+			"count":      s.Count,
+		},
+	)
+}
+
+type MintCountStateValueBSONUnmarshaler struct {
+	Hint       string `bson:"_hint"`
+	Collection string `bson:"collection"`
+	Account    string `bson:"account"`
+	Count      uint64 `bson:"count"`
+}
+
+func (s *MintCountStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode bson of MintCountStateValue")
+
+	var u MintCountStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e(err, "")
+	}
+	s.BaseHinter = hint.NewBaseHinter(ht)
+
+	s.Collection = extensioncurrency.ContractID(u.Collection)
+	s.Count = u.Count
+
+	account, err := base.DecodeAddress(u.Account, enc)
+	if err != nil {
+		return e(err, "")
+	}
+	s.Account = account
+
+	return nil
+}
+
+func (s WhiteStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":      s.Hint().String(),
+			"collection": s.Collection,
+			"account":    s.Account,
+			"quota":      s.Quota,
+			"active":     s.Active,
+		},
+	)
+}
+
+type WhiteStateValueBSONUnmarshaler struct {
+	Hint       string `bson:"_hint"`
+	Collection string `bson:"collection"`
+	Account    string `bson:"account"`
+	Quota      uint64 `bson:"quota"`
+	Active     bool   `bson:"active"`
+}
+
+func (s *WhiteStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode bson of WhiteStateValue")
+
+	var u WhiteStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e(err, "")
+	}
+	s.BaseHinter = hint.NewBaseHinter(ht)
+
+	s.Collection = extensioncurrency.ContractID(u.Collection)
+	s.Quota = u.Quota
+	s.Active = u.Active
+
+	account, err := base.DecodeAddress(u.Account, enc)
+	if err != nil {
+		return e(err, "")
+	}
+	s.Account = account
+
+	return nil
+}
+
+func (s FreezeStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":  s.Hint().String(),
+			"nft":    s.NFT,
+			"frozen": s.Frozen,
+		},
+	)
+}
+
+type FreezeStateValueBSONUnmarshaler struct {
+	Hint   string   `bson:"_hint"`
+	NFT    bson.Raw `bson:"nft"`
+	Frozen bool     `bson:"frozen"`
+}
+
+func (s *FreezeStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode bson of FreezeStateValue")
+
+	var u FreezeStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e(err, "")
+	}
+	s.BaseHinter = hint.NewBaseHinter(ht)
+	s.Frozen = u.Frozen
+
+	var n nft.NFTID
+	if err := n.DecodeBSON(u.NFT, enc); err != nil {
+		return e(err, "")
+	}
+	s.NFT = n
+
+	return nil
+}
+
+func (s EditionStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":   s.Hint().String(),
+			"edition": s.Edition,
+		},
+	)
+}
+
+type EditionStateValueBSONUnmarshaler struct {
+	Hint    string   `bson:"_hint"`
+	Edition bson.Raw `bson:"edition"`
+}
+
+func (s *EditionStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode bson of EditionStateValue")
+
+	var u EditionStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e(err, "")
+	}
+	s.BaseHinter = hint.NewBaseHinter(ht)
+
+	var edition Edition
+	if err := edition.DecodeBSON(u.Edition, enc); err != nil {
+		return e(err, "")
+	}
+	s.Edition = edition
+
+	return nil
+}
+
+func (s EditionBalanceStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":  s.Hint().String(),
+			"token":  s.Token,
+			"holder": s.Holder,
+			"amount": s.Amount,
+		},
+	)
+}
+
+type EditionBalanceStateValueBSONUnmarshaler struct {
+	Hint   string   `bson:"_hint"`
+	Token  bson.Raw `bson:"token"`
+	Holder string   `bson:"holder"`
+	Amount uint64   `bson:"amount"`
+}
+
+func (s *EditionBalanceStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode bson of EditionBalanceStateValue")
+
+	var u EditionBalanceStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e(err, "")
+	}
+	s.BaseHinter = hint.NewBaseHinter(ht)
+	s.Amount = u.Amount
+
+	var n nft.NFTID
+	if err := n.DecodeBSON(u.Token, enc); err != nil {
+		return e(err, "")
+	}
+	s.Token = n
+
+	holder, err := base.DecodeAddress(u.Holder, enc)
+	if err != nil {
+		return e(err, "")
+	}
+	s.Holder = holder
+
+	return nil
+}
+
+func (s FractionStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":    s.Hint().String(),
+			"fraction": s.Fraction,
+		},
+	)
+}
+
+type FractionStateValueBSONUnmarshaler struct {
+	Hint     string   `bson:"_hint"`
+	Fraction bson.Raw `bson:"fraction"`
+}
+
+func (s *FractionStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode bson of FractionStateValue")
+
+	var u FractionStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e(err, "")
+	}
+	s.BaseHinter = hint.NewBaseHinter(ht)
+
+	var fraction Fraction
+	if err := fraction.DecodeBSON(u.Fraction, enc); err != nil {
+		return e(err, "")
+	}
+	s.Fraction = fraction
+
+	return nil
+}
+
+func (s NFTParentStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint": s.Hint().String(),
+			"link":  s.Link,
+		},
+	)
+}
+
+type NFTParentStateValueBSONUnmarshaler struct {
+	Hint string   `bson:"_hint"`
+	Link bson.Raw `bson:"link"`
+}
+
+func (s *NFTParentStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode bson of NFTParentStateValue")
+
+	var u NFTParentStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e(err, "")
+	}
+	s.BaseHinter = hint.NewBaseHinter(ht)
+
+	var link NFTLink
+	if err := link.DecodeBSON(u.Link, enc); err != nil {
+		return e(err, "")
+	}
+	s.Link = link
+
+	return nil
+}
+
+func (s NFTChildrenStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint": s.Hint().String(),
+			"box":   s.Box,
+		},
+	)
+}
+
+type NFTChildrenStateValueBSONUnmarshaler struct {
+	Hint string   `bson:"_hint"`
+	Box  bson.Raw `bson:"box"`
+}
+
+func (s *NFTChildrenStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode bson of NFTChildrenStateValue")
+
+	var u NFTChildrenStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e(err, "")
+	}
+	s.BaseHinter = hint.NewBaseHinter(ht)
+
+	var box NFTBox
+	if err := box.DecodeBSON(u.Box, enc); err != nil {
+		return e(err, "")
+	}
+	s.Box = box
+
+	return nil
+}
+
+func (s UsedVoucherStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":      s.Hint().String(),
+			"collection": s.Collection,
+			"signer":     s.Signer,
+			"nonce":      s.Nonce,
+		},
+	)
+}
+
+type UsedVoucherStateValueBSONUnmarshaler struct {
+	Hint       string `bson:"_hint"`
+	Collection string `bson:"collection"`
+	Signer     string `bson:"signer"`
+	Nonce      uint64 `bson:"nonce"`
+}
+
+func (s *UsedVoucherStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode bson of UsedVoucherStateValue")
+
+	var u UsedVoucherStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e(err, "")
+	}
+	s.BaseHinter = hint.NewBaseHinter(ht)
+
+	s.Collection = extensioncurrency.ContractID(u.Collection)
+	s.Nonce = u.Nonce
+
+	signer, err := base.DecodeAddress(u.Signer, enc)
+	if err != nil {
+		return e(err, "")
+	}
+	s.Signer = signer
+
+	return nil
+}
+
+func (s SignRevocationStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":      s.Hint().String(),
+			"revocation": s.Revocation,
+		},
+	)
+}
+
+type SignRevocationStateValueBSONUnmarshaler struct {
+	Hint       string   `bson:"_hint"`
+	Revocation bson.Raw `bson:"revocation"`
+}
+
+func (s *SignRevocationStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode bson of SignRevocationStateValue")
+
+	var u SignRevocationStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e(err, "")
+	}
+	s.BaseHinter = hint.NewBaseHinter(ht)
+
+	var revocation SignRevocation
+	if err := revocation.DecodeBSON(u.Revocation, enc); err != nil {
+		return e(err, "")
+	}
+	s.Revocation = revocation
+
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 
 	extensioncurrency "github.com/ProtoconNet/mitum-currency-extension/v2/currency"
 	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
 	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
 	"github.com/ProtoconNet/mitum2/util/hint"
@@ -182,3 +183,563 @@ func (s *AgentBoxStateValue) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
 
 	return nil
 }
+
+type CollectionBoxStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Box CollectionBox `json:"collectionbox"`
+}
+
+func (s CollectionBoxStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(
+		CollectionBoxStateValueJSONMarshaler(s),
+	)
+}
+
+type CollectionBoxStateValueJSONUnmarshaler struct {
+	Hint hint.Hint       `json:"_hint"`
+	Box  json.RawMessage `json:"collectionbox"`
+}
+
+func (s *CollectionBoxStateValue) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of CollectionBoxStateValue")
+
+	var u CollectionBoxStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	s.BaseHinter = hint.NewBaseHinter(u.Hint)
+
+	var box CollectionBox
+	if err := box.DecodeJSON(u.Box, enc); err != nil {
+		return e(err, "")
+	}
+	s.Box = box
+
+	return nil
+}
+
+type ListingStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Listing Listing `json:"listing"`
+}
+
+func (s ListingStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(
+		ListingStateValueJSONMarshaler(s),
+	)
+}
+
+type ListingStateValueJSONUnmarshaler struct {
+	Hint    hint.Hint       `json:"_hint"`
+	Listing json.RawMessage `json:"listing"`
+}
+
+func (s *ListingStateValue) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of ListingStateValue")
+
+	var u ListingStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	s.BaseHinter = hint.NewBaseHinter(u.Hint)
+
+	var listing Listing
+	if err := listing.DecodeJSON(u.Listing, enc); err != nil {
+		return e(err, "")
+	}
+	s.Listing = listing
+
+	return nil
+}
+
+type AuctionStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Auction Auction `json:"auction"`
+}
+
+func (s AuctionStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(
+		AuctionStateValueJSONMarshaler(s),
+	)
+}
+
+type AuctionStateValueJSONUnmarshaler struct {
+	Hint    hint.Hint       `json:"_hint"`
+	Auction json.RawMessage `json:"auction"`
+}
+
+func (s *AuctionStateValue) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of AuctionStateValue")
+
+	var u AuctionStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	s.BaseHinter = hint.NewBaseHinter(u.Hint)
+
+	var auction Auction
+	if err := auction.DecodeJSON(u.Auction, enc); err != nil {
+		return e(err, "")
+	}
+	s.Auction = auction
+
+	return nil
+}
+
+type OfferStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Offer Offer `json:"offer"`
+}
+
+func (s OfferStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(
+		OfferStateValueJSONMarshaler(s),
+	)
+}
+
+type OfferStateValueJSONUnmarshaler struct {
+	Hint  hint.Hint       `json:"_hint"`
+	Offer json.RawMessage `json:"offer"`
+}
+
+func (s *OfferStateValue) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of OfferStateValue")
+
+	var u OfferStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	s.BaseHinter = hint.NewBaseHinter(u.Hint)
+
+	var offer Offer
+	if err := offer.DecodeJSON(u.Offer, enc); err != nil {
+		return e(err, "")
+	}
+	s.Offer = offer
+
+	return nil
+}
+
+type CollectionStatusStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	CollectionStatus CollectionStatus `json:"status"`
+}
+
+func (s CollectionStatusStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(
+		CollectionStatusStateValueJSONMarshaler(s),
+	)
+}
+
+type CollectionStatusStateValueJSONUnmarshaler struct {
+	Hint             hint.Hint       `json:"_hint"`
+	CollectionStatus json.RawMessage `json:"status"`
+}
+
+func (s *CollectionStatusStateValue) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of CollectionStatusStateValue")
+
+	var u CollectionStatusStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	s.BaseHinter = hint.NewBaseHinter(u.Hint)
+
+	var status CollectionStatus
+	if err := status.DecodeJSON(u.CollectionStatus, enc); err != nil {
+		return e(err, "")
+	}
+	s.CollectionStatus = status
+
+	return nil
+}
+
+type MintCountStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Collection extensioncurrency.ContractID `json:"collection"`
+	Account    base.Address                 `json:"account"`
+	Count      uint64                       `json:"count"`
+}
+
+func (s MintCountStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(
+		MintCountStateValueJSONMarshaler(s),
+	)
+}
+
+type MintCountStateValueJSONUnmarshaler struct {
+	Hint       hint.Hint `json:"_hint"`
+	Collection string    `json:"collection"`
+	Account    string    `json:"account"`
+	Count      uint64    `json:"count"`
+}
+
+func (s *MintCountStateValue) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of MintCountStateValue")
+
+	var u MintCountStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	s.BaseHinter = hint.NewBaseHinter(u.Hint)
+	s.Collection = extensioncurrency.ContractID(u.Collection)
+	s.Count = u.Count
+
+	account, err := base.DecodeAddress(u.Account, enc)
+	if err != nil {
+		return e(err, "")
+	}
+	s.Account = account
+
+	return nil
+}
+
+type WhiteStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Collection extensioncurrency.ContractID `json:"collection"`
+	Account    base.Address                 `json:"account"`
+	Quota      uint64                       `json:"quota"`
+	Active     bool                         `json:"active"`
+}
+
+func (s WhiteStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(
+		WhiteStateValueJSONMarshaler(s),
+	)
+}
+
+type WhiteStateValueJSONUnmarshaler struct {
+	Hint       hint.Hint `json:"_hint"`
+	Collection string    `json:"collection"`
+	Account    string    `json:"account"`
+	Quota      uint64    `json:"quota"`
+	Active     bool      `json:"active"`
+}
+
+func (s *WhiteStateValue) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of WhiteStateValue")
+
+	var u WhiteStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	s.BaseHinter = hint.NewBaseHinter(u.Hint)
+	s.Collection = extensioncurrency.ContractID(u.Collection)
+	s.Quota = u.Quota
+	s.Active = u.Active
+
+	account, err := base.DecodeAddress(u.Account, enc)
+	if err != nil {
+		return e(err, "")
+	}
+	s.Account = account
+
+	return nil
+}
+
+type FreezeStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	NFT    nft.NFTID `json:"nft"`
+	Frozen bool      `json:"frozen"`
+}
+
+func (s FreezeStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(
+		FreezeStateValueJSONMarshaler(s),
+	)
+}
+
+type FreezeStateValueJSONUnmarshaler struct {
+	Hint   hint.Hint       `json:"_hint"`
+	NFT    json.RawMessage `json:"nft"`
+	Frozen bool            `json:"frozen"`
+}
+
+func (s *FreezeStateValue) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of FreezeStateValue")
+
+	var u FreezeStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	s.BaseHinter = hint.NewBaseHinter(u.Hint)
+	s.Frozen = u.Frozen
+
+	var n nft.NFTID
+	if err := n.DecodeJSON(u.NFT, enc); err != nil {
+		return e(err, "")
+	}
+	s.NFT = n
+
+	return nil
+}
+
+type EditionStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Edition Edition `json:"edition"`
+}
+
+func (s EditionStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(
+		EditionStateValueJSONMarshaler(s),
+	)
+}
+
+type EditionStateValueJSONUnmarshaler struct {
+	Hint    hint.Hint       `json:"_hint"`
+	Edition json.RawMessage `json:"edition"`
+}
+
+func (s *EditionStateValue) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of EditionStateValue")
+
+	var u EditionStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	s.BaseHinter = hint.NewBaseHinter(u.Hint)
+
+	var edition Edition
+	if err := edition.DecodeJSON(u.Edition, enc); err != nil {
+		return e(err, "")
+	}
+	s.Edition = edition
+
+	return nil
+}
+
+type EditionBalanceStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Token  nft.NFTID    `json:"token"`
+	Holder base.Address `json:"holder"`
+	Amount uint64       `json:"amount"`
+}
+
+func (s EditionBalanceStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(
+		EditionBalanceStateValueJSONMarshaler(s),
+	)
+}
+
+type EditionBalanceStateValueJSONUnmarshaler struct {
+	Hint   hint.Hint       `json:"_hint"`
+	Token  json.RawMessage `json:"token"`
+	Holder string          `json:"holder"`
+	Amount uint64          `json:"amount"`
+}
+
+func (s *EditionBalanceStateValue) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of EditionBalanceStateValue")
+
+	var u EditionBalanceStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	s.BaseHinter = hint.NewBaseHinter(u.Hint)
+	s.Amount = u.Amount
+
+	var n nft.NFTID
+	if err := n.DecodeJSON(u.Token, enc); err != nil {
+		return e(err, "")
+	}
+	s.Token = n
+
+	holder, err := base.DecodeAddress(u.Holder, enc)
+	if err != nil {
+		return e(err, "")
+	}
+	s.Holder = holder
+
+	return nil
+}
+
+type FractionStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Fraction Fraction `json:"fraction"`
+}
+
+func (s FractionStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(
+		FractionStateValueJSONMarshaler(s),
+	)
+}
+
+type FractionStateValueJSONUnmarshaler struct {
+	Hint     hint.Hint       `json:"_hint"`
+	Fraction json.RawMessage `json:"fraction"`
+}
+
+func (s *FractionStateValue) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of FractionStateValue")
+
+	var u FractionStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	s.BaseHinter = hint.NewBaseHinter(u.Hint)
+
+	var fraction Fraction
+	if err := fraction.DecodeJSON(u.Fraction, enc); err != nil {
+		return e(err, "")
+	}
+	s.Fraction = fraction
+
+	return nil
+}
+
+type NFTParentStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Link NFTLink `json:"link"`
+}
+
+func (s NFTParentStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(
+		NFTParentStateValueJSONMarshaler(s),
+	)
+}
+
+type NFTParentStateValueJSONUnmarshaler struct {
+	Hint hint.Hint       `json:"_hint"`
+	Link json.RawMessage `json:"link"`
+}
+
+func (s *NFTParentStateValue) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of NFTParentStateValue")
+
+	var u NFTParentStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	s.BaseHinter = hint.NewBaseHinter(u.Hint)
+
+	var link NFTLink
+	if err := link.DecodeJSON(u.Link, enc); err != nil {
+		return e(err, "")
+	}
+	s.Link = link
+
+	return nil
+}
+
+type NFTChildrenStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Box NFTBox `json:"box"`
+}
+
+func (s NFTChildrenStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(
+		NFTChildrenStateValueJSONMarshaler(s),
+	)
+}
+
+type NFTChildrenStateValueJSONUnmarshaler struct {
+	Hint hint.Hint       `json:"_hint"`
+	Box  json.RawMessage `json:"box"`
+}
+
+func (s *NFTChildrenStateValue) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of NFTChildrenStateValue")
+
+	var u NFTChildrenStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	s.BaseHinter = hint.NewBaseHinter(u.Hint)
+
+	var box NFTBox
+	if err := box.DecodeJSON(u.Box, enc); err != nil {
+		return e(err, "")
+	}
+	s.Box = box
+
+	return nil
+}
+
+type UsedVoucherStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Collection extensioncurrency.ContractID `json:"collection"`
+	Signer     base.Address                 `json:"signer"`
+	Nonce      uint64                       `json:"nonce"`
+}
+
+func (s UsedVoucherStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(
+		UsedVoucherStateValueJSONMarshaler(s),
+	)
+}
+
+type UsedVoucherStateValueJSONUnmarshaler struct {
+	Hint       hint.Hint `json:"_hint"`
+	Collection string    `json:"collection"`
+	Signer     string    `json:"signer"`
+	Nonce      uint64    `json:"nonce"`
+}
+
+func (s *UsedVoucherStateValue) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of UsedVoucherStateValue")
+
+	var u UsedVoucherStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	s.BaseHinter = hint.NewBaseHinter(u.Hint)
+	s.Collection = extensioncurrency.ContractID(u.Collection)
+	s.Nonce = u.Nonce
+
+	signer, err := base.DecodeAddress(u.Signer, enc)
+	if err != nil {
+		return e(err, "")
+	}
+	s.Signer = signer
+
+	return nil
+}
+
+type SignRevocationStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Revocation SignRevocation `json:"revocation"`
+}
+
+func (s SignRevocationStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(
+		SignRevocationStateValueJSONMarshaler(s),
+	)
+}
+
+type SignRevocationStateValueJSONUnmarshaler struct {
+	Hint       hint.Hint       `json:"_hint"`
+	Revocation json.RawMessage `json:"revocation"`
+}
+
+func (s *SignRevocationStateValue) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of SignRevocationStateValue")
+
+	var u SignRevocationStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	s.BaseHinter = hint.NewBaseHinter(u.Hint)
+
+	var revocation SignRevocation
+	if err := revocation.DecodeJSON(u.Revocation, enc); err != nil {
+		return e(err, "")
+	}
+	s.Revocation = revocation
+
+	return nil
+}
@@ -0,0 +1,127 @@
+package collection
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	SwapFactHint = hint.MustNewHint("mitum-nft-swap-operation-fact-v0.0.1")
+	SwapHint     = hint.MustNewHint("mitum-nft-swap-operation-v0.0.1")
+)
+
+type SwapFact struct {
+	base.BaseFact
+	left     SwapSide
+	right    SwapSide
+	currency currency.CurrencyID
+}
+
+func NewSwapFact(token []byte, left, right SwapSide, currency currency.CurrencyID) SwapFact {
+	bf := base.NewBaseFact(SwapFactHint, token)
+
+	fact := SwapFact{
+		BaseFact: bf,
+		left:     left,
+		right:    right,
+		currency: currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact SwapFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := currency.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if err := util.CheckIsValiders(nil, false, fact.left, fact.right, fact.currency); err != nil {
+		return err
+	}
+
+	if fact.left.Sender().Equal(fact.right.Sender()) {
+		return util.ErrInvalid.Errorf("both sides have the same sender, %q", fact.left.Sender())
+	}
+
+	if len(fact.left.NFTs())+len(fact.right.NFTs()) < 1 {
+		return util.ErrInvalid.Errorf("empty nfts for SwapFact")
+	}
+
+	founds := map[string]struct{}{}
+	for _, n := range fact.left.NFTs() {
+		founds[n.String()] = struct{}{}
+	}
+
+	for _, n := range fact.right.NFTs() {
+		if _, found := founds[n.String()]; found {
+			return util.ErrInvalid.Errorf("duplicate nft found, %q", n)
+		}
+	}
+
+	return nil
+}
+
+func (fact SwapFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact SwapFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact SwapFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.left.Bytes(),
+		fact.right.Bytes(),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact SwapFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact SwapFact) Left() SwapSide {
+	return fact.left
+}
+
+func (fact SwapFact) Right() SwapSide {
+	return fact.right
+}
+
+func (fact SwapFact) Currency() currency.CurrencyID {
+	return fact.currency
+}
+
+func (fact SwapFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 2)
+	as[0] = fact.left.Sender()
+	as[1] = fact.right.Sender()
+
+	return as, nil
+}
+
+type Swap struct {
+	currency.BaseOperation
+}
+
+func NewSwap(fact SwapFact) (Swap, error) {
+	return Swap{BaseOperation: currency.NewBaseOperation(SwapHint, fact)}, nil
+}
+
+func (op *Swap) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,31 @@
+package collection
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+func (fact *SwapFact) unmarshal(enc encoder.Encoder, bl, br []byte, cid string) error {
+	e := util.StringErrorFunc("failed to unmarshal SwapFact")
+
+	if hinter, err := enc.Decode(bl); err != nil {
+		return e(err, "")
+	} else if sd, ok := hinter.(SwapSide); !ok {
+		return e(util.ErrWrongType.Errorf("expected SwapSide, not %T", hinter), "")
+	} else {
+		fact.left = sd
+	}
+
+	if hinter, err := enc.Decode(br); err != nil {
+		return e(err, "")
+	} else if sd, ok := hinter.(SwapSide); !ok {
+		return e(util.ErrWrongType.Errorf("expected SwapSide, not %T", hinter), "")
+	} else {
+		fact.right = sd
+	}
+
+	fact.currency = currency.CurrencyID(cid)
+
+	return nil
+}
@@ -0,0 +1,69 @@
+package collection
+
+import (
+	"encoding/json"
+
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+)
+
+type SwapFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Left     SwapSide            `json:"left"`
+	Right    SwapSide            `json:"right"`
+	Currency currency.CurrencyID `json:"currency"`
+}
+
+func (fact SwapFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(SwapFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Left:                  fact.left,
+		Right:                 fact.right,
+		Currency:              fact.currency,
+	})
+}
+
+type SwapFactJSONUnmarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Left     json.RawMessage `json:"left"`
+	Right    json.RawMessage `json:"right"`
+	Currency string          `json:"currency"`
+}
+
+func (fact *SwapFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of SwapFact")
+
+	var uf SwapFactJSONUnmarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e(err, "")
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	return fact.unmarshal(enc, uf.Left, uf.Right, uf.Currency)
+}
+
+type swapMarshaler struct {
+	currency.BaseOperationJSONMarshaler
+}
+
+func (op Swap) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(swapMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *Swap) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of Swap")
+
+	var ubo currency.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e(err, "")
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,253 @@
+package collection
+
+import (
+	"context"
+	"sync"
+
+	extensioncurrency "github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var swapProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(SwapProcessor)
+	},
+}
+
+func (Swap) Process(
+	ctx context.Context, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type SwapProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewSwapProcessor() extensioncurrency.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringErrorFunc("failed to create new SwapProcessor")
+
+		nopp := swapProcessorPool.Get()
+		opp, ok := nopp.(*SwapProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected SwapProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e(err, "")
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *SwapProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	e := util.StringErrorFunc("failed to preprocess Swap")
+
+	fact, ok := op.Fact().(SwapFact)
+	if !ok {
+		return ctx, nil, e(nil, "expected SwapFact, not %T", op.Fact())
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, nil, e(err, "")
+	}
+
+	for _, side := range []SwapSide{fact.Left(), fact.Right()} {
+		if err := checkExistsState(currency.StateKeyAccount(side.Sender()), getStateFunc); err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("sender not found, %q: %w", side.Sender(), err), nil
+		}
+
+		if err := checkNotExistsState(extensioncurrency.StateKeyContractAccount(side.Sender()), getStateFunc); err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("contract account cannot swap nfts, %q: %w", side.Sender(), err), nil
+		}
+	}
+
+	if err := checkMultiFactSignsByState([]base.Address{fact.Left().Sender(), fact.Right().Sender()}, op.Signs(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("invalid signing: %w", err), nil
+	}
+
+	if _, err := existsCurrencyPolicy(fact.Currency(), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("currency not found, %q: %w", fact.Currency(), err), nil
+	}
+
+	for _, side := range []SwapSide{fact.Left(), fact.Right()} {
+		if err := checkSwapSide(side, getStateFunc); err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("invalid swap side, %q: %w", side.Sender(), err), nil
+		}
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *SwapProcessor) Process( // nolint:dupl
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	e := util.StringErrorFunc("failed to process Swap")
+
+	fact, ok := op.Fact().(SwapFact)
+	if !ok {
+		return nil, nil, e(nil, "expected SwapFact, not %T", op.Fact())
+	}
+
+	currencyPolicy, err := existsCurrencyPolicy(fact.Currency(), getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("currency not found, %q: %w", fact.Currency(), err), nil
+	}
+
+	fee, err := currencyPolicy.Feeer().Fee(currency.ZeroBig)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to check fee of currency, %q: %w", fact.Currency(), err), nil
+	}
+
+	balances := newBalanceChanges(getStateFunc)
+
+	var sts []base.StateMergeValue // nolint:prealloc
+	for _, sides := range [][2]SwapSide{{fact.Left(), fact.Right()}, {fact.Right(), fact.Left()}} {
+		from, to := sides[0], sides[1]
+
+		s, err := swapNFTs(from.NFTs(), to.Sender(), getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("failed to swap nfts of %q: %w", from.Sender(), err), nil
+		}
+		sts = append(sts, s...)
+
+		for _, am := range from.Amounts() {
+			if err := balances.sub(from.Sender(), am); err != nil {
+				return nil, base.NewBaseOperationProcessReasonError("failed to check enough balance: %w", err), nil
+			}
+			if err := balances.add(to.Sender(), am); err != nil {
+				return nil, base.NewBaseOperationProcessReasonError("failed to pay amount, %q: %w", to.Sender(), err), nil
+			}
+		}
+	}
+
+	for _, side := range []SwapSide{fact.Left(), fact.Right()} {
+		if err := balances.sub(side.Sender(), currency.NewAmount(fee, fact.Currency())); err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("failed to check enough balance: %w", err), nil
+		}
+	}
+
+	sts = append(sts, balances.stateMergeValues()...)
+
+	return sts, nil, nil
+}
+
+func (opp *SwapProcessor) Close() error {
+	swapProcessorPool.Put(opp)
+
+	return nil
+}
+
+func checkSwapSide(side SwapSide, getStateFunc base.GetStateFunc) error {
+	for _, am := range side.Amounts() {
+		if _, err := existsCurrencyPolicy(am.Currency(), getStateFunc); err != nil {
+			return errors.Errorf("currency of amount not found, %q: %w", am.Currency(), err)
+		}
+	}
+
+	for _, nid := range side.NFTs() {
+		if err := checkActiveCollection(nid.Collection(), getStateFunc); err != nil {
+			return err
+		}
+
+		st, err := existsState(StateKeyNFT(nid), "key of nft", getStateFunc)
+		if err != nil {
+			return errors.Errorf("nft not found, %q: %w", nid, err)
+		}
+
+		nv, err := StateNFTValue(st)
+		if err != nil {
+			return errors.Errorf("nft value not found, %q: %w", nid, err)
+		}
+
+		if !nv.Active() {
+			return errors.Errorf("burned nft, %q", nid)
+		}
+
+		if !nv.Owner().Equal(side.Sender()) {
+			return errors.Errorf("not owner of nft, %q, %q", nid, side.Sender())
+		}
+
+		if err := checkNotFrozen(nid, getStateFunc); err != nil {
+			return errors.Errorf("nft not available, %q: %w", nid, err)
+		}
+
+		if err := checkNotInAuction(nid, getStateFunc); err != nil {
+			return errors.Errorf("nft not available, %q: %w", nid, err)
+		}
+
+		if err := checkNotAttached(nid, getStateFunc); err != nil {
+			return errors.Errorf("nft not available, %q: %w", nid, err)
+		}
+
+		if err := checkTransferable(nid.Collection(), getStateFunc); err != nil {
+			return errors.Errorf("nft not transferable, %q: %w", nid, err)
+		}
+
+		if err := checkSigned(nv, getStateFunc); err != nil {
+			return errors.Errorf("nft not transferable, %q: %w", nid, err)
+		}
+
+		if err := checkChildrenMovable(nid, getStateFunc); err != nil {
+			return errors.Errorf("nft not transferable, %q: %w", nid, err)
+		}
+	}
+
+	return nil
+}
+
+func swapNFTs(nids []nft.NFTID, receiver base.Address, getStateFunc base.GetStateFunc) ([]base.StateMergeValue, error) {
+	var sts []base.StateMergeValue // nolint:prealloc
+	for _, nid := range nids {
+		st, err := existsState(StateKeyNFT(nid), "key of nft", getStateFunc)
+		if err != nil {
+			return nil, errors.Errorf("nft not found, %q: %w", nid, err)
+		}
+
+		nv, err := StateNFTValue(st)
+		if err != nil {
+			return nil, errors.Errorf("nft value not found, %q: %w", nid, err)
+		}
+
+		n := nft.NewNFT(nid, nv.Active(), receiver, nv.NFTHash(), nv.URI(), receiver, nv.Creators(), nv.Copyrighters(), receiver, 0, nv.Royalty(), nv.RoyaltyReceiver())
+		if err := n.IsValid(nil); err != nil {
+			return nil, errors.Errorf("invalid nft, %q: %w", nid, err)
+		}
+
+		sts = append(sts, NewNFTStateMergeValue(st.Key(), NewNFTStateValue(n)))
+
+		sv, err := cancelListing(nid, getStateFunc)
+		if err != nil {
+			return nil, errors.Errorf("failed to cancel listing, %q: %w", nid, err)
+		}
+		if sv != nil {
+			sts = append(sts, sv)
+		}
+
+		s, err := moveChildren(nid, receiver, getStateFunc)
+		if err != nil {
+			return nil, errors.Errorf("failed to move children, %q: %w", nid, err)
+		}
+		sts = append(sts, s...)
+	}
+
+	return sts, nil
+}
@@ -0,0 +1,112 @@
+package collection
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+var SwapSideHint = hint.MustNewHint("mitum-nft-swap-side-v0.0.1")
+
+var (
+	MaxSwapNFTs    = 10
+	MaxSwapAmounts = 10
+)
+
+type SwapSide struct {
+	hint.BaseHinter
+	sender  base.Address
+	nfts    []nft.NFTID
+	amounts []currency.Amount
+}
+
+func NewSwapSide(sender base.Address, nfts []nft.NFTID, amounts []currency.Amount) SwapSide {
+	return SwapSide{
+		BaseHinter: hint.NewBaseHinter(SwapSideHint),
+		sender:     sender,
+		nfts:       nfts,
+		amounts:    amounts,
+	}
+}
+
+func (sd SwapSide) IsValid([]byte) error {
+	if err := util.CheckIsValiders(nil, false, sd.BaseHinter, sd.sender); err != nil {
+		return err
+	}
+
+	if l := len(sd.nfts); l > MaxSwapNFTs {
+		return util.ErrInvalid.Errorf("nfts over allowed, %d > %d", l, MaxSwapNFTs)
+	}
+
+	if l := len(sd.amounts); l > MaxSwapAmounts {
+		return util.ErrInvalid.Errorf("amounts over allowed, %d > %d", l, MaxSwapAmounts)
+	}
+
+	if len(sd.nfts) < 1 && len(sd.amounts) < 1 {
+		return util.ErrInvalid.Errorf("empty swap side, %q", sd.sender)
+	}
+
+	founds := map[string]struct{}{}
+	for _, n := range sd.nfts {
+		if err := n.IsValid(nil); err != nil {
+			return err
+		}
+
+		if _, found := founds[n.String()]; found {
+			return util.ErrInvalid.Errorf("duplicate nft found, %q", n)
+		}
+
+		founds[n.String()] = struct{}{}
+	}
+
+	cids := map[currency.CurrencyID]struct{}{}
+	for _, am := range sd.amounts {
+		if err := am.IsValid(nil); err != nil {
+			return err
+		}
+
+		if !am.Big().OverZero() {
+			return util.ErrInvalid.Errorf("amount must be over zero, %q", am.Big())
+		}
+
+		if _, found := cids[am.Currency()]; found {
+			return util.ErrInvalid.Errorf("duplicate currency found, %q", am.Currency())
+		}
+
+		cids[am.Currency()] = struct{}{}
+	}
+
+	return nil
+}
+
+func (sd SwapSide) Bytes() []byte {
+	ns := make([][]byte, len(sd.nfts))
+	for i := range sd.nfts {
+		ns[i] = sd.nfts[i].Bytes()
+	}
+
+	as := make([][]byte, len(sd.amounts))
+	for i := range sd.amounts {
+		as[i] = sd.amounts[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		sd.sender.Bytes(),
+		util.ConcatBytesSlice(ns...),
+		util.ConcatBytesSlice(as...),
+	)
+}
+
+func (sd SwapSide) Sender() base.Address {
+	return sd.sender
+}
+
+func (sd SwapSide) NFTs() []nft.NFTID {
+	return sd.nfts
+}
+
+func (sd SwapSide) Amounts() []currency.Amount {
+	return sd.amounts
+}
@@ -0,0 +1,42 @@
+package collection
+
+import (
+	bsonenc "github.com/ProtoconNet/mitum-currency/v2/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (sd SwapSide) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":   sd.Hint().String(),
+			"sender":  sd.sender,
+			"nfts":    sd.nfts,
+			"amounts": sd.amounts,
+		},
+	)
+}
+
+type SwapSideBSONUnmarshaler struct {
+	Hint    string   `bson:"_hint"`
+	Sender  string   `bson:"sender"`
+	NFTs    bson.Raw `bson:"nfts"`
+	Amounts bson.Raw `bson:"amounts"`
+}
+
+func (sd *SwapSide) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode bson of SwapSide")
+
+	var u SwapSideBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e(err, "")
+	}
+
+	return sd.unmarshal(enc, ht, u.Sender, u.NFTs, u.Amounts)
+}
@@ -0,0 +1,62 @@
+package collection
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (sd *SwapSide) unmarshal(
+	enc encoder.Encoder,
+	ht hint.Hint,
+	sdr string,
+	bns []byte,
+	bams []byte,
+) error {
+	e := util.StringErrorFunc("failed to unmarshal SwapSide")
+
+	sd.BaseHinter = hint.NewBaseHinter(ht)
+
+	sender, err := base.DecodeAddress(sdr, enc)
+	if err != nil {
+		return e(err, "")
+	}
+	sd.sender = sender
+
+	hns, err := enc.DecodeSlice(bns)
+	if err != nil {
+		return e(err, "")
+	}
+
+	nfts := make([]nft.NFTID, len(hns))
+	for i, hinter := range hns {
+		n, ok := hinter.(nft.NFTID)
+		if !ok {
+			return e(util.ErrWrongType.Errorf("expected NFTID, not %T", hinter), "")
+		}
+
+		nfts[i] = n
+	}
+	sd.nfts = nfts
+
+	hams, err := enc.DecodeSlice(bams)
+	if err != nil {
+		return e(err, "")
+	}
+
+	amounts := make([]currency.Amount, len(hams))
+	for i, hinter := range hams {
+		am, ok := hinter.(currency.Amount)
+		if !ok {
+			return e(util.ErrWrongType.Errorf("expected Amount, not %T", hinter), "")
+		}
+
+		amounts[i] = am
+	}
+	sd.amounts = amounts
+
+	return nil
+}
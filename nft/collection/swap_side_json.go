@@ -0,0 +1,46 @@
+package collection
+
+import (
+	"encoding/json"
+
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+type SwapSideJSONMarshaler struct {
+	hint.BaseHinter
+	Sender  base.Address      `json:"sender"`
+	NFTs    []nft.NFTID       `json:"nfts"`
+	Amounts []currency.Amount `json:"amounts"`
+}
+
+func (sd SwapSide) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(SwapSideJSONMarshaler{
+		BaseHinter: sd.BaseHinter,
+		Sender:     sd.sender,
+		NFTs:       sd.nfts,
+		Amounts:    sd.amounts,
+	})
+}
+
+type SwapSideJSONUnmarshaler struct {
+	Hint    hint.Hint       `json:"_hint"`
+	Sender  string          `json:"sender"`
+	NFTs    json.RawMessage `json:"nfts"`
+	Amounts json.RawMessage `json:"amounts"`
+}
+
+func (sd *SwapSide) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of SwapSide")
+
+	var u SwapSideJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	return sd.unmarshal(enc, u.Hint, u.Sender, u.NFTs, u.Amounts)
+}
@@ -0,0 +1,132 @@
+package collection
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var MaxUnfreezeItems = 10
+
+var (
+	UnfreezeFactHint = hint.MustNewHint("mitum-nft-unfreeze-operation-fact-v0.0.1")
+	UnfreezeHint     = hint.MustNewHint("mitum-nft-unfreeze-operation-v0.0.1")
+)
+
+type UnfreezeFact struct {
+	base.BaseFact
+	sender base.Address
+	items  []UnfreezeItem
+}
+
+func NewUnfreezeFact(token []byte, sender base.Address, items []UnfreezeItem) UnfreezeFact {
+	bf := base.NewBaseFact(UnfreezeFactHint, token)
+	fact := UnfreezeFact{
+		BaseFact: bf,
+		sender:   sender,
+		items:    items,
+	}
+
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact UnfreezeFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := currency.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if l := len(fact.items); l < 1 {
+		return util.ErrInvalid.Errorf("empty items for UnfreezeFact")
+	} else if l > int(MaxUnfreezeItems) {
+		return util.ErrInvalid.Errorf("items over allowed, %d > %d", l, MaxUnfreezeItems)
+	}
+
+	if err := fact.sender.IsValid(nil); err != nil {
+		return err
+	}
+
+	founds := map[string]struct{}{}
+	for _, item := range fact.items {
+		if err := item.IsValid(nil); err != nil {
+			return err
+		}
+
+		n := item.NFT()
+		if err := n.IsValid(nil); err != nil {
+			return err
+		}
+
+		if _, found := founds[n.String()]; found {
+			return util.ErrInvalid.Errorf("duplicate nft found, %q", n)
+		}
+
+		founds[n.String()] = struct{}{}
+	}
+
+	return nil
+}
+
+func (fact UnfreezeFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact UnfreezeFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact UnfreezeFact) Bytes() []byte {
+	is := make([][]byte, len(fact.items))
+
+	for i, item := range fact.items {
+		is[i] = item.Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		util.ConcatBytesSlice(is...),
+	)
+}
+
+func (fact UnfreezeFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact UnfreezeFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact UnfreezeFact) Items() []UnfreezeItem {
+	return fact.items
+}
+
+func (fact UnfreezeFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 1)
+	as[0] = fact.sender
+
+	return as, nil
+}
+
+type Unfreeze struct {
+	currency.BaseOperation
+}
+
+func NewUnfreeze(fact UnfreezeFact) (Unfreeze, error) {
+	return Unfreeze{BaseOperation: currency.NewBaseOperation(UnfreezeHint, fact)}, nil
+}
+
+func (op *Unfreeze) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
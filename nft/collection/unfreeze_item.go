@@ -0,0 +1,48 @@
+package collection
+
+import (
+	"github.com/ProtoconNet/mitum-nft/nft"
+
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+var UnfreezeItemHint = hint.MustNewHint("mitum-nft-unfreeze-item-v0.0.1")
+
+type UnfreezeItem struct {
+	hint.BaseHinter
+	nft      nft.NFTID
+	currency currency.CurrencyID
+}
+
+func NewUnfreezeItem(n nft.NFTID, currency currency.CurrencyID) UnfreezeItem {
+	return UnfreezeItem{
+		BaseHinter: hint.NewBaseHinter(UnfreezeItemHint),
+		nft:        n,
+		currency:   currency,
+	}
+}
+
+func (it UnfreezeItem) IsValid([]byte) error {
+	return util.CheckIsValiders(nil, false,
+		it.BaseHinter,
+		it.nft,
+		it.currency,
+	)
+}
+
+func (it UnfreezeItem) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		it.nft.Bytes(),
+		it.currency.Bytes(),
+	)
+}
+
+func (it UnfreezeItem) NFT() nft.NFTID {
+	return it.nft
+}
+
+func (it UnfreezeItem) Currency() currency.CurrencyID {
+	return it.currency
+}
@@ -0,0 +1,151 @@
+package collection
+
+import (
+	extensioncurrency "github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+var VoucherHint = hint.MustNewHint("mitum-nft-voucher-v0.0.1")
+
+type Voucher struct {
+	hint.BaseHinter
+	collection extensioncurrency.ContractID
+	hash       nft.NFTHash
+	uri        nft.URI
+	creators   nft.Signers
+	price      currency.Amount
+	nonce      uint64
+	signer     base.Address
+	signs      []base.Sign
+}
+
+func NewVoucher(
+	collection extensioncurrency.ContractID,
+	hash nft.NFTHash,
+	uri nft.URI,
+	creators nft.Signers,
+	price currency.Amount,
+	nonce uint64,
+	signer base.Address,
+) Voucher {
+	return Voucher{
+		BaseHinter: hint.NewBaseHinter(VoucherHint),
+		collection: collection,
+		hash:       hash,
+		uri:        uri,
+		creators:   creators,
+		price:      price,
+		nonce:      nonce,
+		signer:     signer,
+	}
+}
+
+// IsValid verifies the signs of voucher only when networkID is given.
+func (v Voucher) IsValid(networkID []byte) error {
+	if err := util.CheckIsValiders(nil, false,
+		v.BaseHinter,
+		v.collection,
+		v.hash,
+		v.uri,
+		v.creators,
+		v.price,
+		v.signer,
+	); err != nil {
+		return err
+	}
+
+	if len(v.uri.String()) < 1 {
+		return util.ErrInvalid.Errorf("empty uri")
+	}
+
+	for _, creator := range v.creators.Signers() {
+		if creator.Signed() {
+			return util.ErrInvalid.Errorf("cannot sign at the same time as minting, %q", creator.Account())
+		}
+	}
+
+	if len(v.signs) < 1 {
+		return util.ErrInvalid.Errorf("empty signs of voucher")
+	}
+
+	founds := map[string]struct{}{}
+	for _, s := range v.signs {
+		if err := s.IsValid(nil); err != nil {
+			return err
+		}
+
+		if _, found := founds[s.Signer().String()]; found {
+			return util.ErrInvalid.Errorf("duplicate sign of voucher found, %q", s.Signer())
+		}
+		founds[s.Signer().String()] = struct{}{}
+
+		if len(networkID) < 1 {
+			continue
+		}
+
+		if err := s.Verify(networkID, v.Bytes()); err != nil {
+			return util.ErrInvalid.Errorf("invalid sign of voucher, %q: %w", s.Signer(), err)
+		}
+	}
+
+	return nil
+}
+
+func (v Voucher) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		v.collection.Bytes(),
+		v.hash.Bytes(),
+		v.uri.Bytes(),
+		v.creators.Bytes(),
+		v.price.Bytes(),
+		util.Uint64ToBytes(v.nonce),
+		v.signer.Bytes(),
+	)
+}
+
+func (v *Voucher) Sign(priv base.Privatekey, networkID base.NetworkID) error {
+	s, err := base.NewBaseSignFromBytes(priv, networkID, v.Bytes())
+	if err != nil {
+		return err
+	}
+
+	v.signs = append(v.signs, s)
+
+	return nil
+}
+
+func (v Voucher) Collection() extensioncurrency.ContractID {
+	return v.collection
+}
+
+func (v Voucher) NFTHash() nft.NFTHash {
+	return v.hash
+}
+
+func (v Voucher) URI() nft.URI {
+	return v.uri
+}
+
+func (v Voucher) Creators() nft.Signers {
+	return v.creators
+}
+
+func (v Voucher) Price() currency.Amount {
+	return v.price
+}
+
+func (v Voucher) Nonce() uint64 {
+	return v.nonce
+}
+
+func (v Voucher) Signer() base.Address {
+	return v.signer
+}
+
+func (v Voucher) Signs() []base.Sign {
+	return v.signs
+}
@@ -0,0 +1,52 @@
+package collection
+
+import (
+	bsonenc "github.com/ProtoconNet/mitum-currency/v2/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (v Voucher) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":      v.Hint().String(),
+			"collection": v.collection,
+			"hash":       v.hash,
+			"uri":        v.uri,
+			"creators":   v.creators,
+			"price":      v.price,
+			"nonce":      v.nonce,
+			"signer":     v.signer,
+			"signs":      v.signs,
+		},
+	)
+}
+
+type VoucherBSONUnmarshaler struct {
+	Hint       string   `bson:"_hint"`
+	Collection string   `bson:"collection"`
+	Hash       string   `bson:"hash"`
+	URI        string   `bson:"uri"`
+	Creators   bson.Raw `bson:"creators"`
+	Price      bson.Raw `bson:"price"`
+	Nonce      uint64   `bson:"nonce"`
+	Signer     string   `bson:"signer"`
+	// Signs []bson.Raw `bson:"signs"`
+}
+
+func (v *Voucher) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode bson of Voucher")
+
+	var u VoucherBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e(err, "")
+	}
+
+	return v.unmarshal(enc, ht, u.Collection, u.Hash, u.URI, u.Creators, u.Price, u.Nonce, u.Signer)
+}
@@ -0,0 +1,55 @@
+package collection
+
+import (
+	extensioncurrency "github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (v *Voucher) unmarshal(
+	enc encoder.Encoder,
+	ht hint.Hint,
+	col string,
+	hs string,
+	uri string,
+	bcrs []byte,
+	bp []byte,
+	nonce uint64,
+	sg string,
+) error {
+	e := util.StringErrorFunc("failed to unmarshal Voucher")
+
+	v.BaseHinter = hint.NewBaseHinter(ht)
+	v.collection = extensioncurrency.ContractID(col)
+	v.hash = nft.NFTHash(hs)
+	v.uri = nft.URI(uri)
+	v.nonce = nonce
+
+	if hinter, err := enc.Decode(bcrs); err != nil {
+		return e(err, "")
+	} else if creators, ok := hinter.(nft.Signers); !ok {
+		return e(util.ErrWrongType.Errorf("expected Signers, not %T", hinter), "")
+	} else {
+		v.creators = creators
+	}
+
+	if hinter, err := enc.Decode(bp); err != nil {
+		return e(err, "")
+	} else if price, ok := hinter.(currency.Amount); !ok {
+		return e(util.ErrWrongType.Errorf("expected Amount, not %T", hinter), "")
+	} else {
+		v.price = price
+	}
+
+	signer, err := base.DecodeAddress(sg, enc)
+	if err != nil {
+		return e(err, "")
+	}
+	v.signer = signer
+
+	return nil
+}
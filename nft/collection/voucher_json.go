@@ -0,0 +1,72 @@
+package collection
+
+import (
+	"encoding/json"
+
+	extensioncurrency "github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+type VoucherJSONMarshaler struct {
+	hint.BaseHinter
+	Collection extensioncurrency.ContractID `json:"collection"`
+	Hash       nft.NFTHash                  `json:"hash"`
+	URI        nft.URI                      `json:"uri"`
+	Creators   nft.Signers                  `json:"creators"`
+	Price      currency.Amount              `json:"price"`
+	Nonce      uint64                       `json:"nonce"`
+	Signer     base.Address                 `json:"signer"`
+	Signs      []base.Sign                  `json:"signs"`
+}
+
+func (v Voucher) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(VoucherJSONMarshaler{
+		BaseHinter: v.BaseHinter,
+		Collection: v.collection,
+		Hash:       v.hash,
+		URI:        v.uri,
+		Creators:   v.creators,
+		Price:      v.price,
+		Nonce:      v.nonce,
+		Signer:     v.signer,
+		Signs:      v.signs,
+	})
+}
+
+type VoucherJSONUnmarshaler struct {
+	Hint       hint.Hint         `json:"_hint"`
+	Collection string            `json:"collection"`
+	Hash       string            `json:"hash"`
+	URI        string            `json:"uri"`
+	Creators   json.RawMessage   `json:"creators"`
+	Price      json.RawMessage   `json:"price"`
+	Nonce      uint64            `json:"nonce"`
+	Signer     string            `json:"signer"`
+	Signs      []json.RawMessage `json:"signs"`
+}
+
+func (v *Voucher) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of Voucher")
+
+	var u VoucherJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	v.signs = make([]base.Sign, len(u.Signs))
+	for i := range u.Signs {
+		var ub base.BaseSign
+		if err := ub.DecodeJSON(u.Signs[i], enc); err != nil {
+			return e(err, "failed to decode sign")
+		}
+
+		v.signs[i] = ub
+	}
+
+	return v.unmarshal(enc, u.Hint, u.Collection, u.Hash, u.URI, u.Creators, u.Price, u.Nonce, u.Signer)
+}
@@ -0,0 +1,85 @@
+package collection
+
+import (
+	"testing"
+
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum-nft/nft"
+	"github.com/ProtoconNet/mitum2/base"
+)
+
+func TestVoucherIsValid(t *testing.T) {
+	networkID := base.NetworkID([]byte("mitum-nft-test"))
+	priv := base.NewMPrivatekey()
+	signer := currency.NewAddress("signer")
+	price := currency.NewAmount(currency.NewBig(100), "MCC")
+
+	creators := func(signed bool) nft.Signers {
+		return nft.NewSigners(100, []nft.Signer{nft.NewSigner(currency.NewAddress("creator"), 100, signed)})
+	}
+
+	signed := func(v Voucher) Voucher {
+		if err := v.Sign(priv, networkID); err != nil {
+			t.Fatalf("failed to sign voucher: %v", err)
+		}
+
+		return v
+	}
+
+	cases := []struct {
+		name      string
+		v         Voucher
+		networkID []byte
+		err       bool
+	}{
+		{
+			name: "valid",
+			v:    signed(NewVoucher("ABC", "nft-hash", "https://example.com/nft", creators(false), price, 1, signer)),
+		},
+		{
+			name:      "valid with network id",
+			v:         signed(NewVoucher("ABC", "nft-hash", "https://example.com/nft", creators(false), price, 1, signer)),
+			networkID: networkID,
+		},
+		{
+			name:      "other network id",
+			v:         signed(NewVoucher("ABC", "nft-hash", "https://example.com/nft", creators(false), price, 1, signer)),
+			networkID: []byte("other-network"),
+			err:       true,
+		},
+		{
+			name: "empty signs",
+			v:    NewVoucher("ABC", "nft-hash", "https://example.com/nft", creators(false), price, 1, signer),
+			err:  true,
+		},
+		{
+			name: "duplicate signs",
+			v:    signed(signed(NewVoucher("ABC", "nft-hash", "https://example.com/nft", creators(false), price, 1, signer))),
+			err:  true,
+		},
+		{
+			name: "signed creator",
+			v:    signed(NewVoucher("ABC", "nft-hash", "https://example.com/nft", creators(true), price, 1, signer)),
+			err:  true,
+		},
+		{
+			name: "empty uri",
+			v:    signed(NewVoucher("ABC", "nft-hash", "", creators(false), price, 1, signer)),
+			err:  true,
+		},
+		{
+			name: "invalid collection",
+			v:    signed(NewVoucher("abc", "nft-hash", "https://example.com/nft", creators(false), price, 1, signer)),
+			err:  true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := c.v.IsValid(c.networkID)
+			if c.err != (err != nil) {
+				t.Fatalf("error = %v, expected error %v", err, c.err)
+			}
+		})
+	}
+}
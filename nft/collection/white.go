@@ -0,0 +1,58 @@
+package collection
+
+import (
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+var WhiteHint = hint.MustNewHint("mitum-nft-white-v0.0.1")
+
+// MaxWhites is the max number of whites set by one operation.
+var MaxWhites = 100
+
+type White struct {
+	hint.BaseHinter
+	account base.Address
+	quota   uint64
+}
+
+func NewWhite(account base.Address, quota uint64) White {
+	return White{
+		BaseHinter: hint.NewBaseHinter(WhiteHint),
+		account:    account,
+		quota:      quota,
+	}
+}
+
+func (w White) IsValid([]byte) error {
+	if err := util.CheckIsValiders(nil, false, w.BaseHinter, w.account); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (w White) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		w.account.Bytes(),
+		util.Uint64ToBytes(w.quota),
+	)
+}
+
+func (w White) Account() base.Address {
+	return w.account
+}
+
+// Quota is the number of nfts the account may mint; 0 means no limit.
+func (w White) Quota() uint64 {
+	return w.quota
+}
+
+func (w White) Equal(cw White) bool {
+	if w.quota != cw.quota {
+		return false
+	}
+
+	return w.account.Equal(cw.account)
+}
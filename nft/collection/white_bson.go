@@ -0,0 +1,38 @@
+package collection
+
+import (
+	bsonenc "github.com/ProtoconNet/mitum-currency/v2/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (w White) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(bson.M{
+		"_hint":   w.Hint().String(),
+		"account": w.account,
+		"quota":   w.quota,
+	})
+}
+
+type WhiteBSONUnmarshaler struct {
+	Hint    string `bson:"_hint"`
+	Account string `bson:"account"`
+	Quota   uint64 `bson:"quota"`
+}
+
+func (w *White) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode bson of White")
+
+	var u WhiteBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e(err, "")
+	}
+
+	return w.unmarshal(enc, ht, u.Account, u.Quota)
+}
@@ -0,0 +1,28 @@
+package collection
+
+import (
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (w *White) unmarshal(
+	enc encoder.Encoder,
+	ht hint.Hint,
+	ac string,
+	qt uint64,
+) error {
+	e := util.StringErrorFunc("failed to unmarshal White")
+
+	w.BaseHinter = hint.NewBaseHinter(ht)
+	w.quota = qt
+
+	account, err := base.DecodeAddress(ac, enc)
+	if err != nil {
+		return e(err, "")
+	}
+	w.account = account
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package collection
+
+import (
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+type WhiteJSONMarshaler struct {
+	hint.BaseHinter
+	Account base.Address `json:"account"`
+	Quota   uint64       `json:"quota"`
+}
+
+func (w White) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(WhiteJSONMarshaler{
+		BaseHinter: w.BaseHinter,
+		Account:    w.account,
+		Quota:      w.quota,
+	})
+}
+
+type WhiteJSONUnmarshaler struct {
+	Hint    hint.Hint `json:"_hint"`
+	Account string    `json:"account"`
+	Quota   uint64    `json:"quota"`
+}
+
+func (w *White) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of White")
+
+	var u WhiteJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	return w.unmarshal(enc, u.Hint, u.Account, u.Quota)
+}
@@ -32,7 +32,11 @@ func (hs NFTHash) String() string {
 	return string(hs)
 }
 
-var NFTHint = hint.MustNewHint("mitum-nft-nft-v0.0.1")
+var (
+	NFTHint = hint.MustNewHint("mitum-nft-nft-v0.0.2")
+	// LegacyNFTHint is the hint of nfts stored before user and royalty were added.
+	LegacyNFTHint = hint.MustNewHint("mitum-nft-nft-v0.0.1")
+)
 
 var MaxCreators = 10
 var MaxCopyrighters = 10
@@ -47,6 +51,10 @@ type NFT struct {
 	approved     base.Address
 	creators     Signers
 	copyrighters Signers
+	user         base.Address
+	expiry       base.Height
+	royalty      PaymentParameter
+	receiver     base.Address
 }
 
 func NewNFT(
@@ -58,6 +66,10 @@ func NewNFT(
 	approved base.Address,
 	creators Signers,
 	copyrighters Signers,
+	user base.Address,
+	expiry base.Height,
+	royalty PaymentParameter,
+	receiver base.Address,
 ) NFT {
 	return NFT{
 		BaseHinter:   hint.NewBaseHinter(NFTHint),
@@ -69,6 +81,10 @@ func NewNFT(
 		approved:     approved,
 		creators:     creators,
 		copyrighters: copyrighters,
+		user:         user,
+		expiry:       expiry,
+		royalty:      royalty,
+		receiver:     receiver,
 	}
 }
 
@@ -81,14 +97,33 @@ func (n NFT) IsValid([]byte) error {
 		n.approved,
 		n.creators,
 		n.copyrighters,
+		n.royalty,
 	); err != nil {
 		return err
 	}
 
+	if n.user != nil {
+		if err := n.user.IsValid(nil); err != nil {
+			return err
+		}
+	}
+
+	if n.receiver != nil {
+		if err := n.receiver.IsValid(nil); err != nil {
+			return err
+		}
+	} else if n.royalty != 0 {
+		return util.ErrInvalid.Errorf("royalty set without receiver")
+	}
+
 	if n.uri == "" {
 		return util.ErrInvalid.Errorf("empty uri")
 	}
 
+	if n.expiry < 0 {
+		return util.ErrInvalid.Errorf("user expiry under zero, %d", n.expiry)
+	}
+
 	return nil
 }
 
@@ -101,6 +136,29 @@ func (n NFT) Bytes() []byte {
 		ba[0] = 0
 	}
 
+	if n.isLegacy() {
+		return util.ConcatBytesSlice(
+			n.id.Bytes(),
+			ba,
+			n.owner.Bytes(),
+			n.hash.Bytes(),
+			[]byte(n.uri.String()),
+			n.approved.Bytes(),
+			n.creators.Bytes(),
+			n.copyrighters.Bytes(),
+		)
+	}
+
+	var us []byte
+	if n.user != nil {
+		us = n.user.Bytes()
+	}
+
+	var rc []byte
+	if n.receiver != nil {
+		rc = n.receiver.Bytes()
+	}
+
 	return util.ConcatBytesSlice(
 		n.id.Bytes(),
 		ba,
@@ -110,9 +168,22 @@ func (n NFT) Bytes() []byte {
 		n.approved.Bytes(),
 		n.creators.Bytes(),
 		n.copyrighters.Bytes(),
+		us,
+		n.expiry.Bytes(),
+		n.royalty.Bytes(),
+		rc,
 	)
 }
 
+// isLegacy reports whether the nft keeps the byte layout of LegacyNFTHint.
+func (n NFT) isLegacy() bool {
+	if n.Hint().Equal(LegacyNFTHint) {
+		return true
+	}
+
+	return n.user == nil && n.expiry == 0 && n.royalty == 0 && n.receiver == nil
+}
+
 func (n NFT) ID() NFTID {
 	return n.id
 }
@@ -145,6 +216,49 @@ func (n NFT) Copyrighters() Signers {
 	return n.copyrighters
 }
 
+// Verified reports whether all creators and copyrighters have signed the nft.
+func (n NFT) Verified() bool {
+	return n.creators.IsSignedAll() && n.copyrighters.IsSignedAll()
+}
+
+func (n NFT) User() base.Address {
+	if n.user == nil {
+		return n.owner
+	}
+
+	return n.user
+}
+
+func (n NFT) UserExpiry() base.Height {
+	return n.expiry
+}
+
+// CurrentUser returns the user of the nft at the height; the owner is the user when no user is set or it has expired.
+func (n NFT) CurrentUser(height base.Height) base.Address {
+	if n.ExistsUser(height) {
+		return n.user
+	}
+
+	return n.owner
+}
+
+func (n NFT) ExistsUser(height base.Height) bool {
+	return n.user != nil && !n.user.Equal(n.owner) && height <= n.expiry
+}
+
+func (n NFT) Royalty() PaymentParameter {
+	return n.royalty
+}
+
+func (n NFT) RoyaltyReceiver() base.Address {
+	return n.receiver
+}
+
+// ExistsRoyalty reports whether the nft overrides the royalty of its collection.
+func (n NFT) ExistsRoyalty() bool {
+	return n.receiver != nil
+}
+
 func (n NFT) Equal(cn NFT) bool {
 	if !n.ID().Equal(cn.ID()) {
 		return false
@@ -178,6 +292,26 @@ func (n NFT) Equal(cn NFT) bool {
 		return false
 	}
 
+	if !n.User().Equal(cn.User()) {
+		return false
+	}
+
+	if n.UserExpiry() != cn.UserExpiry() {
+		return false
+	}
+
+	if n.Royalty() != cn.Royalty() {
+		return false
+	}
+
+	if n.ExistsRoyalty() != cn.ExistsRoyalty() {
+		return false
+	}
+
+	if n.ExistsRoyalty() && !n.RoyaltyReceiver().Equal(cn.RoyaltyReceiver()) {
+		return false
+	}
+
 	return n.ID().Equal(cn.ID())
 }
 
@@ -4,34 +4,44 @@ import (
 	"go.mongodb.org/mongo-driver/bson"
 
 	bsonenc "github.com/ProtoconNet/mitum-currency/v2/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
 	"github.com/ProtoconNet/mitum2/util/hint"
 )
 
 func (n NFT) MarshalBSON() ([]byte, error) {
 	return bsonenc.Marshal(bson.M{
-		"_hint":        n.Hint().String(),
-		"id":           n.id,
-		"active":       n.active,
-		"owner":        n.owner,
-		"hash":         n.hash,
-		"uri":          n.uri,
-		"approved":     n.approved,
-		"creators":     n.creators,
-		"copyrighters": n.copyrighters,
+		"_hint":            n.Hint().String(),
+		"id":               n.id,
+		"active":           n.active,
+		"owner":            n.owner,
+		"hash":             n.hash,
+		"uri":              n.uri,
+		"approved":         n.approved,
+		"creators":         n.creators,
+		"copyrighters":     n.copyrighters,
+		"user":             n.user,
+		"user_expiry":      n.expiry,
+		"royalty":          n.royalty,
+		"royalty_receiver": n.receiver,
+		"verified":         n.Verified(),
 	})
 }
 
 type NFTBSONUnmarshaler struct {
-	Hint         string   `bson:"_hint"`
-	ID           bson.Raw `bson:"id"`
-	Active       bool     `bson:"active"`
-	Owner        string   `bson:"owner"`
-	Hash         string   `bson:"hash"`
-	URI          string   `bson:"uri"`
-	Approved     string   `bson:"approved"`
-	Creators     bson.Raw `bson:"creators"`
-	Copyrighters bson.Raw `bson:"copyrighters"`
+	Hint         string      `bson:"_hint"`
+	ID           bson.Raw    `bson:"id"`
+	Active       bool        `bson:"active"`
+	Owner        string      `bson:"owner"`
+	Hash         string      `bson:"hash"`
+	URI          string      `bson:"uri"`
+	Approved     string      `bson:"approved"`
+	Creators     bson.Raw    `bson:"creators"`
+	Copyrighters bson.Raw    `bson:"copyrighters"`
+	User         string      `bson:"user"`
+	UserExpiry   base.Height `bson:"user_expiry"`
+	Royalty      uint        `bson:"royalty"`
+	Receiver     string      `bson:"royalty_receiver"`
 }
 
 func (n *NFT) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -47,5 +57,5 @@ func (n *NFT) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
 		return e(err, "")
 	}
 
-	return n.unmarshal(enc, ht, u.ID, u.Active, u.Owner, u.Hash, u.URI, u.Approved, u.Creators, u.Copyrighters)
+	return n.unmarshal(enc, ht, u.ID, u.Active, u.Owner, u.Hash, u.URI, u.Approved, u.Creators, u.Copyrighters, u.User, u.UserExpiry, u.Royalty, u.Receiver)
 }
@@ -18,6 +18,10 @@ func (n *NFT) unmarshal(
 	ap string,
 	bcrs []byte,
 	bcps []byte,
+	us string,
+	ex base.Height,
+	ry uint,
+	rc string,
 ) error {
 	e := util.StringErrorFunc("failed to unmarshal NFT")
 
@@ -25,6 +29,8 @@ func (n *NFT) unmarshal(
 	n.active = ac
 	n.hash = NFTHash(hs)
 	n.uri = URI(uri)
+	n.expiry = ex
+	n.royalty = PaymentParameter(ry)
 
 	owner, err := base.DecodeAddress(ow, enc)
 	if err != nil {
@@ -38,6 +44,22 @@ func (n *NFT) unmarshal(
 	}
 	n.approved = approved
 
+	if len(us) > 0 {
+		user, err := base.DecodeAddress(us, enc)
+		if err != nil {
+			return e(err, "")
+		}
+		n.user = user
+	}
+
+	if len(rc) > 0 {
+		receiver, err := base.DecodeAddress(rc, enc)
+		if err != nil {
+			return e(err, "")
+		}
+		n.receiver = receiver
+	}
+
 	if hinter, err := enc.Decode(bid); err != nil {
 		return e(err, "")
 	} else if id, ok := hinter.(NFTID); !ok {
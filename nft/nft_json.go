@@ -11,14 +11,19 @@ import (
 
 type NFTJSONMarshaler struct {
 	hint.BaseHinter
-	ID           NFTID        `json:"id"`
-	Active       bool         `json:"active"`
-	Owner        base.Address `json:"owner"`
-	Hash         NFTHash      `json:"hash"`
-	URI          URI          `json:"uri"`
-	Approved     base.Address `json:"approved"`
-	Creators     Signers      `json:"creators"`
-	Copyrighters Signers      `json:"copyrighters"`
+	ID           NFTID            `json:"id"`
+	Active       bool             `json:"active"`
+	Owner        base.Address     `json:"owner"`
+	Hash         NFTHash          `json:"hash"`
+	URI          URI              `json:"uri"`
+	Approved     base.Address     `json:"approved"`
+	Creators     Signers          `json:"creators"`
+	Copyrighters Signers          `json:"copyrighters"`
+	User         base.Address     `json:"user"`
+	UserExpiry   base.Height      `json:"user_expiry"`
+	Royalty      PaymentParameter `json:"royalty"`
+	Receiver     base.Address     `json:"royalty_receiver"`
+	Verified     bool             `json:"verified"`
 }
 
 func (n NFT) MarshalJSON() ([]byte, error) {
@@ -32,6 +37,11 @@ func (n NFT) MarshalJSON() ([]byte, error) {
 		Approved:     n.approved,
 		Creators:     n.creators,
 		Copyrighters: n.copyrighters,
+		User:         n.user,
+		UserExpiry:   n.expiry,
+		Royalty:      n.royalty,
+		Receiver:     n.receiver,
+		Verified:     n.Verified(),
 	})
 }
 
@@ -45,6 +55,10 @@ type NFTJSONUnmarshaler struct {
 	Approved     string          `json:"approved"`
 	Creators     json.RawMessage `json:"creators"`
 	Copyrighters json.RawMessage `json:"copyrighters"`
+	User         string          `json:"user"`
+	UserExpiry   base.Height     `json:"user_expiry"`
+	Royalty      uint            `json:"royalty"`
+	Receiver     string          `json:"royalty_receiver"`
 }
 
 func (n *NFT) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
@@ -55,5 +69,5 @@ func (n *NFT) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
 		return e(err, "")
 	}
 
-	return n.unmarshal(enc, u.Hint, u.ID, u.Active, u.Owner, u.Hash, u.URI, u.Approved, u.Creators, u.Copyrighters)
+	return n.unmarshal(enc, u.Hint, u.ID, u.Active, u.Owner, u.Hash, u.URI, u.Approved, u.Creators, u.Copyrighters, u.User, u.UserExpiry, u.Royalty, u.Receiver)
 }
@@ -0,0 +1,153 @@
+package nft
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+func newTestNFT(user base.Address, expiry base.Height, royalty PaymentParameter, receiver base.Address) NFT {
+	owner := currency.NewAddress("nftowner")
+	creators := NewSigners(100, []Signer{NewSigner(currency.NewAddress("nftcreator"), 100, true)})
+	copyrighters := NewSigners(0, []Signer{})
+
+	return NewNFT(
+		NewNFTID("ABC", 1), true, owner, NFTHash("nft-hash"), URI("https://example.com/nft/1"),
+		owner, creators, copyrighters, user, expiry, royalty, receiver,
+	)
+}
+
+func legacyBytes(n NFT) []byte {
+	ba := []byte{0}
+	if n.active {
+		ba[0] = 1
+	}
+
+	return util.ConcatBytesSlice(
+		n.id.Bytes(),
+		ba,
+		n.owner.Bytes(),
+		n.hash.Bytes(),
+		[]byte(n.uri.String()),
+		n.approved.Bytes(),
+		n.creators.Bytes(),
+		n.copyrighters.Bytes(),
+	)
+}
+
+func newTestEncoder(t *testing.T) *jsonenc.Encoder {
+	enc := jsonenc.NewEncoder()
+
+	for _, d := range []encoder.DecodeDetail{
+		{Hint: currency.AddressHint, Instance: currency.Address{}},
+		{Hint: NFTIDHint, Instance: NFTID{}},
+		{Hint: SignerHint, Instance: Signer{}},
+		{Hint: SignersHint, Instance: Signers{}},
+		{Hint: NFTHint, Instance: NFT{}},
+		{Hint: LegacyNFTHint, Instance: NFT{}},
+	} {
+		if err := enc.Add(d); err != nil {
+			t.Fatalf("failed to add hinter, %q: %v", d.Hint, err)
+		}
+	}
+
+	return enc
+}
+
+func TestNFTBytes(t *testing.T) {
+	user := currency.NewAddress("nftuser")
+	receiver := currency.NewAddress("nftreceiver")
+
+	legacy := newTestNFT(nil, 0, 0, nil)
+	legacy.BaseHinter = hint.NewBaseHinter(LegacyNFTHint)
+
+	cases := []struct {
+		name   string
+		n      NFT
+		legacy bool
+	}{
+		{name: "legacy hint", n: legacy, legacy: true},
+		{name: "no user and royalty", n: newTestNFT(nil, 0, 0, nil), legacy: true},
+		{name: "user", n: newTestNFT(user, 10, 0, nil)},
+		{name: "user without expiry", n: newTestNFT(user, 0, 0, nil)},
+		{name: "royalty", n: newTestNFT(nil, 0, 5, receiver)},
+		{name: "zero royalty with receiver", n: newTestNFT(nil, 0, 0, receiver)},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if err := c.n.IsValid(nil); err != nil {
+				t.Fatalf("invalid nft: %v", err)
+			}
+
+			b := c.n.Bytes()
+			if eq := bytes.Equal(b, legacyBytes(c.n)); eq != c.legacy {
+				t.Fatalf("legacy layout = %v, expected %v", eq, c.legacy)
+			}
+
+			if !bytes.Equal(b, c.n.Bytes()) {
+				t.Fatal("bytes not stable")
+			}
+		})
+	}
+}
+
+func TestNFTDecodeJSON(t *testing.T) {
+	enc := newTestEncoder(t)
+
+	legacy := newTestNFT(nil, 0, 0, nil)
+	legacy.BaseHinter = hint.NewBaseHinter(LegacyNFTHint)
+
+	cases := []struct {
+		name string
+		n    NFT
+		hint hint.Hint
+	}{
+		{name: "v0.0.1", n: legacy, hint: LegacyNFTHint},
+		{name: "v0.0.2 without user", n: newTestNFT(nil, 0, 0, nil), hint: NFTHint},
+		{name: "v0.0.2 with user", n: newTestNFT(currency.NewAddress("nftuser"), 10, 0, nil), hint: NFTHint},
+		{name: "v0.0.2 with royalty", n: newTestNFT(nil, 0, 5, currency.NewAddress("nftreceiver")), hint: NFTHint},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			b, err := util.MarshalJSON(c.n)
+			if err != nil {
+				t.Fatalf("failed to marshal: %v", err)
+			}
+
+			hinter, err := enc.Decode(b)
+			if err != nil {
+				t.Fatalf("failed to decode: %v", err)
+			}
+
+			un, ok := hinter.(NFT)
+			if !ok {
+				t.Fatalf("expected NFT, not %T", hinter)
+			}
+
+			if !un.Hint().Equal(c.hint) {
+				t.Fatalf("hint = %q, expected %q", un.Hint(), c.hint)
+			}
+
+			if !c.n.Equal(un) {
+				t.Fatal("decoded nft not equal")
+			}
+
+			if c.n.user == nil && un.user != nil {
+				t.Fatalf("user backfilled on decode, %q", un.user)
+			}
+
+			if !valuehash.NewSHA256(c.n.Bytes()).Equal(valuehash.NewSHA256(un.Bytes())) {
+				t.Fatal("hash changed after decode")
+			}
+		})
+	}
+}
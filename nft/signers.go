@@ -156,6 +156,15 @@ func (sgns Signers) IsSignedByAddress(address base.Address) bool {
 	return sgns.signers[idx].Signed()
 }
 
+func (sgns Signers) IsSignedAll() bool {
+	for _, signer := range sgns.signers {
+		if !signer.Signed() {
+			return false
+		}
+	}
+	return true
+}
+
 func (sgns *Signers) SetSigner(sgn Signer) error {
 	idx := sgns.Index(sgn)
 	if idx < 0 {
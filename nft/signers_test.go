@@ -0,0 +1,40 @@
+package nft
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ProtoconNet/mitum-currency/v2/currency"
+)
+
+func TestSignersIsValid(t *testing.T) {
+	a := currency.NewAddress("signerA")
+	b := currency.NewAddress("signerB")
+
+	many := make([]Signer, MaxSigners+1)
+	for i := range many {
+		many[i] = NewSigner(currency.NewAddress(fmt.Sprintf("signer%d", i)), 0, false)
+	}
+
+	cases := []struct {
+		name    string
+		signers Signers
+		err     bool
+	}{
+		{name: "empty", signers: NewSigners(0, []Signer{})},
+		{name: "signers", signers: NewSigners(100, []Signer{NewSigner(a, 60, false), NewSigner(b, 40, true)})},
+		{name: "total over max", signers: NewSigners(MaxTotalShare+1, []Signer{NewSigner(a, MaxTotalShare+1, false)}), err: true},
+		{name: "total not matched", signers: NewSigners(100, []Signer{NewSigner(a, 60, false), NewSigner(b, 30, false)}), err: true},
+		{name: "duplicate signer", signers: NewSigners(100, []Signer{NewSigner(a, 50, false), NewSigner(a, 50, false)}), err: true},
+		{name: "signers over max", signers: NewSigners(0, many), err: true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := c.signers.IsValid(nil)
+			if c.err != (err != nil) {
+				t.Fatalf("error = %v, expected error %v", err, c.err)
+			}
+		})
+	}
+}